@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/export"
+)
+
+// Generate Terraform configuration for the objects in an existing PingFederate server. The connection to
+// PingFederate is configured with the same PINGFEDERATE_PROVIDER_* environment variables as the provider.
+
+var (
+	// the provider version reported by the command. The command is not built by the goreleaser configuration,
+	// so this can be set when building it with -ldflags "-X main.version=<version>"
+	version string = "dev"
+)
+
+func main() {
+	var outputFile, resourceTypes string
+	var listResourceTypes bool
+	flag.StringVar(&outputFile, "out", "", "file to write the generated configuration to. Defaults to standard output")
+	flag.StringVar(&resourceTypes, "resource-types", "", "comma-separated list of resource types to export. Defaults to all supported resource types")
+	flag.BoolVar(&listResourceTypes, "list-resource-types", false, "print the supported resource types and exit")
+	flag.Parse()
+
+	if listResourceTypes {
+		for _, resourceType := range export.SupportedResourceTypes() {
+			fmt.Println(resourceType)
+		}
+		return
+	}
+
+	if err := run(context.Background(), outputFile, resourceTypes); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, outputFile, resourceTypes string) error {
+	var resourceTypeNames []string
+	for _, name := range strings.Split(resourceTypes, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			resourceTypeNames = append(resourceTypeNames, name)
+		}
+	}
+
+	exporter, err := export.New(ctx, version)
+	if err != nil {
+		return err
+	}
+	content, warnings, err := exporter.Export(ctx, resourceTypeNames)
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "Warning:", warning)
+	}
+	if err != nil {
+		return err
+	}
+
+	if outputFile == "" {
+		_, err = os.Stdout.Write(content)
+		return err
+	}
+	return os.WriteFile(outputFile, content, 0600)
+}
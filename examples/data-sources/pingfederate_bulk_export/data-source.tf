@@ -0,0 +1,13 @@
+data "pingfederate_bulk_export" "bulkExport" {
+  include_external_resources = false
+  redact_encrypted_values    = true
+}
+
+resource "local_sensitive_file" "bulk_export" {
+  content  = data.pingfederate_bulk_export.bulkExport.exported_json
+  filename = "${path.module}/data.json"
+}
+
+output "bulk_export_save_item_count" {
+  value = data.pingfederate_bulk_export.bulkExport.operation_counts["SAVE"]
+}
@@ -0,0 +1,7 @@
+data "pingfederate_config_archive_export" "configArchiveExport" {
+  output_path = "${path.module}/pingfederate-config-archive.zip"
+}
+
+output "config_archive_sha256" {
+  value = data.pingfederate_config_archive_export.configArchiveExport.output_sha256
+}
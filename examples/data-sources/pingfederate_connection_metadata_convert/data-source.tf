@@ -0,0 +1,78 @@
+resource "pingfederate_metadata_url" "partnerSp" {
+  url_id             = "partnerSpMetadata"
+  name               = "Partner SP Metadata"
+  url                = "https://sp.bxretail.org/metadata.xml"
+  validate_signature = true
+  x509_file = {
+    file_data = filebase64("./assets/partner-sp-metadata-signing.pem")
+  }
+}
+
+data "pingfederate_connection_metadata_convert" "partnerSp" {
+  connection_type    = "SP"
+  expected_entity_id = "https://sp.bxretail.org"
+  metadata_url_ref = {
+    id = pingfederate_metadata_url.partnerSp.url_id
+  }
+}
+
+resource "pingfederate_idp_sp_connection" "partnerSp" {
+  connection_id = "partnerSp"
+  name          = data.pingfederate_connection_metadata_convert.partnerSp.name
+  entity_id     = data.pingfederate_connection_metadata_convert.partnerSp.entity_id
+  base_url      = data.pingfederate_connection_metadata_convert.partnerSp.base_url
+  logging_mode  = data.pingfederate_connection_metadata_convert.partnerSp.logging_mode
+
+  credentials = {
+    certs = data.pingfederate_connection_metadata_convert.partnerSp.credentials.certs
+    signing_settings = {
+      signing_key_pair_ref = {
+        id = "419x9yg43rlawqwq9v6az997k"
+      }
+      algorithm = "SHA256withRSA"
+    }
+  }
+
+  sp_browser_sso = {
+    protocol                      = "SAML20"
+    require_signed_authn_requests = false
+    sp_saml_identity_mapping      = "STANDARD"
+    sign_assertions               = false
+    sign_response_as_required     = true
+    enabled_profiles = [
+      "IDP_INITIATED_SSO",
+      "SP_INITIATED_SSO"
+    ]
+    incoming_bindings     = data.pingfederate_connection_metadata_convert.partnerSp.sp_browser_sso.incoming_bindings
+    sso_service_endpoints = data.pingfederate_connection_metadata_convert.partnerSp.sp_browser_sso.sso_service_endpoints
+    authentication_policy_contract_assertion_mappings = [
+      {
+        authentication_policy_contract_ref = {
+          id = "contractId"
+        }
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "AUTHENTICATION_POLICY_CONTRACT"
+            }
+            value = "subject"
+          }
+        }
+      }
+    ]
+    adapter_mappings = []
+    assertion_lifetime = {
+      minutes_after  = 5
+      minutes_before = 5
+    }
+    attribute_contract = {
+      core_attributes = [
+        {
+          name_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+          name        = "SAML_SUBJECT"
+        }
+      ]
+      extended_attributes = []
+    }
+  }
+}
@@ -0,0 +1,10 @@
+check "data_store_reachable" {
+  data "pingfederate_data_store_connection_test" "check" {
+    data_store_ids = [pingfederate_data_store.example.id]
+  }
+
+  assert {
+    condition     = data.pingfederate_data_store_connection_test.check.all_succeeded
+    error_message = join("\n", [for result in data.pingfederate_data_store_connection_test.check.results : "${result.data_store_id}: ${coalesce(result.error_detail, "ok")}"])
+  }
+}
@@ -0,0 +1,14 @@
+data "pingfederate_pingone_connection_status" "example" {
+  connection_id = pingfederate_pingone_connection.example.connection_id
+}
+
+check "pingone_connection_credential" {
+  assert {
+    condition     = length(data.pingfederate_pingone_connection_status.example.environments) > 0
+    error_message = "The PingOne connection credential can't access any PingOne environments. Credential status: ${data.pingfederate_pingone_connection_status.example.credential_status}"
+  }
+}
+
+output "pingone_connection_usages" {
+  value = [for usage in data.pingfederate_pingone_connection_status.example.usages : "${usage.category_name}: ${usage.name}"]
+}
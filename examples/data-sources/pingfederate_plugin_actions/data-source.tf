@@ -0,0 +1,4 @@
+data "pingfederate_plugin_actions" "example" {
+  plugin_type = "DATA_STORE"
+  plugin_id   = "ProvisionerDS"
+}
@@ -0,0 +1,9 @@
+data "pingfederate_server_info" "server" {
+}
+
+# Only configure the WS-Trust STS settings on servers licensed for WS-Trust
+resource "pingfederate_server_settings_ws_trust_sts_settings" "wsTrustStsSettings" {
+  count = data.pingfederate_server_info.server.license.ws_trust_enabled ? 1 : 0
+
+  basic_authn_enabled = false
+}
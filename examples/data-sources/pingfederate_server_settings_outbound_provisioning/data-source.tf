@@ -0,0 +1,2 @@
+data "pingfederate_server_settings_outbound_provisioning" "outboundProvisioning" {
+}
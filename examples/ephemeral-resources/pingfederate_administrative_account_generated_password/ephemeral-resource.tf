@@ -0,0 +1,3 @@
+ephemeral "pingfederate_administrative_account_generated_password" "example" {
+  length = 40
+}
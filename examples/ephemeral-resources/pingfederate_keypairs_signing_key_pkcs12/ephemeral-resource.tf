@@ -0,0 +1,4 @@
+ephemeral "pingfederate_keypairs_signing_key_pkcs12" "example" {
+  key_id   = "myKeyId"
+  password = var.pkcs12_password
+}
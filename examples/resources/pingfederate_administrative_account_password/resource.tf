@@ -0,0 +1,15 @@
+resource "time_rotating" "admin_password_rotation" {
+  rotation_days = 30
+}
+
+ephemeral "pingfederate_administrative_account_generated_password" "break_glass" {
+  length = 40
+}
+
+resource "pingfederate_administrative_account_password" "break_glass" {
+  username     = pingfederate_administrative_account.break_glass.username
+  new_password = ephemeral.pingfederate_administrative_account_generated_password.break_glass.password
+
+  # The password is only set when the resource is created and when this version changes
+  new_password_version = time_rotating.admin_password_rotation.rotation_rfc3339
+}
@@ -1 +1,4 @@
-terraform import pingfederate_authentication_policy_contract.authenticationPolicyContract contract_id
\ No newline at end of file
+terraform import pingfederate_authentication_policy_contract.authenticationPolicyContract contract_id
+
+# Alternatively, import by name
+terraform import pingfederate_authentication_policy_contract.authenticationPolicyContract "name:Default Contract"
@@ -1 +1,4 @@
-terraform import pingfederate_authentication_selector.authenticationSelector authenticationSelectorId
\ No newline at end of file
+terraform import pingfederate_authentication_selector.authenticationSelector authenticationSelectorId
+
+# Alternatively, import by name
+terraform import pingfederate_authentication_selector.authenticationSelector "name:Extended Property Selector"
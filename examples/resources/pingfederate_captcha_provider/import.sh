@@ -1 +1,4 @@
-terraform import pingfederate_captcha_provider.captchaProviderExample myCaptchaProviderId
\ No newline at end of file
+terraform import pingfederate_captcha_provider.captchaProviderExample myCaptchaProviderId
+
+# Alternatively, import by name
+terraform import pingfederate_captcha_provider.captchaProviderExample "name:reCAPTCHA v2"
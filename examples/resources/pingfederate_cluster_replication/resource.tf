@@ -0,0 +1,11 @@
+resource "pingfederate_authentication_policy_contract" "example" {
+  name                = "Example Contract"
+  extended_attributes = [{ name = "email" }, { name = "given_name" }]
+}
+
+resource "pingfederate_cluster_replication" "example" {
+  replication_trigger_values = {
+    "authentication_policy_contract" : sha256(jsonencode(pingfederate_authentication_policy_contract.example)),
+  }
+  sync_timeout_seconds = 600
+}
@@ -0,0 +1,11 @@
+resource "pingfederate_config_archive_import" "configArchiveImport" {
+  file_path         = "${path.module}/pingfederate-config-archive.zip"
+  confirm_overwrite = true
+  force_import      = false
+  re_encrypt        = true
+
+  // Import the archive again whenever its contents change
+  import_trigger_values = {
+    "archive_sha256" : filesha256("${path.module}/pingfederate-config-archive.zip"),
+  }
+}
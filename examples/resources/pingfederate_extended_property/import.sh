@@ -0,0 +1 @@
+terraform import pingfederate_extended_property.example extendedPropertyName
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "pingfederate_extended_property" "example" {
+  name         = "Attribute 1"
+  description  = "My multi-valued extended attribute"
+  multi_valued = true
+}
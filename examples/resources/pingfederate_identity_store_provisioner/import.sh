@@ -1 +1,4 @@
-terraform import pingfederate_identity_store_provisioner.identityStoreProvisioner provisionerId
\ No newline at end of file
+terraform import pingfederate_identity_store_provisioner.identityStoreProvisioner provisionerId
+
+# Alternatively, import by name
+terraform import pingfederate_identity_store_provisioner.identityStoreProvisioner "name:SCIM Provisioner"
@@ -1 +1,4 @@
-terraform import pingfederate_idp_adapter.idpAdapter idpAdapterId
\ No newline at end of file
+terraform import pingfederate_idp_adapter.idpAdapter idpAdapterId
+
+# Alternatively, import by name
+terraform import pingfederate_idp_adapter.idpAdapter "name:HTML Form"
@@ -1 +1,5 @@
 terraform import pingfederate_idp_sp_connection.idpSpConnection connectionId
+
+# Alternatively, import by entity ID or by name
+terraform import pingfederate_idp_sp_connection.idpSpConnection "entity_id:https://partner.example.com"
+terraform import pingfederate_idp_sp_connection.idpSpConnection "name:Partner Connection"
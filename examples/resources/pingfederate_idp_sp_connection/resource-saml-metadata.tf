@@ -0,0 +1,69 @@
+resource "pingfederate_metadata_url" "partnerMetadata" {
+  url_id             = "partnerMetadata"
+  name               = "Partner Metadata"
+  url                = "https://sp.bxretail.org/metadata"
+  validate_signature = false
+}
+
+resource "pingfederate_idp_sp_connection" "samlMetadataExample" {
+  connection_id = "connection"
+  name          = "connection"
+
+  # The entity ID, SSO and SLO endpoints, incoming bindings and certificates are read from the partner's metadata.
+  metadata = {
+    metadata_url_ref = {
+      id = pingfederate_metadata_url.partnerMetadata.url_id
+    }
+  }
+
+  credentials = {
+    signing_settings = {
+      signing_key_pair_ref = {
+        id = "signingKey"
+      }
+      include_raw_key_in_signature = false
+      include_cert_in_signature    = false
+      algorithm                    = "SHA256withRSA"
+    }
+  }
+  sp_browser_sso = {
+    protocol                      = "SAML20"
+    require_signed_authn_requests = false
+    sp_saml_identity_mapping      = "STANDARD"
+    sign_assertions               = false
+    sign_response_as_required     = true
+    enabled_profiles = [
+      "IDP_INITIATED_SSO",
+      "SP_INITIATED_SSO"
+    ]
+    authentication_policy_contract_assertion_mappings = [
+      {
+        authentication_policy_contract_ref = {
+          id = "contractId"
+        }
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "AUTHENTICATION_POLICY_CONTRACT"
+            }
+            value = "subject"
+          }
+        }
+      }
+    ]
+    adapter_mappings = []
+    assertion_lifetime = {
+      minutes_after  = 5
+      minutes_before = 5
+    }
+    attribute_contract = {
+      core_attributes = [
+        {
+          name_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+          name        = "SAML_SUBJECT"
+        }
+      ]
+      extended_attributes = []
+    }
+  }
+}
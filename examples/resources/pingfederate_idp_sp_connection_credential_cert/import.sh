@@ -0,0 +1 @@
+terraform import pingfederate_idp_sp_connection_credential_cert.cert "connectionId/certId"
\ No newline at end of file
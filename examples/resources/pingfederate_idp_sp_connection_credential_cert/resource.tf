@@ -0,0 +1,6 @@
+resource "pingfederate_idp_sp_connection_credential_cert" "partnerEncryptionCert" {
+  connection_id   = pingfederate_idp_sp_connection.spConnection.connection_id
+  cert_id         = "partnerencryptioncert"
+  file_data       = filebase64("./assets/partner-encryption-cert.pem")
+  encryption_cert = true
+}
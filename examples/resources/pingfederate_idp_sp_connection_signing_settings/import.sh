@@ -0,0 +1 @@
+terraform import pingfederate_idp_sp_connection_signing_settings.signingSettings "connectionId"
\ No newline at end of file
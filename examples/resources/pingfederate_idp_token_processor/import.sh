@@ -1 +1,4 @@
-terraform import pingfederate_idp_token_processor.idpTokenProcessor idpTokenProcessorId
\ No newline at end of file
+terraform import pingfederate_idp_token_processor.idpTokenProcessor idpTokenProcessorId
+
+# Alternatively, import by name
+terraform import pingfederate_idp_token_processor.idpTokenProcessor "name:Username Token Processor"
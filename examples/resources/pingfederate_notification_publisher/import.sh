@@ -1 +1,4 @@
-terraform import pingfederate_notification_publisher.notificationPublisher notificationPublisherId
\ No newline at end of file
+terraform import pingfederate_notification_publisher.notificationPublisher notificationPublisherId
+
+# Alternatively, import by name
+terraform import pingfederate_notification_publisher.notificationPublisher "name:SMTP Publisher"
@@ -1 +1,4 @@
-terraform import pingfederate_oauth_access_token_manager.oauthAccessTokenManager oauthAccessTokenManagerId
\ No newline at end of file
+terraform import pingfederate_oauth_access_token_manager.oauthAccessTokenManager oauthAccessTokenManagerId
+
+# Alternatively, import by name
+terraform import pingfederate_oauth_access_token_manager.oauthAccessTokenManager "name:Reference Token Manager"
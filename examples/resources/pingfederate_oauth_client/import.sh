@@ -1 +1,4 @@
-terraform import pingfederate_oauth_client.oauthClient oauthClientId
\ No newline at end of file
+terraform import pingfederate_oauth_client.oauthClient oauthClientId
+
+# Alternatively, import by name
+terraform import pingfederate_oauth_client.oauthClient "name:Mobile App"
@@ -1 +1,4 @@
-terraform import pingfederate_oauth_client_registration_policy.registrationPolicy policyId
\ No newline at end of file
+terraform import pingfederate_oauth_client_registration_policy.registrationPolicy policyId
+
+# Alternatively, import by name
+terraform import pingfederate_oauth_client_registration_policy.registrationPolicy "name:Registration Policy"
@@ -0,0 +1 @@
+terraform import pingfederate_oauth_exclusive_scope.oauthExclusiveScope exclusiveScopeName
\ No newline at end of file
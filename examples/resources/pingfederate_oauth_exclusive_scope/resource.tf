@@ -0,0 +1,10 @@
+resource "pingfederate_oauth_exclusive_scope" "oauthExclusiveScope" {
+  name        = "payments:write"
+  description = "Initiate payments on behalf of the user"
+}
+
+resource "pingfederate_oauth_exclusive_scope" "dynamicOauthExclusiveScope" {
+  name        = "*payments:approve"
+  description = "Approve a specific payment"
+  dynamic     = true
+}
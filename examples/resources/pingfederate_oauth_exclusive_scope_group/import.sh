@@ -0,0 +1 @@
+terraform import pingfederate_oauth_exclusive_scope_group.oauthExclusiveScopeGroup exclusiveScopeGroupName
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "pingfederate_oauth_exclusive_scope" "paymentsRead" {
+  name        = "payments:read"
+  description = "View the user's payments"
+}
+
+resource "pingfederate_oauth_exclusive_scope" "paymentsWrite" {
+  name        = "payments:write"
+  description = "Initiate payments on behalf of the user"
+}
+
+resource "pingfederate_oauth_exclusive_scope_group" "oauthExclusiveScopeGroup" {
+  name        = "payments"
+  description = "Full access to the user's payments"
+  scopes = [
+    pingfederate_oauth_exclusive_scope.paymentsRead.name,
+    pingfederate_oauth_exclusive_scope.paymentsWrite.name,
+  ]
+}
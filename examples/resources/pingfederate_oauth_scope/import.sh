@@ -0,0 +1 @@
+terraform import pingfederate_oauth_scope.oauthScope scopeName
\ No newline at end of file
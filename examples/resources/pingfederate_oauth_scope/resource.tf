@@ -0,0 +1,10 @@
+resource "pingfederate_oauth_scope" "oauthScope" {
+  name        = "profile:read"
+  description = "Read access to the user's profile"
+}
+
+resource "pingfederate_oauth_scope" "dynamicOauthScope" {
+  name        = "account:*"
+  description = "Access to a single account"
+  dynamic     = true
+}
@@ -0,0 +1 @@
+terraform import pingfederate_oauth_scope_group.oauthScopeGroup scopeGroupName
\ No newline at end of file
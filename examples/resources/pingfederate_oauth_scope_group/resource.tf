@@ -0,0 +1,18 @@
+resource "pingfederate_oauth_scope" "profileRead" {
+  name        = "profile:read"
+  description = "Read access to the user's profile"
+}
+
+resource "pingfederate_oauth_scope" "profileWrite" {
+  name        = "profile:write"
+  description = "Write access to the user's profile"
+}
+
+resource "pingfederate_oauth_scope_group" "oauthScopeGroup" {
+  name        = "profile"
+  description = "Full access to the user's profile"
+  scopes = [
+    pingfederate_oauth_scope.profileRead.name,
+    pingfederate_oauth_scope.profileWrite.name,
+  ]
+}
@@ -0,0 +1,11 @@
+# Scopes and scope groups are managed with the pingfederate_oauth_scope,
+# pingfederate_oauth_exclusive_scope, pingfederate_oauth_scope_group and
+# pingfederate_oauth_exclusive_scope_group resources, so they are left unchanged here.
+resource "pingfederate_oauth_server_settings" "oauthServerSettings" {
+  manage_scopes = false
+
+  authorization_code_entropy = 20
+  authorization_code_timeout = 50
+  refresh_rolling_interval   = 1
+  refresh_token_length       = 40
+}
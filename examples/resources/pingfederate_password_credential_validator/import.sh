@@ -1 +1,4 @@
-terraform import pingfederate_password_credential_validator.passwordCredentialValidator passwordCredentialValidatorId
\ No newline at end of file
+terraform import pingfederate_password_credential_validator.passwordCredentialValidator passwordCredentialValidatorId
+
+# Alternatively, import by name
+terraform import pingfederate_password_credential_validator.passwordCredentialValidator "name:Simple Username Validator"
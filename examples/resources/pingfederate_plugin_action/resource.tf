@@ -0,0 +1,14 @@
+data "pingfederate_plugin_actions" "adapter" {
+  plugin_type = "IDP_ADAPTER"
+  plugin_id   = pingfederate_idp_adapter.example.adapter_id
+}
+
+resource "pingfederate_plugin_action" "clear_cache" {
+  plugin_type = "IDP_ADAPTER"
+  plugin_id   = pingfederate_idp_adapter.example.adapter_id
+  action_id   = one([for action in data.pingfederate_plugin_actions.adapter.actions : action.action_id if action.name == "Clear Cache"])
+
+  action_trigger_values = {
+    "adapter_configuration" : sha256(jsonencode(pingfederate_idp_adapter.example.configuration)),
+  }
+}
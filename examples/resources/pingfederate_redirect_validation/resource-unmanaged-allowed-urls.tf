@@ -0,0 +1,27 @@
+# Entries for individual applications are managed with the pingfederate_redirect_validation_allowed_url
+# resource, so any entries not configured here are left unchanged.
+resource "pingfederate_redirect_validation" "redirectValidationExample" {
+  ignore_unmanaged_allowed_urls = true
+
+  redirect_validation_local_settings = {
+    enable_target_resource_validation_for_sso           = true
+    enable_target_resource_validation_for_slo           = true
+    enable_target_resource_validation_for_idp_discovery = true
+    enable_in_error_resource_validation                 = true
+    white_list = [
+      {
+        target_resource_sso = true
+        valid_domain        = "bxretail.org"
+        valid_path          = "/callback"
+        require_https       = true
+      },
+    ]
+  }
+}
+
+resource "pingfederate_redirect_validation_allowed_url" "appCallback" {
+  valid_domain        = "app.bxretail.org"
+  valid_path          = "/callback"
+  target_resource_sso = true
+  require_https       = true
+}
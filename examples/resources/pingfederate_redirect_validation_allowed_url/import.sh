@@ -0,0 +1 @@
+terraform import pingfederate_redirect_validation_allowed_url.callback app.bxretail.org/callback
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "pingfederate_redirect_validation_allowed_url" "callback" {
+  valid_domain             = "app.bxretail.org"
+  valid_path               = "/callback"
+  target_resource_sso      = true
+  target_resource_slo      = true
+  allow_query_and_fragment = true
+  require_https            = true
+}
+
+resource "pingfederate_redirect_validation_allowed_url" "uri" {
+  valid_uri           = "https://app.bxretail.org/*/logout"
+  target_resource_slo = true
+  in_error_resource   = true
+}
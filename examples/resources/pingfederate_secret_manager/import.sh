@@ -1 +1,4 @@
-terraform import pingfederate_secret_manager.secretManager secretManagerId
\ No newline at end of file
+terraform import pingfederate_secret_manager.secretManager secretManagerId
+
+# Alternatively, import by name
+terraform import pingfederate_secret_manager.secretManager "name:CyberArk Secret Manager"
@@ -0,0 +1 @@
+terraform import pingfederate_server_settings_outbound_provisioning.outboundProvisioning id
\ No newline at end of file
@@ -0,0 +1,18 @@
+resource "pingfederate_data_store" "provisionerDataStore" {
+  jdbc_data_store = {
+    name = "Outbound Provisioning Data Store"
+
+    connection_url = "jdbc:sqlserver://localhost;encrypt=true;integratedSecurity=true;"
+    driver_class   = "com.microsoft.sqlserver.jdbc.SQLServerDriver"
+
+    user_name = var.jdbc_data_store_username
+    password  = var.jdbc_data_store_password
+  }
+}
+
+resource "pingfederate_server_settings_outbound_provisioning" "outboundProvisioning" {
+  data_store_ref = {
+    id = pingfederate_data_store.provisionerDataStore.id
+  }
+  synchronization_frequency = 60
+}
@@ -1 +1,4 @@
-terraform import pingfederate_sp_adapter.spAdapter spAdapterId
\ No newline at end of file
+terraform import pingfederate_sp_adapter.spAdapter spAdapterId
+
+# Alternatively, import by name
+terraform import pingfederate_sp_adapter.spAdapter "name:OpenToken SP Adapter"
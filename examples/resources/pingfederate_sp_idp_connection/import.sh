@@ -1 +1,5 @@
-terraform import pingfederate_sp_idp_connection.spIdpConnection spIdpConnectionId
\ No newline at end of file
+terraform import pingfederate_sp_idp_connection.spIdpConnection spIdpConnectionId
+
+# Alternatively, import by entity ID or by name
+terraform import pingfederate_sp_idp_connection.spIdpConnection "entity_id:https://partner.example.com"
+terraform import pingfederate_sp_idp_connection.spIdpConnection "name:Partner Connection"
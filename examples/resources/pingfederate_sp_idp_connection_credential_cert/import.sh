@@ -0,0 +1 @@
+terraform import pingfederate_sp_idp_connection_credential_cert.cert "connectionId/certId"
\ No newline at end of file
@@ -0,0 +1,7 @@
+resource "pingfederate_sp_idp_connection_credential_cert" "partnerSigningCert" {
+  connection_id             = pingfederate_sp_idp_connection.idpConnection.connection_id
+  cert_id                   = "partnersigningcert"
+  file_data                 = filebase64("./assets/partner-signing-cert.pem")
+  active_verification_cert  = true
+  primary_verification_cert = true
+}
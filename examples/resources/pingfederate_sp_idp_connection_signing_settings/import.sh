@@ -0,0 +1 @@
+terraform import pingfederate_sp_idp_connection_signing_settings.signingSettings "connectionId"
\ No newline at end of file
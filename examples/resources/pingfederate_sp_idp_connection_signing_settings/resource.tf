@@ -0,0 +1,8 @@
+resource "pingfederate_sp_idp_connection_signing_settings" "signingSettings" {
+  connection_id = pingfederate_sp_idp_connection.idpConnection.connection_id
+  signing_key_pair_ref = {
+    id = pingfederate_keypairs_signing_key.signingKey.key_id
+  }
+  algorithm                 = "SHA256withRSA"
+  include_cert_in_signature = true
+}
@@ -0,0 +1 @@
+terraform import pingfederate_sp_target_url_mapping.example https://www.bxretail.org/acct101/
\ No newline at end of file
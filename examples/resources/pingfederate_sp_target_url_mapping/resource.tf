@@ -0,0 +1,7 @@
+resource "pingfederate_sp_target_url_mapping" "example" {
+  url  = "https://www.bxretail.org/acct101/"
+  type = "SP_ADAPTER"
+  ref = {
+    id = pingfederate_sp_adapter.reference_id.id
+  }
+}
@@ -0,0 +1 @@
+terraform import pingfederate_virtual_host_name.example auth.bxretail.org
\ No newline at end of file
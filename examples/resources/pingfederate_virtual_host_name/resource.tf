@@ -0,0 +1,3 @@
+resource "pingfederate_virtual_host_name" "example" {
+  virtual_host_name = "auth.bxretail.org"
+}
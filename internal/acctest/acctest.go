@@ -6,16 +6,22 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
 
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
+// Prefix for the IDs of objects created by the acceptance tests. The test sweepers delete leftover objects whose
+// ID or name starts with this prefix, so any object that a test leaves behind on failure should use it.
+const ResourceIdPrefix = "acctest"
+
 // Verify that any required environment variables are set before the test begins
 func ConfigurationPreCheck(t *testing.T) {
 	envVars := []string{
@@ -44,6 +50,34 @@ func ConfigurationPreCheck(t *testing.T) {
 	if errorFound {
 		t.FailNow()
 	}
+
+	configureCassette(t)
+}
+
+// Record or replay the HTTP requests made by the test when PINGFEDERATE_TF_CASSETTE_MODE is set to "record" or
+// "replay". Each test has its own cassette in the testdata/cassettes folder of the test package. Tests with no
+// recorded cassette are skipped in replay mode, or fail if PINGFEDERATE_TF_CASSETTE_REQUIRED is true.
+func configureCassette(t *testing.T) {
+	mode := os.Getenv("PINGFEDERATE_TF_CASSETTE_MODE")
+	if mode == "" {
+		return
+	}
+
+	cassettePath := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	if mode == api.CassetteModeReplay {
+		if _, err := os.Stat(cassettePath); err != nil {
+			if required, _ := strconv.ParseBool(os.Getenv("PINGFEDERATE_TF_CASSETTE_REQUIRED")); required {
+				t.Fatalf("No cassette recorded at %s. Record it against PingFederate with \"make testaccrecord\"", cassettePath)
+			}
+			t.Skipf("No cassette recorded at %s", cassettePath)
+		}
+	}
+	t.Setenv("PINGFEDERATE_TF_CASSETTE_PATH", cassettePath)
+	t.Cleanup(func() {
+		if err := api.CloseCassette(cassettePath); err != nil {
+			t.Errorf("Failed to save cassette %s: %v", cassettePath, err)
+		}
+	})
 }
 
 func GetTransport() *http.Transport {
@@ -67,7 +101,17 @@ func TestClient() *client.APIClient {
 		},
 	}
 
-	httpClient := &http.Client{Transport: GetTransport()}
+	var transport http.RoundTripper = GetTransport()
+	if cassettePath := os.Getenv("PINGFEDERATE_TF_CASSETTE_PATH"); cassettePath != "" {
+		strict, _ := strconv.ParseBool(os.Getenv("PINGFEDERATE_TF_CASSETTE_STRICT"))
+		cassette, err := api.OpenCassette(cassettePath, os.Getenv("PINGFEDERATE_TF_CASSETTE_MODE"), strict)
+		if err != nil {
+			panic(fmt.Sprintf("Failed to open cassette %s: %v", cassettePath, err))
+		}
+		transport = api.NewCassetteTransport(transport, cassette)
+	}
+
+	httpClient := &http.Client{Transport: transport}
 	clientConfig.HTTPClient = httpClient
 	return client.NewAPIClient(clientConfig)
 }
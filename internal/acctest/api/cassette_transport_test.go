@@ -0,0 +1,104 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/fakepf"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+func cassetteClient(t *testing.T, serverUrl string, server *fakepf.Server, cassettePath, mode string, strict bool) *client.APIClient {
+	cassette, err := api.OpenCassette(cassettePath, mode, strict)
+	if err != nil {
+		t.Fatalf("Failed to open cassette: %v", err)
+	}
+	clientConfig := client.NewConfiguration()
+	clientConfig.Servers = client.ServerConfigurations{
+		{
+			URL: serverUrl + fakepf.AdminApiPath,
+		},
+	}
+	httpClient := server.Client()
+	httpClient.Transport = api.NewCassetteTransport(httpClient.Transport, cassette)
+	clientConfig.HTTPClient = httpClient
+	return client.NewAPIClient(clientConfig)
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	ctx := config.BasicAuthContext(context.Background(), "administrator", "2FederateM0re")
+	server := fakepf.NewServer()
+	serverUrl := server.URL
+
+	// Record creating and reading an issuer
+	recordClient := cassetteClient(t, serverUrl, server, cassettePath, api.CassetteModeRecord, false)
+	issuer := client.NewIssuer("issuer", "example.com")
+	issuer.Id = client.PtrString("myissuer")
+	_, _, err := recordClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*issuer).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+	_, _, err = recordClient.OauthIssuersAPI.GetOauthIssuerById(ctx, "myissuer").Execute()
+	if err != nil {
+		t.Fatalf("Failed to read issuer: %v", err)
+	}
+
+	// Secrets should be scrubbed from the recording
+	_, _, err = recordClient.AdministrativeAccountsAPI.AddAccount(ctx).Body(client.AdministrativeAccount{
+		Username: "recorded",
+		Password: client.PtrString("2FederateM0re!"),
+	}).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create account: %v", err)
+	}
+	if err := api.CloseCassette(cassettePath); err != nil {
+		t.Fatalf("Failed to save cassette: %v", err)
+	}
+	server.Close()
+	recorded, err := os.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatalf("Failed to read cassette: %v", err)
+	}
+	if strings.Contains(string(recorded), "2FederateM0re!") {
+		t.Error("Expected the password to be scrubbed from the cassette")
+	}
+
+	// Replay the requests with the server stopped
+	replayClient := cassetteClient(t, serverUrl, server, cassettePath, api.CassetteModeReplay, false)
+	readIssuer, _, err := replayClient.OauthIssuersAPI.GetOauthIssuerById(ctx, "myissuer").Execute()
+	if err != nil {
+		t.Fatalf("Failed to replay reading issuer: %v", err)
+	}
+	if readIssuer.Host != "example.com" {
+		t.Errorf("Expected replayed host example.com, found %s", readIssuer.Host)
+	}
+	_, _, err = replayClient.OauthIssuersAPI.GetOauthIssuerById(ctx, "otherissuer").Execute()
+	if err == nil {
+		t.Error("Expected an error replaying a request that was not recorded")
+	}
+	if err := api.CloseCassette(cassettePath); err != nil {
+		t.Fatalf("Failed to close cassette: %v", err)
+	}
+
+	// Strict replay requires the requests in the recorded order
+	strictClient := cassetteClient(t, serverUrl, server, cassettePath, api.CassetteModeReplay, true)
+	_, _, err = strictClient.OauthIssuersAPI.GetOauthIssuerById(ctx, "myissuer").Execute()
+	if err == nil {
+		t.Error("Expected an error replaying requests out of order in strict mode")
+	}
+	if err := api.CloseCassette(cassettePath); err != nil {
+		t.Fatalf("Failed to close cassette: %v", err)
+	}
+	strictClient = cassetteClient(t, serverUrl, server, cassettePath, api.CassetteModeReplay, true)
+	_, _, err = strictClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*issuer).Execute()
+	if err != nil {
+		t.Errorf("Failed to replay creating issuer in strict mode: %v", err)
+	}
+	_ = api.CloseCassette(cassettePath)
+}
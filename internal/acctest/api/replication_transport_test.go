@@ -0,0 +1,140 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+)
+
+func TestIsConfigurationChange(t *testing.T) {
+	testCases := []struct {
+		method   string
+		path     string
+		status   int
+		expected bool
+	}{
+		{http.MethodPost, "/pf-admin-api/v1/oauth/clients", http.StatusCreated, true},
+		{http.MethodPut, "/pf-admin-api/v1/oauth/clients/myclient", http.StatusOK, true},
+		{http.MethodDelete, "/pf-admin-api/v1/oauth/clients/myclient", http.StatusNoContent, true},
+		{http.MethodPost, "/pf-admin-api/v1/keyPairs/signing/generate", http.StatusOK, true},
+		{http.MethodPost, "/pf-admin-api/v1/keyPairs/sslServer/mykey/csr", http.StatusOK, true},
+		{http.MethodPost, "/pf-admin-api/v1/bulk/import", http.StatusOK, true},
+		{http.MethodGet, "/pf-admin-api/v1/oauth/clients", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/oauth/clients", http.StatusUnprocessableEntity, false},
+		{http.MethodPost, "/pf-admin-api/v1/cluster/replicate", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/connectionMetadata/convert", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/connectionMetadata/export", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/dataStores/ldap/actions/test/invokeAction", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/idp/adapters/htmlform/actions/reset/invokeAction", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/keyPairs/signing/mykey/pkcs12", http.StatusOK, false},
+		{http.MethodPost, "/pf-admin-api/v1/keyPairs/sslServer/mykey/pem", http.StatusOK, false},
+	}
+
+	for _, testCase := range testCases {
+		req := httptest.NewRequest(testCase.method, "https://localhost:9999"+testCase.path, nil)
+		resp := &http.Response{StatusCode: testCase.status}
+		if actual := api.IsConfigurationChange(req, resp); actual != testCase.expected {
+			t.Errorf("Expected %s %s with status %d to return %t, found %t", testCase.method, testCase.path, testCase.status, testCase.expected, actual)
+		}
+	}
+}
+
+func TestReplicatorCoalescesChanges(t *testing.T) {
+	var replications atomic.Int32
+	var replicateStatus atomic.Int32
+	replicateStatus.Store(http.StatusOK)
+	var password atomic.Value
+	password.Store("2FederateM0re")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, requestPassword, _ := r.BasicAuth(); requestPassword != password.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/cluster/replicate") {
+			replications.Add(1)
+			w.WriteHeader(int(replicateStatus.Load()))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	replicator := api.NewReplicator()
+	httpClient := &http.Client{}
+	// Authenticate each request with the current password, as the provider does
+	post := func(ctx context.Context, path string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/pf-admin-api/v1"+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.SetBasicAuth("administrator", password.Load().(string))
+		return httpClient.Do(req)
+	}
+	httpClient.Transport = replicator.Transport(http.DefaultTransport, func(ctx context.Context) (*http.Response, error) {
+		return post(ctx, "/cluster/replicate")
+	})
+	change := func() {
+		resp, err := post(ctx, "/oauth/clients")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// Changes made while other resource changes are in progress are replicated once they have all completed
+	replicator.BeginChange()
+	replicator.BeginChange()
+	change()
+	if err := replicator.EndChange(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	change()
+	if replications.Load() != 0 {
+		t.Errorf("Expected no replication while changes are in progress, found %d", replications.Load())
+	}
+	if err := replicator.EndChange(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if replications.Load() != 1 {
+		t.Errorf("Expected 1 replication, found %d", replications.Load())
+	}
+
+	// Replication uses the current credentials, even when they changed after the configuration change
+	replicator.BeginChange()
+	change()
+	password.Store("updatedPassword")
+	if err := replicator.EndChange(ctx); err != nil {
+		t.Fatalf("Unexpected error replicating after a password change: %v", err)
+	}
+	if replications.Load() != 2 {
+		t.Errorf("Expected 2 replications, found %d", replications.Load())
+	}
+
+	// Resource changes that don't change the configuration are not replicated
+	replicator.BeginChange()
+	resp, err := post(ctx, "/connectionMetadata/convert")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if err := replicator.EndChange(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if replications.Load() != 2 {
+		t.Errorf("Expected 2 replications, found %d", replications.Load())
+	}
+
+	// Replication failures are returned
+	replicateStatus.Store(http.StatusInternalServerError)
+	replicator.BeginChange()
+	change()
+	if err := replicator.EndChange(ctx); err == nil {
+		t.Error("Expected an error when replication fails")
+	}
+}
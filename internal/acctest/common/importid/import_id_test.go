@@ -0,0 +1,132 @@
+package importid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
+)
+
+// Filter the connections by partial match, like the PingFederate listing endpoints
+func listConnections(ctx context.Context, prefix, value string) ([]importid.Object, *http.Response, error) {
+	var objects []importid.Object
+	for _, object := range []importid.Object{
+		{Id: "conn1", Name: "Partner", EntityId: "https://partner.example.com"},
+		{Id: "conn2", Name: "Mobile App", EntityId: "https://mobile.example.com"},
+		{Id: "conn3", Name: "Mobile App", EntityId: "https://mobile2.example.com"},
+		{Id: "conn4", Name: "Partner Portal", EntityId: "https://partner.example.com/portal"},
+	} {
+		if (prefix == importid.NamePrefix && strings.Contains(object.Name, value)) ||
+			(prefix == importid.EntityIdPrefix && strings.Contains(object.EntityId, value)) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil, nil
+}
+
+func TestResolveImportId(t *testing.T) {
+	testCases := []struct {
+		importId      string
+		expectedId    string
+		expectedError string
+	}{
+		{importId: "conn1", expectedId: "conn1"},
+		{importId: "name:Partner", expectedId: "conn1"},
+		{importId: "entity_id:https://mobile.example.com", expectedId: "conn2"},
+		{importId: "entity_id:https://partner.example.com", expectedId: "conn1"},
+		{importId: "name:Partner Portal", expectedId: "conn4"},
+		{importId: "name:Mobile App", expectedError: "conn2, conn3"},
+		{importId: "name:Other", expectedError: "No object found"},
+		{importId: "name:Mobile", expectedError: "No object found"},
+		{importId: "name:", expectedError: "must include a value"},
+	}
+	for _, testCase := range testCases {
+		var diags diag.Diagnostics
+		id, ok := importid.Resolve(context.Background(), testCase.importId, "pingfederate_idp_sp_connection", listConnections, &diags, importid.EntityIdPrefix, importid.NamePrefix)
+		if testCase.expectedError != "" {
+			if ok || !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), testCase.expectedError) {
+				t.Errorf("Expected error containing '%s' for import ID '%s', found %v", testCase.expectedError, testCase.importId, diags)
+			}
+			continue
+		}
+		if !ok || id != testCase.expectedId {
+			t.Errorf("Expected ID '%s' for import ID '%s', found '%s' with %v", testCase.expectedId, testCase.importId, id, diags)
+		}
+	}
+}
+
+func TestResolveImportIdUnsupportedPrefix(t *testing.T) {
+	var diags diag.Diagnostics
+	listCalled := false
+	list := func(ctx context.Context, prefix, value string) ([]importid.Object, *http.Response, error) {
+		listCalled = true
+		return nil, nil, errors.New("unexpected list")
+	}
+	_, ok := importid.Resolve(context.Background(), "entity_id:https://partner.example.com", "pingfederate_oauth_client", list, &diags, importid.NamePrefix)
+	if ok || !diags.HasError() || listCalled {
+		t.Errorf("Expected an unsupported prefix error without listing objects, found %v", diags)
+	}
+}
+
+// Page the matching objects like the PingFederate listing endpoints, with the exact match on the last page
+func listPagedConnections(listedPages *[]int64) importid.PageFunc {
+	var allObjects []importid.Object
+	for i := 0; i < 2*importid.PageSize+10; i++ {
+		allObjects = append(allObjects, importid.Object{Id: fmt.Sprintf("conn%d", i), Name: fmt.Sprintf("Partner %d", i)})
+	}
+	allObjects = append(allObjects, importid.Object{Id: "exact", Name: "Partner"})
+
+	return func(ctx context.Context, prefix, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		*listedPages = append(*listedPages, page)
+		var matches []importid.Object
+		for _, object := range allObjects {
+			if strings.Contains(object.Name, value) {
+				matches = append(matches, object)
+			}
+		}
+		start := (page - 1) * numberPerPage
+		if start >= int64(len(matches)) {
+			return nil, nil, nil
+		}
+		end := min(start+numberPerPage, int64(len(matches)))
+		return matches[start:end], nil, nil
+	}
+}
+
+func TestResolveImportIdPaged(t *testing.T) {
+	var diags diag.Diagnostics
+	var listedPages []int64
+	id, ok := importid.Resolve(context.Background(), "name:Partner", "pingfederate_idp_sp_connection", importid.ListPages(listPagedConnections(&listedPages)), &diags, importid.NamePrefix)
+	if !ok || id != "exact" {
+		t.Errorf("Expected ID 'exact' for import ID 'name:Partner', found '%s' with %v", id, diags)
+	}
+	if len(listedPages) != 3 {
+		t.Errorf("Expected 3 pages to be listed, found %v", listedPages)
+	}
+}
+
+func TestResolveImportIdPagingIgnored(t *testing.T) {
+	// An endpoint that ignores the page parameter returns the same full page every time
+	var fullPage []importid.Object
+	for i := 0; i < importid.PageSize; i++ {
+		fullPage = append(fullPage, importid.Object{Id: fmt.Sprintf("conn%d", i), Name: fmt.Sprintf("Partner %d", i)})
+	}
+	listedPages := 0
+	listPage := func(ctx context.Context, prefix, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		listedPages++
+		return fullPage, nil, nil
+	}
+	var diags diag.Diagnostics
+	id, ok := importid.Resolve(context.Background(), "name:Partner 7", "pingfederate_idp_sp_connection", importid.ListPages(listPage), &diags, importid.NamePrefix)
+	if !ok || id != "conn7" {
+		t.Errorf("Expected ID 'conn7' for import ID 'name:Partner 7', found '%s' with %v", id, diags)
+	}
+	if listedPages != 2 {
+		t.Errorf("Expected listing to stop after a repeated page, found %d pages listed", listedPages)
+	}
+}
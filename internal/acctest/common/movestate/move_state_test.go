@@ -0,0 +1,109 @@
+package movestate_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const communityProviderAddress = "registry.terraform.io/iwarapter/pingfederate"
+
+func moveState(t *testing.T, sourceProviderAddress, sourceTypeName, targetTypeName, sourceState string) (map[string]tftypes.Value, *tfprotov6.MoveResourceStateResponse) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(provider.NewTestProvider())()
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Failed to get provider schema: %v", err)
+	}
+	resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: sourceProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &tfprotov6.RawState{JSON: []byte(sourceState)},
+		TargetTypeName:        targetTypeName,
+	})
+	if err != nil {
+		t.Fatalf("Failed to move state: %v", err)
+	}
+	if resp.TargetState == nil {
+		return nil, resp
+	}
+	targetState, err := resp.TargetState.Unmarshal(schemaResp.ResourceSchemas[targetTypeName].ValueType())
+	if err != nil {
+		t.Fatalf("Failed to parse moved state: %v", err)
+	}
+	var attributes map[string]tftypes.Value
+	if err := targetState.As(&attributes); err != nil {
+		t.Fatalf("Failed to read moved state: %v", err)
+	}
+	return attributes, resp
+}
+
+func hasErrors(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func expectString(t *testing.T, attributes map[string]tftypes.Value, name, expected string) {
+	var value *string
+	if err := attributes[name].As(&value); err != nil {
+		t.Fatalf("Failed to read %s: %v", name, err)
+	}
+	if expected == "" && value != nil {
+		t.Errorf("Expected %s to be null, found %s", name, *value)
+	}
+	if expected != "" && (value == nil || *value != expected) {
+		t.Errorf("Expected %s to be %s, found %v", name, expected, value)
+	}
+}
+
+func TestMoveStateFromCommunityProvider(t *testing.T) {
+	attributes, resp := moveState(t, communityProviderAddress, "pingfederate_oauth_client", "pingfederate_oauth_client",
+		`{"id": "myclient", "client_id": "myclient", "name": "My Client"}`)
+	if hasErrors(resp.Diagnostics) || attributes == nil {
+		t.Fatalf("Unexpected errors moving state: %v", resp.Diagnostics)
+	}
+	expectString(t, attributes, "client_id", "myclient")
+	expectString(t, attributes, "name", "")
+	// The read following the move should be handled as an import
+	if !strings.Contains(string(resp.TargetPrivate), `"import"`) {
+		t.Errorf("Expected the moved state to be marked for import, found private state %s", string(resp.TargetPrivate))
+	}
+
+	// Key pair settings that PingFederate doesn't return are copied, ignoring empty values
+	attributes, resp = moveState(t, communityProviderAddress, "pingfederate_keypair_signing", "pingfederate_keypairs_signing_key",
+		`{"id": "mykey", "file_data": "ZmlsZWRhdGE=", "password": "2FederateM0re", "common_name": "", "valid_days": 0}`)
+	if hasErrors(resp.Diagnostics) || attributes == nil {
+		t.Fatalf("Unexpected errors moving state: %v", resp.Diagnostics)
+	}
+	expectString(t, attributes, "key_id", "mykey")
+	expectString(t, attributes, "file_data", "ZmlsZWRhdGE=")
+	expectString(t, attributes, "password", "2FederateM0re")
+	expectString(t, attributes, "common_name", "")
+	if !attributes["valid_days"].IsNull() {
+		t.Errorf("Expected valid_days to be null, found %s", attributes["valid_days"])
+	}
+}
+
+func TestMoveStateUnsupportedSource(t *testing.T) {
+	// State from other providers is not moved
+	_, resp := moveState(t, "registry.terraform.io/example/pingfederate", "pingfederate_oauth_client", "pingfederate_oauth_client",
+		`{"id": "myclient"}`)
+	if !hasErrors(resp.Diagnostics) {
+		t.Error("Expected an error moving state from an unsupported provider")
+	}
+
+	// The source state must include the ID
+	_, resp = moveState(t, communityProviderAddress, "pingfederate_idp_adapter", "pingfederate_idp_adapter", `{"name": "adapter"}`)
+	if !hasErrors(resp.Diagnostics) {
+		t.Error("Expected an error moving state without an id")
+	}
+}
@@ -0,0 +1,74 @@
+package ondestroy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
+)
+
+// Build a delete request for a singleton resource with the given on_destroy value in state
+func deleteRequest(canReset bool, onDestroy *string) resource.DeleteRequest {
+	s := schema.Schema{Attributes: map[string]schema.Attribute{}}
+	ondestroy.ToSchema(&s, canReset)
+	var value tftypes.Value
+	if onDestroy == nil {
+		value = tftypes.NewValue(tftypes.String, nil)
+	} else {
+		value = tftypes.NewValue(tftypes.String, *onDestroy)
+	}
+	return resource.DeleteRequest{
+		State: tfsdk.State{
+			Schema: s,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"on_destroy": tftypes.String}},
+				map[string]tftypes.Value{"on_destroy": value}),
+		},
+	}
+}
+
+func TestDelete(t *testing.T) {
+	retain := ondestroy.Retain
+	resetToDefault := ondestroy.ResetToDefault
+	testCases := []struct {
+		name          string
+		canReset      bool
+		onDestroy     *string
+		expectedReset bool
+	}{
+		{name: "null in state resets a resource that can be reset", canReset: true, onDestroy: nil, expectedReset: true},
+		{name: "null in state retains a resource that can't be reset", canReset: false, onDestroy: nil, expectedReset: false},
+		{name: "retain", canReset: true, onDestroy: &retain, expectedReset: false},
+		{name: "reset_to_default", canReset: true, onDestroy: &resetToDefault, expectedReset: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resetCalled := false
+			var reset func(ctx context.Context) (*http.Response, error)
+			if testCase.canReset {
+				reset = func(ctx context.Context) (*http.Response, error) {
+					resetCalled = true
+					return nil, nil
+				}
+			}
+			restore := func(ctx context.Context, original map[string]any) (*http.Response, error) {
+				t.Fatal("unexpected restore of the original configuration")
+				return nil, nil
+			}
+
+			var resp resource.DeleteResponse
+			ondestroy.Delete(context.Background(), deleteRequest(testCase.canReset, testCase.onDestroy), &resp, "pingfederate_example", reset, restore)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+			}
+			if resetCalled != testCase.expectedReset {
+				t.Errorf("expected reset to be called: %t, got %t", testCase.expectedReset, resetCalled)
+			}
+		})
+	}
+}
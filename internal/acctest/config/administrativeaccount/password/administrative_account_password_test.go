@@ -0,0 +1,186 @@
+package administrativeaccountpassword_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+const accountUsername = "passwordRotationUser"
+const providerAccountUsername = "providerRotationUser"
+
+func TestAccAdministrativeAccountPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: administrativeAccountPassword_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Reset to a generated password
+				Config: administrativeAccountPassword_GeneratedHCL("initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_administrative_account_password.example", "new_password_version", "initial"),
+					resource.TestCheckNoResourceAttr("pingfederate_administrative_account_password.example", "new_password"),
+				),
+			},
+			{
+				// The ephemeral password changes on every run, but only a new version sets it again
+				Config:   administrativeAccountPassword_GeneratedHCL("initial"),
+				PlanOnly: true,
+			},
+			{
+				// Reset to a supplied password
+				Config: administrativeAccountPassword_SuppliedHCL("2FederateM0re!Rotated", "supplied"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pingfederate_administrative_account_password.example", "new_password"),
+					administrativeAccountPassword_CheckAuthentication(accountUsername, "2FederateM0re!Rotated"),
+				),
+			},
+			{
+				// Changing the write-only password alone should not set it again
+				Config:   administrativeAccountPassword_SuppliedHCL("2FederateM0re!Ignored", "supplied"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAdministrativeAccountPassword_RotateProviderCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: administrativeAccountPassword_CheckProviderAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Rotate the password of the account used by the aliased provider
+				Config: administrativeAccountPassword_RotateProviderHCL("2FederateM0re!Initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_administrative_account_password.rotate", "rotate_provider_credentials", "true"),
+					administrativeAccountPassword_CheckAuthentication(providerAccountUsername, "2FederateM0re!Rotated"),
+				),
+			},
+			{
+				// The aliased provider must be configured with the new password on the next run
+				Config: administrativeAccountPassword_RotateProviderHCL("2FederateM0re!Rotated"),
+				Check:  administrativeAccountPassword_CheckAuthentication(providerAccountUsername, "2FederateM0re!Rotated"),
+			},
+		},
+	})
+}
+
+func administrativeAccountHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_administrative_account" "example" {
+  username = "%s"
+  password = "2FederateM0re!"
+  roles    = ["USER_ADMINISTRATOR"]
+  lifecycle {
+    ignore_changes = [encrypted_password]
+  }
+}
+`, accountUsername)
+}
+
+func administrativeAccountPassword_GeneratedHCL(passwordVersion string) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "pingfederate_administrative_account_generated_password" "example" {
+}
+
+resource "pingfederate_administrative_account_password" "example" {
+  username             = pingfederate_administrative_account.example.username
+  new_password         = ephemeral.pingfederate_administrative_account_generated_password.example.password
+  new_password_version = "%s"
+}
+`, administrativeAccountHCL(), passwordVersion)
+}
+
+func administrativeAccountPassword_SuppliedHCL(password, passwordVersion string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "pingfederate_administrative_account_password" "example" {
+  username             = pingfederate_administrative_account.example.username
+  new_password         = "%s"
+  new_password_version = "%s"
+}
+`, administrativeAccountHCL(), password, passwordVersion)
+}
+
+func administrativeAccountPassword_RotateProviderHCL(providerPassword string) string {
+	return fmt.Sprintf(`
+provider "pingfederate" {
+  alias    = "rotation"
+  username = "%[1]s"
+  password = "%[2]s"
+}
+
+resource "pingfederate_administrative_account" "provider_account" {
+  username = "%[1]s"
+  password = "2FederateM0re!Initial"
+  roles    = ["ADMINISTRATOR", "USER_ADMINISTRATOR"]
+  lifecycle {
+    ignore_changes = [encrypted_password]
+  }
+}
+
+resource "pingfederate_administrative_account_password" "rotate" {
+  provider                    = pingfederate.rotation
+  username                    = pingfederate_administrative_account.provider_account.username
+  new_password                = "2FederateM0re!Rotated"
+  rotate_provider_credentials = true
+}
+`, providerAccountUsername, providerPassword)
+}
+
+// Test that an account can authenticate with the given password
+func administrativeAccountPassword_CheckAuthentication(username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		_, _, err := testClient.AdministrativeAccountsAPI.GetAccount(config.BasicAuthContext(context.Background(), username, password), username).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to authenticate as %s with the rotated password: %w", username, err)
+		}
+		return nil
+	}
+}
+
+// Test that the administrative account is removed along with the password resource
+func administrativeAccountPassword_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, _, err := testClient.AdministrativeAccountsAPI.GetAccount(acctest.TestBasicAuthContext(), accountUsername).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Administrative Account", accountUsername)
+	}
+	return nil
+}
+
+// Test that the provider's administrative account is removed along with the password resource
+func administrativeAccountPassword_CheckProviderAccountDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, _, err := testClient.AdministrativeAccountsAPI.GetAccount(acctest.TestBasicAuthContext(), providerAccountUsername).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("Administrative Account", providerAccountUsername)
+	}
+	return nil
+}
@@ -38,7 +38,7 @@ func TestAccAuthenticationApiApplication(t *testing.T) {
 		clientForRedirectlessModeRef: nil,
 	}
 
-	clientForRedirectlessModeRefResourceLink := client.NewResourceLink("myOauthClientExample")
+	clientForRedirectlessModeRefResourceLink := client.NewResourceLink("acctestAuthnApiAppClient")
 
 	updatedResourceModel := authenticationApiApplicationResourceModel{
 		applicationId:                authenticationApiApplicationId,
@@ -119,7 +119,7 @@ func optionalHcl(model authenticationApiApplicationResourceModel) string {
 	if model.clientForRedirectlessModeRef != nil {
 		clientForRedirectlessModeRef = `
 	client_for_redirectless_mode_ref = {
-	  id = pingfederate_oauth_client.myOauthClientExample.id
+	  id = pingfederate_oauth_client.acctestAuthnApiAppClient.id
 	}`
 	}
 
@@ -134,9 +134,9 @@ func optionalHcl(model authenticationApiApplicationResourceModel) string {
 func testAccAuthenticationApiApplication(resourceName string, resourceModel authenticationApiApplicationResourceModel) string {
 	optionalFields := optionalHcl(resourceModel)
 	return fmt.Sprintf(`
-resource "pingfederate_oauth_client" "myOauthClientExample" {
-  client_id                     = "myOauthClientExample"
-  name                          = "myOauthClientExample"
+resource "pingfederate_oauth_client" "acctestAuthnApiAppClient" {
+  client_id                     = "acctestAuthnApiAppClient"
+  name                          = "acctestAuthnApiAppClient"
   grant_types                   = ["EXTENSION"]
   allow_authentication_api_init = true
 }
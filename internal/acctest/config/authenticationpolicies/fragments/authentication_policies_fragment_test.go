@@ -18,7 +18,7 @@ import (
 var pingOneConnection, pingOneEnvironment, pingOnePopulation string
 
 func TestAccAuthenticationPoliciesFragment(t *testing.T) {
-	resourceName := "myAuthenticationPoliciesFragment"
+	resourceName := "acctestFragment"
 
 	pingOneConnection = os.Getenv("PF_TF_P1_CONNECTION_ID")
 	pingOneEnvironment = os.Getenv("PF_TF_P1_CONNECTION_ENV_ID")
@@ -327,7 +327,7 @@ func additionalAttributeContractFulfillments() string {
 func dependencyHcl() string {
 	return fmt.Sprintf(`
 resource "pingfederate_authentication_policy_contract" "mycontract" {
-  contract_id = "fragmentVerifyReg"
+  contract_id = "acctestFragmentVerifyReg"
   name        = "Fragment - Verify - Registration"
   extended_attributes = [
     {
@@ -349,7 +349,7 @@ resource "pingfederate_authentication_policy_contract" "mycontract" {
 }
 
 resource "pingfederate_idp_adapter" "myadapter" {
-  adapter_id = "PingOneVerify"
+  adapter_id = "acctestPingOneVerify"
   name       = "PingOneVerify (GovID)"
   plugin_descriptor_ref = {
     id = "com.pingidentity.adapters.pingone.verify.PingOneVerifyAdapter"
@@ -25,18 +25,18 @@ type authenticationPolicyContractResourceModel struct {
 func TestAccAuthenticationPolicyContract(t *testing.T) {
 	resourceName := "myAuthenticationPolicyContract"
 	initialResourceModel := authenticationPolicyContractResourceModel{
-		name:               "example",
+		name:               "acctestContract",
 		extendedAttributes: []string{},
 	}
 	updatedResourceModel := authenticationPolicyContractResourceModel{
-		name:               "example",
+		name:               "acctestContract",
 		extendedAttributes: []string{"extended_attribute", "extended_attribute2", "extendedwith\\\"escaped\\\"quotes"},
 	}
 
 	minimalResourceModelWithId := authenticationPolicyContractResourceModel{
-		name:               "example",
+		name:               "acctestContract",
 		extendedAttributes: []string{},
-		id:                 "myAuthenticationPolicyContract",
+		id:                 "acctestAuthnPolicyContract",
 	}
 
 	resource.Test(t, resource.TestCase{
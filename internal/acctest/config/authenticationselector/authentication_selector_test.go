@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const authenticationSelectorsId = "selectorTest"
+const authenticationSelectorsId = "acctestSelector"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type authenticationSelectorsResourceModel struct {
@@ -0,0 +1,65 @@
+package bulkexport_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccBulkExport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Run the export and validate the results
+				Config: bulkExport_MinimalHCL(),
+				Check:  bulkExport_CheckComputedValues(),
+			},
+			{
+				// Run the export with encrypted values redacted
+				Config: bulkExport_RedactedHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					bulkExport_CheckComputedValues(),
+					resource.TestMatchResourceAttr("data.pingfederate_bulk_export.example", "exported_json", regexp.MustCompile(`"encryptedPassword": "REDACTED"`)),
+					resource.TestCheckResourceAttr("data.pingfederate_bulk_export.example", "include_external_resources", "true"),
+				),
+			},
+		},
+	})
+}
+
+// Minimal HCL with only required values set
+func bulkExport_MinimalHCL() string {
+	return `
+data "pingfederate_bulk_export" "example" {
+}
+`
+}
+
+func bulkExport_RedactedHCL() string {
+	return `
+data "pingfederate_bulk_export" "example" {
+  include_external_resources = true
+  redact_encrypted_values    = true
+}
+`
+}
+
+// Validate any computed values when applying HCL
+func bulkExport_CheckComputedValues() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.pingfederate_bulk_export.example", "pf_version"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_bulk_export.example", "exported_json"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_bulk_export.example", "operation_counts.SAVE"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_bulk_export.example", "operations.0.resource_type"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_bulk_export.example", "operations.0.item_count"),
+	)
+}
@@ -33,7 +33,7 @@ func TestAccCertificate(t *testing.T) {
 	}
 	minimalResourceModel := certificatesResourceModel{
 		fileData: fileData,
-		id:       "mycertificateca",
+		id:       "acctestcertificateca",
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,74 @@
+package clusterreplication_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccClusterReplication(t *testing.T) {
+	// Check if the server is running in clustered mode or not
+	testClient := acctest.TestClient()
+	_, _, err := testClient.ClusterAPI.GetClusterStatus(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		// This server must not be in clustered mode, so replication should fail
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      clusterReplication_HCL("initial"),
+					ExpectError: regexp.MustCompile("An error occurred while replicating the cluster configuration"),
+				},
+			},
+		})
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Replicate and wait for the nodes to sync
+				Config: clusterReplication_HCL("initial"),
+				Check:  clusterReplication_CheckComputedValues(),
+			},
+			{
+				// Changing a trigger value should replicate again
+				Config: clusterReplication_HCL("updated"),
+				Check:  clusterReplication_CheckComputedValues(),
+			},
+		},
+	})
+}
+
+func clusterReplication_HCL(triggerValue string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_cluster_replication" "example" {
+  replication_trigger_values = {
+    "trigger" : "%s"
+  }
+  sync_timeout_seconds = 120
+}
+`, triggerValue)
+}
+
+// Validate any computed values when applying HCL
+func clusterReplication_CheckComputedValues() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("pingfederate_cluster_replication.example", "wait_for_sync", "true"),
+		resource.TestCheckResourceAttrSet("pingfederate_cluster_replication.example", "result_message"),
+		resource.TestCheckResourceAttrSet("pingfederate_cluster_replication.example", "last_replication_time"),
+	)
+}
@@ -0,0 +1,100 @@
+package clusterreplication_test
+
+import (
+	"testing"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	clusterreplication "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/replication"
+)
+
+func clusterNode(index int64, replicationStatus, configSyncStatus string) client.ClusterNode {
+	node := client.ClusterNode{
+		Index:   pointers.Int64(index),
+		Address: pointers.String("10.0.0.1:7600"),
+	}
+	if replicationStatus != "" {
+		node.ReplicationStatus = pointers.String(replicationStatus)
+	}
+	if configSyncStatus != "" {
+		node.AdminConsoleInfo = &client.AdminConsoleInfo{
+			ConfigSyncStatus: pointers.String(configSyncStatus),
+		}
+	}
+	return node
+}
+
+func TestOutOfSyncNodes(t *testing.T) {
+	testCases := []struct {
+		name            string
+		status          client.ClusterStatus
+		expectedPending string
+		expectError     bool
+	}{
+		{
+			name: "InSync",
+			status: client.ClusterStatus{
+				ReplicationRequired: pointers.Bool(false),
+				Nodes: []client.ClusterNode{
+					clusterNode(0, "", "SUCCEEDED"),
+					clusterNode(1, "SUCCEEDED", ""),
+					clusterNode(2, "", "NONE"),
+				},
+			},
+		},
+		{
+			name: "ReplicationRequired",
+			status: client.ClusterStatus{
+				ReplicationRequired: pointers.Bool(true),
+			},
+			expectedPending: "replication is still required",
+		},
+		{
+			name: "NodesPending",
+			status: client.ClusterStatus{
+				Nodes: []client.ClusterNode{
+					clusterNode(0, "", "IN_PROGRESS"),
+					clusterNode(1, "IN_PROGRESS", ""),
+				},
+			},
+			expectedPending: "node 0 (10.0.0.1:7600) has config sync status IN_PROGRESS, node 1 (10.0.0.1:7600) has replication status IN_PROGRESS",
+		},
+		{
+			name: "ReplicationFailed",
+			status: client.ClusterStatus{
+				Nodes: []client.ClusterNode{
+					clusterNode(0, "IN_PROGRESS", ""),
+					clusterNode(1, "FAILED", ""),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "ConfigSyncFailed",
+			status: client.ClusterStatus{
+				Nodes: []client.ClusterNode{
+					clusterNode(0, "", "FAILED"),
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pending, err := clusterreplication.OutOfSyncNodes(&testCase.status)
+			if testCase.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, found pending nodes '%s'", pending)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if pending != testCase.expectedPending {
+				t.Errorf("Expected pending nodes '%s', found '%s'", testCase.expectedPending, pending)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package configarchiveexport_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccConfigArchiveExport(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "config-archive.zip")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Run the export and validate the results
+				Config: configArchiveExport_MinimalHCL(outputPath),
+				Check:  configArchiveExport_CheckComputedValues(outputPath),
+			},
+		},
+	})
+}
+
+// Minimal HCL with only required values set
+func configArchiveExport_MinimalHCL(outputPath string) string {
+	return fmt.Sprintf(`
+data "pingfederate_config_archive_export" "example" {
+  output_path = "%s"
+}
+`, outputPath)
+}
+
+// Validate any computed values when applying HCL
+func configArchiveExport_CheckComputedValues(outputPath string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.pingfederate_config_archive_export.example", "output_path", outputPath),
+		resource.TestCheckResourceAttrSet("data.pingfederate_config_archive_export.example", "output_sha256"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_config_archive_export.example", "output_size"),
+	)
+}
@@ -0,0 +1,87 @@
+package configarchiveimport_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccConfigArchiveImport(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "config-archive.zip")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Export the current configuration once, so the archive and its checksum stay the same for the rest
+				// of the test. An archive exported by the data source would change on every refresh.
+				PreConfig: func() {
+					configArchiveImport_WriteArchive(t, archivePath)
+				},
+				// The import must be explicitly confirmed
+				Config:      configArchiveImport_HCL(archivePath, false),
+				ExpectError: regexp.MustCompile("confirm_overwrite must be set to true"),
+			},
+			{
+				// Import the exported configuration
+				Config: configArchiveImport_HCL(archivePath, true),
+				Check:  configArchiveImport_CheckComputedValues(),
+			},
+			{
+				// The archive is not imported again while it is unchanged
+				Config:   configArchiveImport_HCL(archivePath, true),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func configArchiveImport_HCL(archivePath string, confirmOverwrite bool) string {
+	return fmt.Sprintf(`
+resource "pingfederate_config_archive_import" "example" {
+  file_path         = "%[1]s"
+  confirm_overwrite = %[2]t
+  re_encrypt        = true
+  import_trigger_values = {
+    "archive_sha256" = filesha256("%[1]s")
+  }
+}
+`, archivePath, confirmOverwrite)
+}
+
+// Write the current configuration archive of the server to the given path
+func configArchiveImport_WriteArchive(t *testing.T, archivePath string) {
+	testClient := acctest.TestClient()
+	httpResp, err := testClient.ConfigArchiveAPI.ExportConfigArchive(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		t.Fatalf("Failed to export the configuration archive: %v", err)
+	}
+	defer httpResp.Body.Close()
+	archive, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read the configuration archive: %v", err)
+	}
+	if err := os.WriteFile(archivePath, archive, 0600); err != nil {
+		t.Fatalf("Failed to write the configuration archive: %v", err)
+	}
+}
+
+// Validate any computed values when applying HCL
+func configArchiveImport_CheckComputedValues() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttrSet("pingfederate_config_archive_import.example", "result_message"),
+		resource.TestCheckResourceAttr("pingfederate_config_archive_import.example", "force_import", "false"),
+		resource.TestCheckResourceAttr("pingfederate_config_archive_import.example", "force_unsupported_import", "false"),
+	)
+}
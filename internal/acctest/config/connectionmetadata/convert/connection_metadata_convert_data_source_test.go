@@ -0,0 +1,58 @@
+package connectionmetadataconvert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const spEntityId = "https://sp.bxretail.org/convertTest"
+
+func TestAccConnectionMetadataConvertDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: connectionMetadataConvert_HCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingfederate_connection_metadata_convert.example", "entity_id", spEntityId),
+					resource.TestCheckResourceAttr("data.pingfederate_connection_metadata_convert.example", "signature_status", "UNSIGNED"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_connection_metadata_convert.example", "name"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_connection_metadata_convert.example", "connection_json"),
+					resource.TestCheckResourceAttr("data.pingfederate_connection_metadata_convert.example", "sp_browser_sso.sso_service_endpoints.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingfederate_connection_metadata_convert.example", "sp_browser_sso.sso_service_endpoints.*", map[string]string{
+						"binding":    "POST",
+						"url":        "https://sp.bxretail.org/sp/ACS.saml2",
+						"index":      "0",
+						"is_default": "true",
+					}),
+					resource.TestCheckNoResourceAttr("data.pingfederate_connection_metadata_convert.example", "idp_browser_sso.%"),
+				),
+			},
+		},
+	})
+}
+
+func connectionMetadataConvert_HCL() string {
+	return fmt.Sprintf(`
+data "pingfederate_connection_metadata_convert" "example" {
+  connection_type    = "SP"
+  expected_entity_id = "%[1]s"
+  saml_metadata      = <<EOT
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%[1]s">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.bxretail.org/sp/ACS.saml2" index="0" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>
+EOT
+}
+`, spEntityId)
+}
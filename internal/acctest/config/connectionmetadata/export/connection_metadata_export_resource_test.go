@@ -37,7 +37,7 @@ func TestAccConnectionMetadataExport(t *testing.T) {
 func spConnectionHcl() string {
 	return `
 resource "pingfederate_idp_sp_connection" "spConnection" {
-  connection_id      = "metadataExportConnection"
+  connection_id      = "acctestMetadataExportConn"
   name               = "connection"
   entity_id          = "entity"
   active             = true
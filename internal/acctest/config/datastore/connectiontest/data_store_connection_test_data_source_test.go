@@ -0,0 +1,62 @@
+package datastoreconnectiontest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccDataStoreConnectionTest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Test the default data store
+				Config: dataStoreConnectionTest_SingleHCL(),
+				Check:  dataStoreConnectionTest_CheckComputedValues(),
+			},
+			{
+				// Test all data stores
+				Config: dataStoreConnectionTest_AllHCL(),
+				Check:  resource.TestCheckResourceAttrSet("data.pingfederate_data_store_connection_test.example", "all_succeeded"),
+			},
+		},
+	})
+}
+
+func dataStoreConnectionTest_SingleHCL() string {
+	return `
+data "pingfederate_data_store_connection_test" "example" {
+  data_store_ids = ["ProvisionerDS"]
+}
+`
+}
+
+func dataStoreConnectionTest_AllHCL() string {
+	return `
+data "pingfederate_data_store_connection_test" "example" {
+}
+`
+}
+
+// Validate any computed values when applying HCL
+func dataStoreConnectionTest_CheckComputedValues() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "all_succeeded", "true"),
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "results.#", "1"),
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "results.0.data_store_id", "ProvisionerDS"),
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "results.0.data_store_type", "JDBC"),
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "results.0.testable", "true"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_data_store_connection_test.example", "results.0.action_id"),
+		resource.TestCheckResourceAttr("data.pingfederate_data_store_connection_test.example", "results.0.success", "true"),
+		resource.TestCheckResourceAttrSet("data.pingfederate_data_store_connection_test.example", "results.0.latency_ms"),
+		resource.TestCheckNoResourceAttr("data.pingfederate_data_store_connection_test.example", "results.0.error_detail"),
+	)
+}
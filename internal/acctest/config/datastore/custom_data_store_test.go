@@ -15,7 +15,7 @@ import (
 )
 
 // These variables cannot be modified due to resource dependent values
-const customDataStoreId = "customDataStoreId"
+const customDataStoreId = "acctestCustomDataStore"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type customDataStoreResourceModel struct {
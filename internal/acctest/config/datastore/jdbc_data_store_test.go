@@ -16,7 +16,7 @@ import (
 )
 
 // These variables cannot be modified due to resource dependent values
-const jdbcDataStoreId = "jdbcDataStoreId"
+const jdbcDataStoreId = "acctestJdbcDataStore"
 const driverClass = "org.hsqldb.jdbcDriver"
 const userName = "sa"
 const password = "secretpass"
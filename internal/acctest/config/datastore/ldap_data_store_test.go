@@ -18,7 +18,7 @@ import (
 )
 
 // These variables cannot be modified due to resource dependent values
-const ldapDataStoreId = "ldapDataStoreId"
+const ldapDataStoreId = "acctestLdapDataStore"
 const dataStoreType = "LDAP"
 const ldapType = "PING_DIRECTORY"
 const verifyHost = false
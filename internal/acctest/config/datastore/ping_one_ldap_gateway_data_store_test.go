@@ -19,7 +19,7 @@ import (
 )
 
 // These variables cannot be modified due to resource dependent values
-const pingOneLdapGatewayDataStoreId = "pingOneLdapGatewayDataStoreId"
+const pingOneLdapGatewayDataStoreId = "acctestP1LdapGatewayDataStore"
 const pingOneLdapGDSType = "PING_ONE_LDAP_GATEWAY"
 const ldapTypeVal = "PING_DIRECTORY"
 
@@ -0,0 +1,168 @@
+package extendedproperty_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	extendedPropertyName = "extendedPropertyName"
+	// Property that is not managed by terraform, which should be left in place
+	unmanagedExtendedPropertyName = "unmanagedExtendedPropertyName"
+)
+
+func TestAccExtendedProperty_MinimalMaximal(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: extendedProperty_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Create the resource with a minimal model
+				PreConfig: func() {
+					extendedProperty_Modify(t, func(items []client.ExtendedProperty) []client.ExtendedProperty {
+						return append(items, client.ExtendedProperty{
+							Name: pointers.String(unmanagedExtendedPropertyName),
+						})
+					})
+				},
+				Config: extendedProperty_MinimalHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_extended_property.example", "id", extendedPropertyName),
+					resource.TestCheckResourceAttr("pingfederate_extended_property.example", "multi_valued", "false"),
+					resource.TestCheckNoResourceAttr("pingfederate_extended_property.example", "description"),
+				),
+			},
+			{
+				// Update to a complete model
+				Config: extendedProperty_CompleteHCL(),
+				Check:  extendedProperty_CheckAttributes("Updated description", true),
+			},
+			{
+				// Test importing the resource
+				Config:                               extendedProperty_CompleteHCL(),
+				ResourceName:                         "pingfederate_extended_property.example",
+				ImportStateId:                        extendedPropertyName,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				// Back to minimal model
+				Config: extendedProperty_MinimalHCL(),
+				Check:  extendedProperty_CheckAttributes("", false),
+			},
+		},
+	})
+}
+
+// Minimal HCL with only required values set
+func extendedProperty_MinimalHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_extended_property" "example" {
+  name = "%s"
+}
+`, extendedPropertyName)
+}
+
+// Maximal HCL with all values set where possible
+func extendedProperty_CompleteHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_extended_property" "example" {
+  name         = "%s"
+  description  = "Updated description"
+  multi_valued = true
+}
+`, extendedPropertyName)
+}
+
+func getExtendedProperties() ([]client.ExtendedProperty, error) {
+	testClient := acctest.TestClient()
+	response, _, err := testClient.ExtendedPropertiesAPI.GetExtendedProperties(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+func findExtendedProperty(items []client.ExtendedProperty, name string) *client.ExtendedProperty {
+	i := slices.IndexFunc(items, func(item client.ExtendedProperty) bool {
+		return item.GetName() == name
+	})
+	if i < 0 {
+		return nil
+	}
+	return &items[i]
+}
+
+// Validate that the expected attributes are set on the PingFederate server
+func extendedProperty_CheckAttributes(description string, multiValued bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "ExtendedProperty"
+		resourceName := extendedPropertyName
+		items, err := getExtendedProperties()
+		if err != nil {
+			return err
+		}
+
+		property := findExtendedProperty(items, extendedPropertyName)
+		if property == nil {
+			return fmt.Errorf("extended property '%s' not found in PingFederate", extendedPropertyName)
+		}
+
+		err = acctest.TestAttributesMatchString(resourceType, &resourceName, "description", description, property.GetDescription())
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchBool(resourceType, &resourceName, "multi_valued", multiValued, property.GetMultiValued())
+	}
+}
+
+func extendedProperty_Modify(t *testing.T, modify func(items []client.ExtendedProperty) []client.ExtendedProperty) {
+	items, err := getExtendedProperties()
+	if err != nil {
+		t.Fatalf("Failed to get extended properties: %v", err)
+	}
+	if err = putExtendedProperties(modify(items)); err != nil {
+		t.Fatalf("Failed to update extended properties: %v", err)
+	}
+}
+
+func putExtendedProperties(items []client.ExtendedProperty) error {
+	testClient := acctest.TestClient()
+	body := client.NewExtendedProperties()
+	body.Items = items
+	_, _, err := testClient.ExtendedPropertiesAPI.UpdateExtendedProperties(acctest.TestBasicAuthContext()).Body(*body).Execute()
+	return err
+}
+
+// Test that the property created by the test is destroyed, and that the unmanaged property was left in place
+func extendedProperty_CheckDestroy(s *terraform.State) error {
+	items, err := getExtendedProperties()
+	if err != nil {
+		return err
+	}
+	if findExtendedProperty(items, extendedPropertyName) != nil {
+		return acctest.ExpectedDestroyError("ExtendedProperty", extendedPropertyName)
+	}
+	if findExtendedProperty(items, unmanagedExtendedPropertyName) == nil {
+		return fmt.Errorf("unmanaged extended property '%s' was removed", unmanagedExtendedPropertyName)
+	}
+
+	// Clean up the unmanaged property
+	return putExtendedProperties(slices.DeleteFunc(items, func(item client.ExtendedProperty) bool {
+		return item.GetName() == unmanagedExtendedPropertyName
+	}))
+}
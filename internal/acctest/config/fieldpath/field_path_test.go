@@ -0,0 +1,144 @@
+package fieldpath_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+var coreAttributeType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name": types.StringType,
+}}
+
+var schemaType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"contract_id": types.StringType,
+	"name":        types.StringType,
+	"attribute_contract": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"core_attributes":     types.ListType{ElemType: coreAttributeType},
+		"extended_attributes": types.SetType{ElemType: coreAttributeType},
+	}},
+	"attribute_sources": types.MapType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"description": types.StringType,
+	}}},
+	"policy_action": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"apc_mapping_policy_action": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"authentication_policy_contract_ref": types.StringType,
+		}},
+		"local_identity_mapping_policy_action": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"local_identity_ref": types.StringType,
+			"context":            types.StringType,
+		}},
+		"restart_policy_action": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"context": types.StringType,
+		}},
+	}},
+	"ws_trust_settings": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"enable_saml_1_1": types.BoolType,
+	}},
+	"certs": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+		"cert_view": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"sha1fingerprint": types.StringType,
+		}},
+	}}},
+	"unmapped_settings": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"other": types.StringType,
+	}},
+}}
+
+func TestParseFieldPath(t *testing.T) {
+	testCases := []struct {
+		fieldPath string
+		expected  []config.FieldPathStep
+	}{
+		{fieldPath: "name", expected: []config.FieldPathStep{{Name: "name"}}},
+		{fieldPath: "attributeContract.coreAttributes[2].name", expected: []config.FieldPathStep{
+			{Name: "attributeContract"},
+			{Name: "coreAttributes"},
+			{Index: 2, IsIndex: true, IsBracket: true},
+			{Name: "name"},
+		}},
+		{fieldPath: "attributeSources['ldap'].description", expected: []config.FieldPathStep{
+			{Name: "attributeSources"},
+			{Name: "ldap", IsBracket: true},
+			{Name: "description"},
+		}},
+		{fieldPath: "items[1", expected: []config.FieldPathStep{{Name: "items"}, {Name: "[1"}}},
+		{fieldPath: "", expected: nil},
+	}
+	for _, testCase := range testCases {
+		steps := config.ParseFieldPath(testCase.fieldPath)
+		if !reflect.DeepEqual(steps, testCase.expected) {
+			t.Errorf("Expected steps %v for field path '%s', found %v", testCase.expected, testCase.fieldPath, steps)
+		}
+	}
+}
+
+func TestFindObjectAttribute(t *testing.T) {
+	policyActionTypes := schemaType.AttrTypes["policy_action"].(types.ObjectType).AttrTypes
+	testCases := []struct {
+		tfName        string
+		expectedNames []string
+		expectedFound bool
+	}{
+		{tfName: "apc_mapping_policy_action", expectedNames: []string{"apc_mapping_policy_action"}, expectedFound: true},
+		// A field of a single polymorphic wrapper
+		{tfName: "local_identity_ref", expectedNames: []string{"local_identity_mapping_policy_action", "local_identity_ref"}, expectedFound: true},
+		// A field shared by more than one wrapper is ambiguous
+		{tfName: "context", expectedFound: false},
+		{tfName: "missing", expectedFound: false},
+	}
+	for _, testCase := range testCases {
+		names, _, found := config.FindObjectAttribute(policyActionTypes, testCase.tfName)
+		if found != testCase.expectedFound {
+			t.Errorf("Expected found to be %t for '%s', found %t", testCase.expectedFound, testCase.tfName, found)
+			continue
+		}
+		if found && !reflect.DeepEqual(names, testCase.expectedNames) {
+			t.Errorf("Expected attribute names %v for '%s', found %v", testCase.expectedNames, testCase.tfName, names)
+		}
+	}
+}
+
+func TestToTerraformPath(t *testing.T) {
+	customId := "contract_id"
+	testCases := []struct {
+		fieldPath     string
+		customId      *string
+		expectedPath  path.Path
+		expectedFound bool
+	}{
+		{fieldPath: "name", expectedPath: path.Root("name"), expectedFound: true},
+		{fieldPath: "attributeContract.coreAttributes[2].name", expectedPath: path.Root("attribute_contract").AtName("core_attributes").AtListIndex(2).AtName("name"), expectedFound: true},
+		// Set elements can't be addressed, so the translation stops at the set
+		{fieldPath: "attributeContract.extendedAttributes[0].name", expectedPath: path.Root("attribute_contract").AtName("extended_attributes"), expectedFound: true},
+		// Map keys are not renamed
+		{fieldPath: "attributeSources['ldapSource'].description", expectedPath: path.Root("attribute_sources").AtMapKey("ldapSource").AtName("description"), expectedFound: true},
+		{fieldPath: "attributeSources[3].description", expectedPath: path.Root("attribute_sources").AtMapKey("3").AtName("description"), expectedFound: true},
+		{fieldPath: "id", customId: &customId, expectedPath: path.Root("contract_id"), expectedFound: true},
+		{fieldPath: "id", expectedFound: false},
+		{fieldPath: "policyAction.localIdentityRef", expectedPath: path.Root("policy_action").AtName("local_identity_mapping_policy_action").AtName("local_identity_ref"), expectedFound: true},
+		{fieldPath: "policyAction.context", expectedPath: path.Root("policy_action"), expectedFound: true},
+		// Renamed attributes
+		{fieldPath: "wsTrustSettings.enableSaml11", expectedPath: path.Root("ws_trust_settings").AtName("enable_saml_1_1"), expectedFound: true},
+		{fieldPath: "certs[0].certView.sha1Fingerprint", expectedPath: path.Root("certs").AtListIndex(0).AtName("cert_view").AtName("sha1fingerprint"), expectedFound: true},
+		// Unknown attributes fall back to the nearest ancestor
+		{fieldPath: "unmappedSettings.unknownField", expectedPath: path.Root("unmapped_settings"), expectedFound: true},
+		{fieldPath: "attributeContract.coreAttributes.name", expectedPath: path.Root("attribute_contract").AtName("core_attributes"), expectedFound: true},
+		{fieldPath: "name.extra", expectedPath: path.Root("name"), expectedFound: true},
+		{fieldPath: "unknownRoot", expectedFound: false},
+	}
+	for _, testCase := range testCases {
+		result, found := config.ToTerraformPath(schemaType, testCase.fieldPath, testCase.customId)
+		if found != testCase.expectedFound {
+			t.Errorf("Expected found to be %t for field path '%s', found %t", testCase.expectedFound, testCase.fieldPath, found)
+			continue
+		}
+		if found && !result.Equal(testCase.expectedPath) {
+			t.Errorf("Expected path %s for field path '%s', found %s", testCase.expectedPath, testCase.fieldPath, result)
+		}
+	}
+}
@@ -20,7 +20,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const idpAdapterId = "idpAdapterId"
+const idpAdapterId = "acctestIdpAdapter"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type idpAdapterResourceModel struct {
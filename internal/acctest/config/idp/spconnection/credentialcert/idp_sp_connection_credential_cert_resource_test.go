@@ -0,0 +1,127 @@
+package idpspconnectioncredentialcert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	connectionId = "acctestCredentialCertSpConn"
+	certId       = "credentialcert"
+	certFileData = "MIIDOjCCAiICCQCjbB7XBVkxCzANBgkqhkiG9w0BAQsFADBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwHhcNMjMwNzE0MDI1NDUzWhcNMjQwNzEzMDI1NDUzWjBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC5yFrh9VR2wk9IjzMz+Ei80K453g1j1/Gv3EQ/SC9h7HZBI6aV9FaEYhGnaquRT5q87p8lzCphKNXVyeL6T/pDJOW70zXItkl8Ryoc0tIaknRQmj8+YA0Hr9GDdmYev2yrxSoVS7s5Bl8poasn3DljgnWT07vsQz+hw3NY4SPp7IFGP2PpGUBBIIvrOaDWpPGsXeznBxSFtis6Qo+JiEoaVql9b9/XyKZj65wOsVyZhFWeM1nCQITSP9OqOc9FSoDFYQ1AVogm4A2AzUrkMnT1SrN2dCuTmNbeVw7gOMqMrVf0CiTv9hI0cATbO5we1sPAlJxscSkJjsaI+sQfjiAnAgMBAAEwDQYJKoZIhvcNAQELBQADggEBACgwoH1qklPF1nI9+WbIJ4K12Dl9+U3ZMZa2lP4hAk1rMBHk9SHboOU1CHDQKT1Z6uxi0NI4JZHmP1qP8KPNEWTI8Q76ue4Q3aiA53EQguzGb3SEtyp36JGBq05Jor9erEebFftVl83NFvio72Fn0N2xvu8zCnlylf2hpz9x1i01Xnz5UNtZ2ppsf2zzT+4U6w3frH+pkp0RDPuoe9mnBF001AguP31hSBZyZzWcwQltuNELnSRCcgJl4kC2h3mAgaVtYalrFxLRa3tA2XF2BHRHmKgocedVhTq+81xrqj+WQuDmUe06DnrS3Ohmyj3jhsCCluznAolmrBhT/SaDuGg="
+)
+
+func TestAccIdpSpConnectionCredentialCert(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: idpSpConnectionCredentialCert_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Add a certificate to a connection that doesn't manage its own certificates
+				Config: idpSpConnectionCredentialCert_HCL("connection name", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_credential_cert.example", "id", certId),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_credential_cert.example", "active_verification_cert", "false"),
+					resource.TestCheckResourceAttrSet("pingfederate_idp_sp_connection_credential_cert.example", "formatted_file_data"),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_credential_cert.example", "subject_dn", "C=US, O=CDR, OU=PING, L=AUSTIN, ST=TEXAS, CN=localhost"),
+					resource.TestCheckNoResourceAttr("pingfederate_idp_sp_connection.example", "credentials.certs"),
+				),
+			},
+			{
+				// Updating the connection retains the certificate
+				Config: idpSpConnectionCredentialCert_HCL("updated connection name", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_credential_cert.example", "active_verification_cert", "true"),
+					resource.TestCheckNoResourceAttr("pingfederate_idp_sp_connection.example", "credentials.certs"),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               idpSpConnectionCredentialCert_HCL("updated connection name", true),
+				ResourceName:                         "pingfederate_idp_sp_connection_credential_cert.example",
+				ImportStateId:                        connectionId + "/" + certId,
+				ImportStateVerifyIdentifierAttribute: "cert_id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				// file_data is reformatted by PingFederate
+				ImportStateVerifyIgnore: []string{"file_data"},
+			},
+		},
+	})
+}
+
+func idpSpConnectionCredentialCert_HCL(connectionName string, activeVerificationCert bool) string {
+	return fmt.Sprintf(`
+resource "pingfederate_idp_sp_connection" "example" {
+  connection_id = "%[1]s"
+  name          = "%[2]s"
+  entity_id     = "credentialCertSpEntity"
+  credentials = {
+    signing_settings = {
+      signing_key_pair_ref = {
+        id = "419x9yg43rlawqwq9v6az997k"
+      }
+      include_raw_key_in_signature = false
+      include_cert_in_signature    = false
+      algorithm                    = "SHA256withRSA"
+    }
+  }
+  ws_trust = {
+    partner_service_ids = [
+      "credentialCertSp"
+    ]
+    default_token_type = "SAML20"
+    attribute_contract = {
+      core_attributes = [
+        {
+          name = "TOKEN_SUBJECT"
+        }
+      ]
+      extended_attributes = []
+    }
+    token_processor_mappings = [
+      {
+        attribute_contract_fulfillment = {
+          "TOKEN_SUBJECT" : {
+            source = {
+              type = "TOKEN"
+            }
+            value = "username"
+          }
+        }
+        idp_token_processor_ref = {
+          id = "UsernameTokenProcessor"
+        }
+      }
+    ]
+  }
+}
+
+resource "pingfederate_idp_sp_connection_credential_cert" "example" {
+  connection_id            = pingfederate_idp_sp_connection.example.connection_id
+  cert_id                  = "%[3]s"
+  file_data                = "%[4]s"
+  active_verification_cert = %[5]t
+}
+`, connectionId, connectionName, certId, certFileData, activeVerificationCert)
+}
+
+// Test that any objects created by the test are destroyed
+func idpSpConnectionCredentialCert_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, err := testClient.IdpSpConnectionsAPI.DeleteSpConnection(acctest.TestBasicAuthContext(), connectionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("IdP SP Connection", connectionId)
+	}
+	return nil
+}
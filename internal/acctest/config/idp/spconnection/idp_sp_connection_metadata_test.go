@@ -0,0 +1,220 @@
+package idpspconnection_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	metadataSpConnectionId    = "acctestMetadataSpConn"
+	metadataSpConnectionUrlId = "acctestMetadataSpConnUrl"
+	metadataSpEntityId        = "https://sp.bxretail.org/metadata"
+	metadataSpCert            = "MIIDOjCCAiICCQCjbB7XBVkxCzANBgkqhkiG9w0BAQsFADBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwHhcNMjMwNzE0MDI1NDUzWhcNMjQwNzEzMDI1NDUzWjBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC5yFrh9VR2wk9IjzMz+Ei80K453g1j1/Gv3EQ/SC9h7HZBI6aV9FaEYhGnaquRT5q87p8lzCphKNXVyeL6T/pDJOW70zXItkl8Ryoc0tIaknRQmj8+YA0Hr9GDdmYev2yrxSoVS7s5Bl8poasn3DljgnWT07vsQz+hw3NY4SPp7IFGP2PpGUBBIIvrOaDWpPGsXeznBxSFtis6Qo+JiEoaVql9b9/XyKZj65wOsVyZhFWeM1nCQITSP9OqOc9FSoDFYQ1AVogm4A2AzUrkMnT1SrN2dCuTmNbeVw7gOMqMrVf0CiTv9hI0cATbO5we1sPAlJxscSkJjsaI+sQfjiAnAgMBAAEwDQYJKoZIhvcNAQELBQADggEBACgwoH1qklPF1nI9+WbIJ4K12Dl9+U3ZMZa2lP4hAk1rMBHk9SHboOU1CHDQKT1Z6uxi0NI4JZHmP1qP8KPNEWTI8Q76ue4Q3aiA53EQguzGb3SEtyp36JGBq05Jor9erEebFftVl83NFvio72Fn0N2xvu8zCnlylf2hpz9x1i01Xnz5UNtZ2ppsf2zzT+4U6w3frH+pkp0RDPuoe9mnBF001AguP31hSBZyZzWcwQltuNELnSRCcgJl4kC2h3mAgaVtYalrFxLRa3tA2XF2BHRHmKgocedVhTq+81xrqj+WQuDmUe06DnrS3Ohmyj3jhsCCluznAolmrBhT/SaDuGg="
+)
+
+func TestAccIdpSpConnection_Metadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckMetadataSpConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Populate the connection from metadata
+				Config: testAccSpConnectionMetadata("https://sp.bxretail.org/sp/ACS.saml2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.metadata", "entity_id", metadataSpEntityId),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.metadata", "credentials.certs.#", "1"),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.metadata", "credentials.certs.0.x509_file.file_data", metadataSpCert),
+					resource.TestCheckTypeSetElemNestedAttrs("pingfederate_idp_sp_connection.metadata", "sp_browser_sso.sso_service_endpoints.*", map[string]string{
+						"binding": "POST",
+						"url":     "https://sp.bxretail.org/sp/ACS.saml2",
+					}),
+				),
+			},
+			{
+				// Changes to the metadata are applied to the connection
+				Config: testAccSpConnectionMetadata("https://sp.bxretail.org/sp/updated/ACS.saml2"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs("pingfederate_idp_sp_connection.metadata", "sp_browser_sso.sso_service_endpoints.*", map[string]string{
+					"binding": "POST",
+					"url":     "https://sp.bxretail.org/sp/updated/ACS.saml2",
+				}),
+			},
+		},
+	})
+}
+
+func TestAccIdpSpConnection_MetadataUrl(t *testing.T) {
+	// Serve the metadata locally, so that it can be changed between steps
+	var metadataLock sync.Mutex
+	metadataXml := testAccSpConnectionMetadataXml("https://sp.bxretail.org/sp/ACS.saml2")
+	metadataServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		metadataLock.Lock()
+		defer metadataLock.Unlock()
+		_, _ = w.Write([]byte(metadataXml))
+	}))
+	defer metadataServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckMetadataSpConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Populate the connection from the metadata URL
+				Config: testAccSpConnectionMetadataUrl(metadataServer.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.metadata", "entity_id", metadataSpEntityId),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.metadata", "credentials.certs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("pingfederate_idp_sp_connection.metadata", "sp_browser_sso.sso_service_endpoints.*", map[string]string{
+						"binding": "POST",
+						"url":     "https://sp.bxretail.org/sp/ACS.saml2",
+					}),
+				),
+			},
+			{
+				// Unchanged metadata at the URL should not produce a plan
+				Config:   testAccSpConnectionMetadataUrl(metadataServer.URL),
+				PlanOnly: true,
+			},
+			{
+				// Changes to the metadata at the URL should be detected without any change to the configuration
+				PreConfig: func() {
+					metadataLock.Lock()
+					defer metadataLock.Unlock()
+					metadataXml = testAccSpConnectionMetadataXml("https://sp.bxretail.org/sp/updated/ACS.saml2")
+				},
+				Config:             testAccSpConnectionMetadataUrl(metadataServer.URL),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccSpConnectionMetadataUrl(metadataServer.URL),
+				Check: resource.TestCheckTypeSetElemNestedAttrs("pingfederate_idp_sp_connection.metadata", "sp_browser_sso.sso_service_endpoints.*", map[string]string{
+					"binding": "POST",
+					"url":     "https://sp.bxretail.org/sp/updated/ACS.saml2",
+				}),
+			},
+		},
+	})
+}
+
+func testAccSpConnectionMetadataXml(acsUrl string) string {
+	return fmt.Sprintf(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="%[1]s">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>%[2]s</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%[3]s" index="0" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`, metadataSpEntityId, metadataSpCert, acsUrl)
+}
+
+func testAccSpConnectionMetadata(acsUrl string) string {
+	return testAccSpConnectionMetadataHCL(fmt.Sprintf(`{
+    xml = <<EOT
+%s
+EOT
+  }`, testAccSpConnectionMetadataXml(acsUrl)))
+}
+
+func testAccSpConnectionMetadataUrl(url string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_metadata_url" "metadata" {
+  url_id             = "%s"
+  name               = "acctestMetadataSpConnUrl"
+  url                = "%s"
+  validate_signature = false
+}
+%s`, metadataSpConnectionUrlId, url, testAccSpConnectionMetadataHCL(`{
+    metadata_url_ref = {
+      id = pingfederate_metadata_url.metadata.id
+    }
+  }`))
+}
+
+func testAccSpConnectionMetadataHCL(metadata string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_idp_sp_connection" "metadata" {
+  connection_id = "%s"
+  name          = "acctestMetadataSpConn"
+  metadata      = %s
+  credentials = {
+    signing_settings = {
+      signing_key_pair_ref = {
+        id = "419x9yg43rlawqwq9v6az997k"
+      }
+      include_raw_key_in_signature = false
+      include_cert_in_signature    = false
+      algorithm                    = "SHA256withRSA"
+    }
+  }
+  sp_browser_sso = {
+    protocol                      = "SAML20"
+    require_signed_authn_requests = false
+    sp_saml_identity_mapping      = "STANDARD"
+    sign_assertions               = false
+    sign_response_as_required     = true
+    enabled_profiles = [
+      "IDP_INITIATED_SSO"
+    ]
+    authentication_policy_contract_assertion_mappings = [
+      {
+        authentication_policy_contract_ref = {
+          id = "QGxlec5CX693lBQL"
+        }
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "AUTHENTICATION_POLICY_CONTRACT"
+            }
+            value = "subject"
+          }
+        }
+      }
+    ]
+    adapter_mappings = []
+    assertion_lifetime = {
+      minutes_after  = 5
+      minutes_before = 5
+    }
+    attribute_contract = {
+      core_attributes = [
+        {
+          name_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+          name        = "SAML_SUBJECT"
+        }
+      ]
+      extended_attributes = []
+    }
+  }
+}
+`, metadataSpConnectionId, metadata)
+}
+
+func testAccCheckMetadataSpConnectionDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, err := testClient.IdpSpConnectionsAPI.DeleteSpConnection(ctx, metadataSpConnectionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("IdP SP Connection", metadataSpConnectionId)
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ import (
 )
 
 const (
-	spConnectionId = "spConnId"
+	spConnectionId = "acctestSpConn"
 	resourceType   = "IdP SP Connection"
 )
 
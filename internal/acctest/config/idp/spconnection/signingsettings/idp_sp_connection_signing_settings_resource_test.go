@@ -0,0 +1,143 @@
+package idpspconnectionsigningsettings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	connectionId = "acctestSigningSettingsSpConn"
+	signingKeyId = "419x9yg43rlawqwq9v6az997k"
+)
+
+func TestAccIdpSpConnectionSigningSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: idpSpConnectionSigningSettings_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The connection manages its own signing settings
+				Config: idpSpConnectionSigningSettings_HCL("connection name", ""),
+				Check:  resource.TestCheckResourceAttr("pingfederate_idp_sp_connection.example", "credentials.signing_settings.algorithm", "SHA256withRSA"),
+			},
+			{
+				// Move the signing settings to the signing settings resource
+				Config: idpSpConnectionSigningSettings_HCL("connection name", "SHA384withRSA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_signing_settings.example", "id", connectionId),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_signing_settings.example", "signing_key_pair_ref.id", signingKeyId),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_signing_settings.example", "algorithm", "SHA384withRSA"),
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_signing_settings.example", "include_cert_in_signature", "true"),
+					resource.TestCheckNoResourceAttr("pingfederate_idp_sp_connection.example", "credentials.signing_settings"),
+				),
+			},
+			{
+				// Updating the connection retains the signing settings
+				Config: idpSpConnectionSigningSettings_HCL("updated connection name", "SHA384withRSA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_idp_sp_connection_signing_settings.example", "algorithm", "SHA384withRSA"),
+					resource.TestCheckNoResourceAttr("pingfederate_idp_sp_connection.example", "credentials.signing_settings"),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               idpSpConnectionSigningSettings_HCL("updated connection name", "SHA384withRSA"),
+				ResourceName:                         "pingfederate_idp_sp_connection_signing_settings.example",
+				ImportStateId:                        connectionId,
+				ImportStateVerifyIdentifierAttribute: "connection_id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+// When signingSettingsAlgorithm is empty, the connection manages its own signing settings
+func idpSpConnectionSigningSettings_HCL(connectionName, signingSettingsAlgorithm string) string {
+	credentials := fmt.Sprintf(`
+  credentials = {
+    signing_settings = {
+      signing_key_pair_ref = {
+        id = "%s"
+      }
+      include_raw_key_in_signature = false
+      include_cert_in_signature    = false
+      algorithm                    = "SHA256withRSA"
+    }
+  }`, signingKeyId)
+	signingSettings := ""
+	if signingSettingsAlgorithm != "" {
+		credentials = `
+  credentials = {
+  }`
+		signingSettings = fmt.Sprintf(`
+resource "pingfederate_idp_sp_connection_signing_settings" "example" {
+  connection_id = pingfederate_idp_sp_connection.example.connection_id
+  signing_key_pair_ref = {
+    id = "%s"
+  }
+  algorithm                 = "%s"
+  include_cert_in_signature = true
+}
+`, signingKeyId, signingSettingsAlgorithm)
+	}
+
+	return fmt.Sprintf(`
+resource "pingfederate_idp_sp_connection" "example" {
+  connection_id = "%[1]s"
+  name          = "%[2]s"
+  entity_id     = "signingSettingsSpEntity"
+%[3]s
+  ws_trust = {
+    partner_service_ids = [
+      "signingSettingsSp"
+    ]
+    default_token_type = "SAML20"
+    attribute_contract = {
+      core_attributes = [
+        {
+          name = "TOKEN_SUBJECT"
+        }
+      ]
+      extended_attributes = []
+    }
+    token_processor_mappings = [
+      {
+        attribute_contract_fulfillment = {
+          "TOKEN_SUBJECT" : {
+            source = {
+              type = "TOKEN"
+            }
+            value = "username"
+          }
+        }
+        idp_token_processor_ref = {
+          id = "UsernameTokenProcessor"
+        }
+      }
+    ]
+  }
+}
+%[4]s
+`, connectionId, connectionName, credentials, signingSettings)
+}
+
+// Test that any objects created by the test are destroyed
+func idpSpConnectionSigningSettings_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, err := testClient.IdpSpConnectionsAPI.DeleteSpConnection(acctest.TestBasicAuthContext(), connectionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("IdP SP Connection", connectionId)
+	}
+	return nil
+}
@@ -15,8 +15,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const keypairsSigningKeyGenerateKeyId = "keypairssigninggenkeyid"
-const keypairsSigningKeyImportKeyId = "keypairssigningimpkeyid"
+const keypairsSigningKeyGenerateKeyId = "acctestsigninggenkey"
+const keypairsSigningKeyImportKeyId = "acctestsigningimpkey"
 
 func TestAccKeypairsSigningKey_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const keypairsSigningKeyRotationSettingsSettingsId = "rotationsettingstestkey"
+const keypairsSigningKeyRotationSettingsSettingsId = "acctestrotationsettingskey"
 
 func TestAccKeypairsSigningKeyRotationSettings_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -17,7 +17,7 @@ import (
 
 var fileDataInitial, fileDataUpdated, fileDataCa string
 
-const signingCaId = "sslclientcsrtestca"
+const signingCaId = "acctestsslclientcsrca"
 
 func TestAccKeypairsSslClientCsrResponseResource(t *testing.T) {
 	fileDataInitial = os.Getenv("PF_TF_ACC_TEST_CSR_RESPONSE_1")
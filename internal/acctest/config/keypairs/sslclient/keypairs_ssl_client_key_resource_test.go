@@ -15,8 +15,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const keypairsSslClientKeyGenerateKeyId = "keypairssslgenkeyid"
-const keypairsSslClientKeyImportKeyId = "keypairssslimpkeyid"
+const keypairsSslClientKeyGenerateKeyId = "acctestsslgenkey"
+const keypairsSslClientKeyImportKeyId = "acctestsslimpkey"
 
 func TestAccKeypairsSslClientKey_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
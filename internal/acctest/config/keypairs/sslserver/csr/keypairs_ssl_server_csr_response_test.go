@@ -17,7 +17,7 @@ import (
 
 var fileDataInitial, fileDataUpdated, fileDataCa string
 
-const signingCaId = "sslservercsrtestca"
+const signingCaId = "acctestsslservercsrca"
 
 func TestAccKeypairsSslServerCsrResponseResource(t *testing.T) {
 	fileDataInitial = os.Getenv("PF_TF_ACC_TEST_CSR_RESPONSE_1")
@@ -15,8 +15,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const keypairsSslServerKeyGenerateKeyId = "keypairssslgenkeyid"
-const keypairsSslServerKeyImportKeyId = "keypairssslimpkeyid"
+const keypairsSslServerKeyGenerateKeyId = "acctestsslgenkey"
+const keypairsSslServerKeyImportKeyId = "acctestsslimpkey"
 
 func TestAccKeypairsSslServerKey_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
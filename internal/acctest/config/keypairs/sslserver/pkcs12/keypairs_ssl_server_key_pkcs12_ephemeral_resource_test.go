@@ -0,0 +1,49 @@
+package keypairssslserverpkcs12_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccKeypairsSslServerKeyPkcs12(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		// Ephemeral resources require Terraform 1.10 or later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Run the export and validate the results
+				Config: keypairsSslServerKeyPkcs12_MinimalHCL(),
+				Check:  resource.TestCheckResourceAttrSet("echo.example", "data.exported_pkcs12"),
+			},
+		},
+	})
+}
+
+func keypairsSslServerKeyPkcs12_MinimalHCL() string {
+	return `
+ephemeral "pingfederate_keypairs_ssl_server_key_pkcs12" "example" {
+  key_id   = "sslservercert"
+  password = "2FederateM0re"
+}
+
+provider "echo" {
+  data = ephemeral.pingfederate_keypairs_ssl_server_key_pkcs12.example
+}
+
+resource "echo" "example" {}
+`
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const localIdentityProfilesId = "testLocalIdProfile"
+const localIdentityProfilesId = "acctestLocalIdProfile"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type localIdentityProfilesResourceModel struct {
@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const metadataUrlUrlId = "metadataUrlUrlId"
+const metadataUrlUrlId = "acctestMetadataUrl"
 
 func TestAccMetadataUrl_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const notificationPublisherPublisherId = "notificationPublisherPublisherId"
+const notificationPublisherPublisherId = "acctestNotificationPublisher"
 
 func TestAccNotificationPublisher_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
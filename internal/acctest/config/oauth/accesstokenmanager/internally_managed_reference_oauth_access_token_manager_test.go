@@ -13,7 +13,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const internallyManagedReferenceOauthAccessTokenManagerId = "internallyManagedReferenceOatm"
+const internallyManagedReferenceOauthAccessTokenManagerId = "acctestInternallyManagedRefAtm"
 const internallyManagedReferenceOauthAccessTokenManagerName = "internallyManagedReferenceExample"
 
 // Attributes to test with. Add optional properties to test here if desired.
@@ -15,7 +15,7 @@ import (
 )
 
 // #nosec G101
-const jsonWebTokenOauthAccessTokenManagerId = "jsonWebTokenOatm"
+const jsonWebTokenOauthAccessTokenManagerId = "acctestJsonWebTokenAtm"
 
 // #nosec G101
 const jsonWebTokenOauthAccessTokenManagerName = "jsonWebTokenExample"
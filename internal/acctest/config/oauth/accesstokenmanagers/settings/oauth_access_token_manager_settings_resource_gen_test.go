@@ -44,7 +44,7 @@ func TestAccOauthAccessTokenManagerSettings_MinimalMaximal(t *testing.T) {
 func oauthAccessTokenManagerSettings_MinimalHCL() string {
 	return fmt.Sprintf(`
 resource "pingfederate_oauth_access_token_manager" "example" {
-  manager_id = "myOauthAccessTokenManager"
+  manager_id = "acctestAtmSettingsManager"
   name       = "Internal Manager"
   plugin_descriptor_ref = {
     id = "org.sourceid.oauth20.token.plugin.impl.ReferenceBearerAccessTokenManagementPlugin"
@@ -74,7 +74,7 @@ resource "pingfederate_oauth_access_token_manager_settings" "example" {
 func oauthAccessTokenManagerSettings_ResetDefaultManagerHCL() string {
 	return fmt.Sprintf(`
 resource "pingfederate_oauth_access_token_manager" "example" {
-  manager_id = "myOauthAccessTokenManager"
+  manager_id = "acctestAtmSettingsManager"
   name       = "Internal Manager"
   plugin_descriptor_ref = {
     id = "org.sourceid.oauth20.token.plugin.impl.ReferenceBearerAccessTokenManagementPlugin"
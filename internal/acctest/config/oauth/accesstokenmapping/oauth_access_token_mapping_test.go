@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const oauthAccessTokenMappingId = "client_credentials|oauthAccessTokenManagerTest"
+const oauthAccessTokenMappingId = "client_credentials|acctestAtmMappingManager"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type oauthAccessTokenMappingResourceModel struct {
@@ -193,9 +193,9 @@ func optionalHcl(resourceModel oauthAccessTokenMappingResourceModel) string {
 
 func testAccOauthAccessTokenMapping(resourceName string, resourceModel oauthAccessTokenMappingResourceModel) string {
 	return fmt.Sprintf(`
-resource "pingfederate_oauth_access_token_manager" "oauthAccessTokenManagerTest" {
-  manager_id = "oauthAccessTokenManagerTest"
-  name       = "oauthAccessTokenManagerTest"
+resource "pingfederate_oauth_access_token_manager" "acctestAtmMappingManager" {
+  manager_id = "acctestAtmMappingManager"
+  name       = "acctestAtmMappingManager"
   plugin_descriptor_ref = {
     id = "org.sourceid.oauth20.token.plugin.impl.ReferenceBearerAccessTokenManagementPlugin"
   }
@@ -262,7 +262,7 @@ resource "pingfederate_oauth_access_token_manager" "oauthAccessTokenManagerTest"
 
 resource "pingfederate_oauth_access_token_mapping" "%[1]s" {
   access_token_manager_ref = {
-    id = pingfederate_oauth_access_token_manager.oauthAccessTokenManagerTest.id
+    id = pingfederate_oauth_access_token_manager.acctestAtmMappingManager.id
   }
 
   context = {
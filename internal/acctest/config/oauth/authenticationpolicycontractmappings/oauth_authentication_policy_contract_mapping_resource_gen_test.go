@@ -17,7 +17,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const oauthAuthenticationPolicyContractMappingMappingId = "oauthAuthPolicyTestContract"
+const oauthAuthenticationPolicyContractMappingMappingId = "acctestOauthApcMappingContract"
 
 func TestAccOauthAuthenticationPolicyContractMapping_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -92,7 +92,7 @@ func TestAccOauthAuthenticationPolicyContractMapping_MinimalMaximal(t *testing.T
 func oauthAuthenticationPolicyContractMapping_DependencyHCL() string {
 	return fmt.Sprintf(`
 resource "pingfederate_authentication_policy_contract" "oauth_auth_policy_mapping_contract" {
-  contract_id = "oauthAuthPolicyTestContract"
+  contract_id = "acctestOauthApcMappingContract"
   name        = "OAuth Auth Policy Test Contract"
   extended_attributes = [
     {
@@ -0,0 +1,73 @@
+package oauthauthserversettings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const unmanagedScopeName = "acctest_unmanaged_scope"
+
+func TestAccOauthAuthServerSettingsUnmanagedScopes(t *testing.T) {
+	resourceName := "myOauthAuthServerSettingsUnmanagedScopes"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOauthAuthServerSettingsUnmanagedScopes(resourceName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUnmanagedScopeExists,
+					resource.TestCheckResourceAttr("pingfederate_oauth_server_settings."+resourceName, "manage_scopes", "false"),
+					resource.TestCheckResourceAttr("pingfederate_oauth_server_settings."+resourceName, "scopes.#", "0"),
+				),
+			},
+			{
+				// Updating the settings must not remove the scope managed by the separate resource
+				Config: testAccOauthAuthServerSettingsUnmanagedScopes(resourceName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUnmanagedScopeExists,
+					resource.TestCheckResourceAttr("pingfederate_oauth_server_settings."+resourceName, "authorization_code_timeout", "60"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOauthAuthServerSettingsUnmanagedScopes(resourceName string, authorizationCodeTimeout int64) string {
+	return fmt.Sprintf(`
+resource "pingfederate_oauth_scope" "%[1]s" {
+  name        = "%[2]s"
+  description = "Scope managed outside of the server settings"
+}
+
+resource "pingfederate_oauth_server_settings" "%[1]s" {
+  depends_on = [pingfederate_oauth_scope.%[1]s]
+
+  manage_scopes              = false
+  authorization_code_entropy = 30
+  authorization_code_timeout = %[3]d
+  refresh_rolling_interval   = 0
+  refresh_token_length       = 42
+}`, resourceName,
+		unmanagedScopeName,
+		authorizationCodeTimeout,
+	)
+}
+
+// Test that the scope managed by the pingfederate_oauth_scope resource still exists on the server
+func testAccCheckUnmanagedScopeExists(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.OauthAuthServerSettingsAPI.GetCommonScope(ctx, unmanagedScopeName).Execute()
+	return err
+}
@@ -0,0 +1,157 @@
+package oauthauthserversettingsscopegroups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const commonScopeGroupName = "acctest_common_scope_group"
+const exclusiveScopeGroupName = "acctest_exclusive_scope_group"
+
+func TestAccOauthScopeGroup(t *testing.T) {
+	resourceName := "myOauthScopeGroup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: oauthScopeGroup_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Each group starts with a single scope
+				Config: oauthScopeGroup_HCL(resourceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					oauthScopeGroup_CheckExpectedAttributes(1),
+					resource.TestCheckResourceAttr("pingfederate_oauth_scope_group."+resourceName, "id", commonScopeGroupName),
+					resource.TestCheckResourceAttr("pingfederate_oauth_scope_group."+resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttr("pingfederate_oauth_exclusive_scope_group."+resourceName, "id", exclusiveScopeGroupName),
+				),
+			},
+			{
+				// Add a second scope to each group
+				Config: oauthScopeGroup_HCL(resourceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					oauthScopeGroup_CheckExpectedAttributes(2),
+					resource.TestCheckResourceAttr("pingfederate_oauth_scope_group."+resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttr("pingfederate_oauth_exclusive_scope_group."+resourceName, "scopes.#", "2"),
+				),
+			},
+			{
+				// Test importing the common scope group
+				Config:                               oauthScopeGroup_HCL(resourceName, true),
+				ResourceName:                         "pingfederate_oauth_scope_group." + resourceName,
+				ImportStateId:                        commonScopeGroupName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// Test importing the exclusive scope group
+				Config:                               oauthScopeGroup_HCL(resourceName, true),
+				ResourceName:                         "pingfederate_oauth_exclusive_scope_group." + resourceName,
+				ImportStateId:                        exclusiveScopeGroupName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// Back to a single scope
+				Config: oauthScopeGroup_HCL(resourceName, false),
+				Check:  oauthScopeGroup_CheckExpectedAttributes(1),
+			},
+		},
+	})
+}
+
+func oauthScopeGroup_HCL(resourceName string, includeSecondScope bool) string {
+	commonScopes := fmt.Sprintf("pingfederate_oauth_scope.%s_first.name", resourceName)
+	exclusiveScopes := fmt.Sprintf("pingfederate_oauth_exclusive_scope.%s_first.name", resourceName)
+	if includeSecondScope {
+		commonScopes += fmt.Sprintf(", pingfederate_oauth_scope.%s_second.name", resourceName)
+		exclusiveScopes += fmt.Sprintf(", pingfederate_oauth_exclusive_scope.%s_second.name", resourceName)
+	}
+
+	return fmt.Sprintf(`
+resource "pingfederate_oauth_scope" "%[1]s_first" {
+  name        = "acctest_group_scope_first"
+  description = "First scope"
+}
+
+resource "pingfederate_oauth_scope" "%[1]s_second" {
+  name        = "acctest_group_scope_second"
+  description = "Second scope"
+}
+
+resource "pingfederate_oauth_exclusive_scope" "%[1]s_first" {
+  name        = "acctest_group_exclusive_scope_first"
+  description = "First exclusive scope"
+}
+
+resource "pingfederate_oauth_exclusive_scope" "%[1]s_second" {
+  name        = "acctest_group_exclusive_scope_second"
+  description = "Second exclusive scope"
+}
+
+resource "pingfederate_oauth_scope_group" "%[1]s" {
+  name        = "%[2]s"
+  description = "Common scope group"
+  scopes      = [%[4]s]
+}
+
+resource "pingfederate_oauth_exclusive_scope_group" "%[1]s" {
+  name        = "%[3]s"
+  description = "Exclusive scope group"
+  scopes      = [%[5]s]
+}
+`, resourceName,
+		commonScopeGroupName,
+		exclusiveScopeGroupName,
+		commonScopes,
+		exclusiveScopes,
+	)
+}
+
+// Test that the expected attributes are set on the PingFederate server
+func oauthScopeGroup_CheckExpectedAttributes(scopeCount int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		commonScopeGroup, _, err := testClient.OauthAuthServerSettingsAPI.GetCommonScopeGroup(ctx, commonScopeGroupName).Execute()
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchInt("OauthScopeGroup", nil, "scopes.#", scopeCount, int64(len(commonScopeGroup.Scopes)))
+		if err != nil {
+			return err
+		}
+
+		exclusiveScopeGroup, _, err := testClient.OauthAuthServerSettingsAPI.GetExclusiveScopeGroup(ctx, exclusiveScopeGroupName).Execute()
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchInt("OauthExclusiveScopeGroup", nil, "scopes.#", scopeCount, int64(len(exclusiveScopeGroup.Scopes)))
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func oauthScopeGroup_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.OauthAuthServerSettingsAPI.GetCommonScopeGroup(ctx, commonScopeGroupName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("OauthScopeGroup", commonScopeGroupName)
+	}
+	_, _, err = testClient.OauthAuthServerSettingsAPI.GetExclusiveScopeGroup(ctx, exclusiveScopeGroupName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("OauthExclusiveScopeGroup", exclusiveScopeGroupName)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package oauthauthserversettingsscopes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const commonScopeName = "acctest_common_scope"
+const exclusiveScopeName = "acctest_exclusive_scope"
+const dynamicExclusiveScopeName = "*acctest_dynamic_exclusive_scope"
+
+func TestAccOauthScope(t *testing.T) {
+	resourceName := "myOauthScope"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: oauthScope_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Minimal model
+				Config: oauthScope_HCL(resourceName, "Initial description", false),
+				Check: resource.ComposeTestCheckFunc(
+					oauthScope_CheckComputedValuesMinimal(resourceName),
+					oauthScope_CheckExpectedAttributes("Initial description"),
+				),
+			},
+			{
+				// Update the description and add the dynamic exclusive scope
+				Config: oauthScope_HCL(resourceName, "Updated description", true),
+				Check: resource.ComposeTestCheckFunc(
+					oauthScope_CheckExpectedAttributes("Updated description"),
+					resource.TestCheckResourceAttr("pingfederate_oauth_exclusive_scope."+resourceName+"_dynamic", "dynamic", "true"),
+				),
+			},
+			{
+				// Test importing the common scope
+				Config:                               oauthScope_HCL(resourceName, "Updated description", true),
+				ResourceName:                         "pingfederate_oauth_scope." + resourceName,
+				ImportStateId:                        commonScopeName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// Test importing the dynamic exclusive scope
+				Config:                               oauthScope_HCL(resourceName, "Updated description", true),
+				ResourceName:                         "pingfederate_oauth_exclusive_scope." + resourceName + "_dynamic",
+				ImportStateId:                        dynamicExclusiveScopeName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// Back to minimal model
+				Config: oauthScope_HCL(resourceName, "Initial description", false),
+				Check:  oauthScope_CheckExpectedAttributes("Initial description"),
+			},
+			{
+				// Test removing the scope outside of Terraform
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					_, err := testClient.OauthAuthServerSettingsAPI.RemoveCommonScope(ctx, commonScopeName).Execute()
+					if err != nil {
+						t.Fatalf("Failed to delete config: %v", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func oauthScope_HCL(resourceName, description string, includeDynamic bool) string {
+	dynamicHcl := ""
+	if includeDynamic {
+		dynamicHcl = fmt.Sprintf(`
+resource "pingfederate_oauth_exclusive_scope" "%[1]s_dynamic" {
+  name        = "%[2]s"
+  description = "%[3]s"
+  dynamic     = true
+}
+`, resourceName, dynamicExclusiveScopeName, description)
+	}
+
+	return fmt.Sprintf(`
+resource "pingfederate_oauth_scope" "%[1]s" {
+  name        = "%[2]s"
+  description = "%[4]s"
+}
+
+resource "pingfederate_oauth_exclusive_scope" "%[1]s" {
+  name        = "%[3]s"
+  description = "%[4]s"
+}
+%[5]s
+`, resourceName,
+		commonScopeName,
+		exclusiveScopeName,
+		description,
+		dynamicHcl,
+	)
+}
+
+// Validate any computed values when applying minimal HCL
+func oauthScope_CheckComputedValuesMinimal(resourceName string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr("pingfederate_oauth_scope."+resourceName, "id", commonScopeName),
+		resource.TestCheckResourceAttr("pingfederate_oauth_scope."+resourceName, "dynamic", "false"),
+		resource.TestCheckResourceAttr("pingfederate_oauth_exclusive_scope."+resourceName, "id", exclusiveScopeName),
+		resource.TestCheckResourceAttr("pingfederate_oauth_exclusive_scope."+resourceName, "dynamic", "false"),
+	)
+}
+
+// Test that the expected attributes are set on the PingFederate server
+func oauthScope_CheckExpectedAttributes(description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		commonScope, _, err := testClient.OauthAuthServerSettingsAPI.GetCommonScope(ctx, commonScopeName).Execute()
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchString("OauthScope", nil, "description", description, commonScope.Description)
+		if err != nil {
+			return err
+		}
+
+		exclusiveScope, _, err := testClient.OauthAuthServerSettingsAPI.GetExclusiveScope(ctx, exclusiveScopeName).Execute()
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchString("OauthExclusiveScope", nil, "description", description, exclusiveScope.Description)
+	}
+}
+
+// Test that any objects created by the test are destroyed
+func oauthScope_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err := testClient.OauthAuthServerSettingsAPI.GetExclusiveScope(ctx, exclusiveScopeName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("OauthExclusiveScope", exclusiveScopeName)
+	}
+	_, _, err = testClient.OauthAuthServerSettingsAPI.GetCommonScope(ctx, commonScopeName).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("OauthScope", commonScopeName)
+	}
+	return nil
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
-const oauthClientId = "myOauthClient"
+const oauthClientId = "acctestOauthClient"
 
 type oauthClientResourceModel struct {
 	clientId                                                      string
@@ -206,6 +206,24 @@ func TestAccOauthClient(t *testing.T) {
 					"persistent_grant_expiration_time_unit",
 				},
 			},
+			{
+				// Test importing the resource by name
+				Config:            testAccOauthClient(resourceName, updatedResourceModel),
+				ResourceName:      "pingfederate_oauth_client." + resourceName,
+				ImportStateId:     "name:" + updatedResourceModel.name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"client_auth.secret",
+					"client_auth.encrypted_secret",
+					"client_auth.secondary_secrets.0.secret",
+					"client_auth.secondary_secrets.0.encrypted_secret",
+					"client_secret_changed_time",
+					"modification_date",
+					"persistent_grant_expiration_time",
+					"persistent_grant_expiration_time_unit",
+				},
+			},
 			{
 				// Back to minimal model
 				Config: testAccOauthClient(resourceName, minimalResourceModel),
@@ -73,9 +73,9 @@ resource "pingfederate_oauth_server_settings" "oauthSettings" {
   refresh_token_length       = 40
 }
 
-resource "pingfederate_oauth_access_token_manager" "accessTokenManager" {
-  manager_id = "accessTokenManager"
-  name       = "accessTokenManager"
+resource "pingfederate_oauth_access_token_manager" "acctestClientSettingsAtm" {
+  manager_id = "acctestClientSettingsAtm"
+  name       = "acctestClientSettingsAtm"
   plugin_descriptor_ref = {
     id = "org.sourceid.oauth20.token.plugin.impl.ReferenceBearerAccessTokenManagementPlugin"
   }
@@ -92,11 +92,11 @@ resource "pingfederate_oauth_access_token_manager" "accessTokenManager" {
   }
 }
 
-resource "pingfederate_openid_connect_policy" "oidcPolicy" {
-  policy_id = "oidcPolicy"
-  name      = "oidcPolicy"
+resource "pingfederate_openid_connect_policy" "acctestClientSettingsOidcPolicy" {
+  policy_id = "acctestClientSettingsOidcPolicy"
+  name      = "acctestClientSettingsOidcPolicy"
   access_token_manager_ref = {
-    id = pingfederate_oauth_access_token_manager.accessTokenManager.manager_id
+    id = pingfederate_oauth_access_token_manager.acctestClientSettingsAtm.manager_id
   }
   attribute_contract = {
     extended_attributes = []
@@ -159,7 +159,7 @@ func oauthClientSettings_CompleteHCL() string {
 resource "pingfederate_oauth_client_settings" "example" {
   depends_on = [
     pingfederate_oauth_server_settings.oauthSettings,
-    pingfederate_openid_connect_policy.oidcPolicy
+    pingfederate_openid_connect_policy.acctestClientSettingsOidcPolicy
   ]
   dynamic_client_registration = {
     allow_client_delete                          = false
@@ -184,7 +184,7 @@ resource "pingfederate_oauth_client_settings" "example" {
     oidc_policy = {
       id_token_signing_algorithm = "ES256"
       policy_group = {
-        id = "oidcPolicy"
+        id = "acctestClientSettingsOidcPolicy"
       }
     }
     pending_authorization_timeout_override  = 5
@@ -16,7 +16,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
-const oauthOpenIdConnectPoliciesId = "testOpenIdConnectPolicy"
+const oauthOpenIdConnectPoliciesId = "acctestOidcPolicy"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type oauthOpenIdConnectPoliciesResourceModel struct {
@@ -129,8 +129,8 @@ func TestAccOpenidConnectPolicies(t *testing.T) {
 func accessTokenManagerHcl() string {
 	return `
 resource "pingfederate_oauth_access_token_manager" "jsonWebTokenOauthAccessTokenManagerExample" {
-  manager_id = "oidcJsonWebTokenExample"
-  name       = "oidcJsonWebTokenExample"
+  manager_id = "acctestOidcPolicyAtm"
+  name       = "acctestOidcPolicyAtm"
   plugin_descriptor_ref = {
     id = "com.pingidentity.pf.access.token.management.plugins.JwtBearerAccessTokenManagementPlugin"
   }
@@ -13,7 +13,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
-const pingOneForEnterpriseDirectoryPasswordCredentialValidatorsId = "pingOneForEnterpriseDirectoryPcv"
+const pingOneForEnterpriseDirectoryPasswordCredentialValidatorsId = "acctestP14EDirectoryPcv"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type pingOneForEnterpriseDirectoryPasswordCredentialValidatorsResourceModel struct {
@@ -12,7 +12,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const radiusPasswordCredentialValidatorsId = "radiusPcv"
+const radiusPasswordCredentialValidatorsId = "acctestRadiusPcv"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type radiusPasswordCredentialValidatorsResourceModel struct {
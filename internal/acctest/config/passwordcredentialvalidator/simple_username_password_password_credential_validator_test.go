@@ -12,7 +12,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const simpleUsernamePasswordPasswordCredentialValidatorsId = "simpleUsernamePasswordPcv"
+const simpleUsernamePasswordPasswordCredentialValidatorsId = "acctestSimpleUsernamePcv"
 
 // Attributes to test with. Add optional properties to test here if desired.
 type simpleUsernamePasswordPasswordCredentialValidatorsResourceModel struct {
@@ -0,0 +1,57 @@
+package pingoneconnection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const statusPingOneConnectionId = "acctestStatusPingOneConnection"
+
+func TestAccPingOneConnectionStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.ConfigurationPreCheck(t)
+			if credentialData == "" {
+				t.Fatal("PF_TF_ACC_TEST_PING_ONE_CONNECTION_CREDENTIAL_DATA must be set for acceptance tests")
+			}
+			if pingOneEnvironmentId == "" {
+				t.Fatal("PF_TF_P1_CONNECTION_ENV_ID must be set for acceptance tests")
+			}
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPingOneConnectionStatusDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pingfederate_pingone_connection_status.example", "credential_status"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pingfederate_pingone_connection_status.example", "environments.*", map[string]string{
+						"id": pingOneEnvironmentId,
+					}),
+					resource.TestCheckResourceAttr("data.pingfederate_pingone_connection_status.example", "usages.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPingOneConnectionStatusDataSource() string {
+	return fmt.Sprintf(`
+resource "pingfederate_pingone_connection" "example" {
+  connection_id = "%[1]s"
+  name          = "%[1]s"
+  credential    = "%[2]s"
+}
+
+data "pingfederate_pingone_connection_status" "example" {
+  connection_id = pingfederate_pingone_connection.example.connection_id
+}
+`, statusPingOneConnectionId, credentialData)
+}
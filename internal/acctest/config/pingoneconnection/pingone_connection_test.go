@@ -16,8 +16,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-var pingOneConnectionId = "myPingOneConnectionId"
-var pingOneConnectionName = "myPingOneConnectionName"
+var pingOneConnectionId = "acctestPingOneConnection"
+var pingOneConnectionName = "acctestPingOneConnectionName"
 var credentialData = os.Getenv("PF_TF_ACC_TEST_PING_ONE_CONNECTION_CREDENTIAL_DATA")
 var pingOneEnvironmentId = os.Getenv("PF_TF_P1_CONNECTION_ENV_ID")
 
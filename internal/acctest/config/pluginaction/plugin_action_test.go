@@ -0,0 +1,122 @@
+package pluginaction_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccPluginActions_DataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// List the actions on the default data store
+				Config: pluginActions_DataSourceHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pingfederate_plugin_actions.example", "plugin_type", "DATA_STORE"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_plugin_actions.example", "actions.#"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_plugin_actions.example", "actions.0.action_id"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_plugin_actions.example", "actions.0.name"),
+					resource.TestCheckResourceAttr("data.pingfederate_plugin_actions.example", "actions.0.download", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPluginAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Invoke the first action listed for the default data store
+				Config: pluginAction_HCL("initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_plugin_action.example", "plugin_type", "DATA_STORE"),
+					resource.TestCheckResourceAttr("pingfederate_plugin_action.example", "plugin_id", "ProvisionerDS"),
+					resource.TestCheckResourceAttrPair("pingfederate_plugin_action.example", "action_id", "data.pingfederate_plugin_actions.example", "actions.0.action_id"),
+					resource.TestCheckResourceAttrSet("pingfederate_plugin_action.example", "result_message"),
+				),
+			},
+			{
+				// Changing the trigger values invokes the action again
+				Config: pluginAction_HCL("updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pingfederate_plugin_action.example", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_plugin_action.example", "action_trigger_values.trigger", "updated"),
+					resource.TestCheckResourceAttrSet("pingfederate_plugin_action.example", "result_message"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPluginAction_UnknownAction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      pluginAction_UnknownActionHCL(),
+				ExpectError: regexp.MustCompile("An error occurred while getting the plugin action"),
+			},
+		},
+	})
+}
+
+func pluginActions_DataSourceHCL() string {
+	return `
+data "pingfederate_plugin_actions" "example" {
+  plugin_type = "DATA_STORE"
+  plugin_id   = "ProvisionerDS"
+}
+`
+}
+
+func pluginAction_HCL(trigger string) string {
+	return fmt.Sprintf(`
+data "pingfederate_plugin_actions" "example" {
+  plugin_type = "DATA_STORE"
+  plugin_id   = "ProvisionerDS"
+}
+
+resource "pingfederate_plugin_action" "example" {
+  plugin_type = data.pingfederate_plugin_actions.example.plugin_type
+  plugin_id   = data.pingfederate_plugin_actions.example.plugin_id
+  action_id   = data.pingfederate_plugin_actions.example.actions[0].action_id
+  action_trigger_values = {
+    "trigger" = "%s"
+  }
+}
+`, trigger)
+}
+
+func pluginAction_UnknownActionHCL() string {
+	return `
+resource "pingfederate_plugin_action" "example" {
+  plugin_type = "DATA_STORE"
+  plugin_id   = "ProvisionerDS"
+  action_id   = "doesNotExist"
+}
+`
+}
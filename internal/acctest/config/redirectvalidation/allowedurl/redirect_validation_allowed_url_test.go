@@ -0,0 +1,240 @@
+package redirectvalidationallowedurl_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+)
+
+const (
+	validDomain = "allowedurl.bxretail.org"
+	validPath   = "/callback"
+	validUri    = "https://allowedurl.bxretail.org/*/logout"
+	// Entry that is not managed by terraform, which should be left in place
+	unmanagedValidDomain = "unmanaged.bxretail.org"
+)
+
+func TestAccRedirectValidationAllowedUrl_MinimalMaximal(t *testing.T) {
+	steps := []resource.TestStep{
+		{
+			// Create the resource with a minimal model
+			PreConfig: func() {
+				redirectValidationAllowedUrl_Modify(t, func(whiteList []client.RedirectValidationSettingsWhitelistEntry) []client.RedirectValidationSettingsWhitelistEntry {
+					return append(whiteList, client.RedirectValidationSettingsWhitelistEntry{
+						ValidDomain: unmanagedValidDomain,
+					})
+				})
+			},
+			Config: redirectValidationAllowedUrl_MinimalHCL(),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("pingfederate_redirect_validation_allowed_url.example", "id", validDomain+validPath),
+				resource.TestCheckResourceAttr("pingfederate_redirect_validation_allowed_url.example", "target_resource_sso", "false"),
+				resource.TestCheckResourceAttr("pingfederate_redirect_validation_allowed_url.example", "require_https", "false"),
+			),
+		},
+		{
+			// Update to a complete model
+			Config: redirectValidationAllowedUrl_CompleteHCL(),
+			Check:  redirectValidationAllowedUrl_CheckWhiteListEntry(true),
+		},
+		{
+			// Test importing the resource
+			Config:                               redirectValidationAllowedUrl_CompleteHCL(),
+			ResourceName:                         "pingfederate_redirect_validation_allowed_url.example",
+			ImportStateId:                        validDomain + validPath,
+			ImportStateVerifyIdentifierAttribute: "id",
+			ImportState:                          true,
+			ImportStateVerify:                    true,
+		},
+		{
+			// Back to minimal model
+			Config: redirectValidationAllowedUrl_MinimalHCL(),
+			Check:  redirectValidationAllowedUrl_CheckWhiteListEntry(false),
+		},
+	}
+	if acctest.VersionAtLeast(version.PingFederate1210) {
+		steps = append(steps,
+			resource.TestStep{
+				// Replace with a URI allow list entry
+				Config: redirectValidationAllowedUrl_UriHCL(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_redirect_validation_allowed_url.example", "id", "uri:"+validUri),
+					redirectValidationAllowedUrl_CheckUriEntry(),
+				),
+			},
+			resource.TestStep{
+				// Test importing the resource
+				Config:                               redirectValidationAllowedUrl_UriHCL(),
+				ResourceName:                         "pingfederate_redirect_validation_allowed_url.example",
+				ImportStateId:                        "uri:" + validUri,
+				ImportStateVerifyIdentifierAttribute: "id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: redirectValidationAllowedUrl_CheckDestroy,
+		Steps:        steps,
+	})
+}
+
+// Minimal HCL with only required values set
+func redirectValidationAllowedUrl_MinimalHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_redirect_validation_allowed_url" "example" {
+  valid_domain = "%s"
+  valid_path   = "%s"
+}
+`, validDomain, validPath)
+}
+
+// Maximal HCL with all values set where possible
+func redirectValidationAllowedUrl_CompleteHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_redirect_validation_allowed_url" "example" {
+  valid_domain             = "%s"
+  valid_path               = "%s"
+  target_resource_sso      = true
+  target_resource_slo      = true
+  in_error_resource        = true
+  idp_discovery            = true
+  allow_query_and_fragment = true
+  require_https            = true
+}
+`, validDomain, validPath)
+}
+
+func redirectValidationAllowedUrl_UriHCL() string {
+	return fmt.Sprintf(`
+resource "pingfederate_redirect_validation_allowed_url" "example" {
+  valid_uri           = "%s"
+  target_resource_slo = true
+  in_error_resource   = true
+}
+`, validUri)
+}
+
+func getRedirectValidationSettings() (*client.RedirectValidationSettings, error) {
+	testClient := acctest.TestClient()
+	response, _, err := testClient.RedirectValidationAPI.GetRedirectValidationSettings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if response.RedirectValidationLocalSettings == nil {
+		response.RedirectValidationLocalSettings = client.NewRedirectValidationLocalSettings()
+	}
+	return response, nil
+}
+
+func findWhiteListEntry(settings *client.RedirectValidationSettings, domain, path string) *client.RedirectValidationSettingsWhitelistEntry {
+	whiteList := settings.RedirectValidationLocalSettings.WhiteList
+	i := slices.IndexFunc(whiteList, func(entry client.RedirectValidationSettingsWhitelistEntry) bool {
+		return entry.ValidDomain == domain && entry.GetValidPath() == path
+	})
+	if i < 0 {
+		return nil
+	}
+	return &whiteList[i]
+}
+
+// Validate that the expected attributes are set on the PingFederate server
+func redirectValidationAllowedUrl_CheckWhiteListEntry(flags bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "RedirectValidationAllowedUrl"
+		resourceName := validDomain + validPath
+		settings, err := getRedirectValidationSettings()
+		if err != nil {
+			return err
+		}
+
+		entry := findWhiteListEntry(settings, validDomain, validPath)
+		if entry == nil {
+			return fmt.Errorf("white list entry '%s' not found in PingFederate", resourceName)
+		}
+
+		err = acctest.TestAttributesMatchBool(resourceType, &resourceName, "target_resource_sso", flags, entry.GetTargetResourceSSO())
+		if err != nil {
+			return err
+		}
+		err = acctest.TestAttributesMatchBool(resourceType, &resourceName, "idp_discovery", flags, entry.GetIdpDiscovery())
+		if err != nil {
+			return err
+		}
+		return acctest.TestAttributesMatchBool(resourceType, &resourceName, "require_https", flags, entry.GetRequireHttps())
+	}
+}
+
+func redirectValidationAllowedUrl_CheckUriEntry() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		settings, err := getRedirectValidationSettings()
+		if err != nil {
+			return err
+		}
+		if findWhiteListEntry(settings, validDomain, validPath) != nil {
+			return acctest.ExpectedDestroyError("RedirectValidationAllowedUrl", validDomain+validPath)
+		}
+		if !slices.ContainsFunc(settings.RedirectValidationLocalSettings.UriAllowList, func(entry client.RedirectValidationSettingsUriAllowlistEntry) bool {
+			return entry.ValidUri == validUri
+		}) {
+			return fmt.Errorf("URI allow list entry '%s' not found in PingFederate", validUri)
+		}
+		return nil
+	}
+}
+
+func redirectValidationAllowedUrl_Modify(t *testing.T, modify func(whiteList []client.RedirectValidationSettingsWhitelistEntry) []client.RedirectValidationSettingsWhitelistEntry) {
+	settings, err := getRedirectValidationSettings()
+	if err != nil {
+		t.Fatalf("Failed to get redirect validation settings: %v", err)
+	}
+	settings.RedirectValidationLocalSettings.WhiteList = modify(settings.RedirectValidationLocalSettings.WhiteList)
+	if err = putRedirectValidationSettings(settings); err != nil {
+		t.Fatalf("Failed to update redirect validation settings: %v", err)
+	}
+}
+
+func putRedirectValidationSettings(settings *client.RedirectValidationSettings) error {
+	testClient := acctest.TestClient()
+	_, _, err := testClient.RedirectValidationAPI.UpdateRedirectValidationSettings(acctest.TestBasicAuthContext()).Body(*settings).Execute()
+	return err
+}
+
+// Test that the entries created by the test are destroyed, and that the unmanaged entry was left in place
+func redirectValidationAllowedUrl_CheckDestroy(s *terraform.State) error {
+	settings, err := getRedirectValidationSettings()
+	if err != nil {
+		return err
+	}
+	if findWhiteListEntry(settings, validDomain, validPath) != nil {
+		return acctest.ExpectedDestroyError("RedirectValidationAllowedUrl", validDomain+validPath)
+	}
+	if slices.ContainsFunc(settings.RedirectValidationLocalSettings.UriAllowList, func(entry client.RedirectValidationSettingsUriAllowlistEntry) bool {
+		return entry.ValidUri == validUri
+	}) {
+		return acctest.ExpectedDestroyError("RedirectValidationAllowedUrl", "uri:"+validUri)
+	}
+	if findWhiteListEntry(settings, unmanagedValidDomain, "") == nil {
+		return fmt.Errorf("unmanaged white list entry '%s' was removed", unmanagedValidDomain)
+	}
+
+	// Clean up the unmanaged entry
+	settings.RedirectValidationLocalSettings.WhiteList = slices.DeleteFunc(settings.RedirectValidationLocalSettings.WhiteList, func(entry client.RedirectValidationSettingsWhitelistEntry) bool {
+		return entry.ValidDomain == unmanagedValidDomain
+	})
+	return putRedirectValidationSettings(settings)
+}
@@ -0,0 +1,139 @@
+package redirectvalidation_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const unmanagedValidDomain = "unmanaged.bxretail.org"
+
+func TestAccRedirectValidationUnmanagedAllowedUrls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: testAccCheckRedirectValidationUnmanagedAllowedUrlsDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccAddUnmanagedWhiteListEntry(t)
+				},
+				Config: testAccRedirectValidationUnmanagedAllowedUrls("owned.bxretail.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_redirect_validation.example", "redirect_validation_local_settings.white_list.#", "1"),
+					testAccCheckWhiteListDomains([]string{"owned.bxretail.org", unmanagedValidDomain}, nil),
+				),
+			},
+			{
+				// Entries previously owned by the resource are removed, while the unmanaged entry is left in place
+				Config: testAccRedirectValidationUnmanagedAllowedUrls("owned2.bxretail.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_redirect_validation.example", "redirect_validation_local_settings.white_list.#", "1"),
+					resource.TestCheckResourceAttr("pingfederate_redirect_validation.example", "redirect_validation_local_settings.white_list.0.valid_domain", "owned2.bxretail.org"),
+					testAccCheckWhiteListDomains([]string{"owned2.bxretail.org", unmanagedValidDomain}, []string{"owned.bxretail.org"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRedirectValidationUnmanagedAllowedUrls(validDomain string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_redirect_validation" "example" {
+  ignore_unmanaged_allowed_urls = true
+  redirect_validation_local_settings = {
+    enable_target_resource_validation_for_sso = true
+    white_list = [
+      {
+        target_resource_sso = true
+        valid_domain        = "%s"
+      }
+    ]
+  }
+}
+`, validDomain)
+}
+
+func testAccGetWhiteList() ([]client.RedirectValidationSettingsWhitelistEntry, *client.RedirectValidationSettings, error) {
+	testClient := acctest.TestClient()
+	response, _, err := testClient.RedirectValidationAPI.GetRedirectValidationSettings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return nil, nil, err
+	}
+	if response.RedirectValidationLocalSettings == nil {
+		response.RedirectValidationLocalSettings = client.NewRedirectValidationLocalSettings()
+	}
+	return response.RedirectValidationLocalSettings.WhiteList, response, nil
+}
+
+func containsDomain(whiteList []client.RedirectValidationSettingsWhitelistEntry, validDomain string) bool {
+	return slices.ContainsFunc(whiteList, func(entry client.RedirectValidationSettingsWhitelistEntry) bool {
+		return entry.ValidDomain == validDomain
+	})
+}
+
+func testAccAddUnmanagedWhiteListEntry(t *testing.T) {
+	whiteList, settings, err := testAccGetWhiteList()
+	if err != nil {
+		t.Fatalf("Failed to get redirect validation settings: %v", err)
+	}
+	settings.RedirectValidationLocalSettings.WhiteList = append(whiteList, client.RedirectValidationSettingsWhitelistEntry{
+		ValidDomain: unmanagedValidDomain,
+	})
+	testClient := acctest.TestClient()
+	_, _, err = testClient.RedirectValidationAPI.UpdateRedirectValidationSettings(acctest.TestBasicAuthContext()).Body(*settings).Execute()
+	if err != nil {
+		t.Fatalf("Failed to update redirect validation settings: %v", err)
+	}
+}
+
+// Test that the expected white list entries are present on the PingFederate server
+func testAccCheckWhiteListDomains(present, absent []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		whiteList, _, err := testAccGetWhiteList()
+		if err != nil {
+			return err
+		}
+		for _, validDomain := range present {
+			if !containsDomain(whiteList, validDomain) {
+				return fmt.Errorf("white list entry '%s' not found in PingFederate", validDomain)
+			}
+		}
+		for _, validDomain := range absent {
+			if containsDomain(whiteList, validDomain) {
+				return fmt.Errorf("white list entry '%s' was not removed from PingFederate", validDomain)
+			}
+		}
+		return nil
+	}
+}
+
+// Test that the owned entries were removed, and the unmanaged entry was left in place
+func testAccCheckRedirectValidationUnmanagedAllowedUrlsDestroy(s *terraform.State) error {
+	err := testAccCheckWhiteListDomains([]string{unmanagedValidDomain}, []string{"owned.bxretail.org", "owned2.bxretail.org"})(s)
+	if err != nil {
+		return err
+	}
+
+	// Clean up the unmanaged entry
+	whiteList, settings, err := testAccGetWhiteList()
+	if err != nil {
+		return err
+	}
+	settings.RedirectValidationLocalSettings.WhiteList = slices.DeleteFunc(whiteList, func(entry client.RedirectValidationSettingsWhitelistEntry) bool {
+		return entry.ValidDomain == unmanagedValidDomain
+	})
+	testClient := acctest.TestClient()
+	_, _, err = testClient.RedirectValidationAPI.UpdateRedirectValidationSettings(acctest.TestBasicAuthContext()).Body(*settings).Execute()
+	return err
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
 )
 
-const secretManagerManagerId = "secretManagerManagerId"
+const secretManagerManagerId = "acctestSecretManager"
 
 func TestAccSecretManager_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
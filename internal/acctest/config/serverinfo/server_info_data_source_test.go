@@ -0,0 +1,71 @@
+package serverinfo_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+func TestAccServerInfo(t *testing.T) {
+	dataSourceName := "myServerInfo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerInfo(dataSourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedServerInfoVersion("data.pingfederate_server_info."+dataSourceName),
+					resource.TestCheckResourceAttrSet("data.pingfederate_server_info."+dataSourceName, "major_version"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_server_info."+dataSourceName, "minor_version"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_server_info."+dataSourceName, "license.product"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_server_info."+dataSourceName, "license.oauth_enabled"),
+					resource.TestCheckResourceAttrSet("data.pingfederate_server_info."+dataSourceName, "license.ws_trust_enabled"),
+					resource.TestCheckResourceAttr("data.pingfederate_server_info."+dataSourceName, "roles_and_protocols.oauth_enabled", "true"),
+					resource.TestCheckResourceAttr("data.pingfederate_server_info."+dataSourceName, "crypto_provider.hybrid_hsm_mode", "false"),
+					resource.TestCheckResourceAttr("data.pingfederate_server_info."+dataSourceName, "crypto_provider.hsm_key_pairs", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerInfo(dataSourceName string) string {
+	return fmt.Sprintf(`
+data "pingfederate_server_info" "%s" {
+}`, dataSourceName)
+}
+
+// Test that the version and its components match the version reported by the PingFederate server
+func testAccCheckExpectedServerInfoVersion(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.VersionAPI.GetVersion(ctx).Execute()
+		if err != nil {
+			return err
+		}
+
+		checks := []resource.TestCheckFunc{
+			resource.TestCheckResourceAttr(resourceName, "version", response.GetVersion()),
+		}
+		versionParts := strings.Split(response.GetVersion(), ".")
+		if len(versionParts) >= 2 {
+			checks = append(checks,
+				resource.TestCheckResourceAttr(resourceName, "major_version", versionParts[0]),
+				resource.TestCheckResourceAttr(resourceName, "minor_version", versionParts[1]),
+			)
+		}
+		return resource.ComposeTestCheckFunc(checks...)(s)
+	}
+}
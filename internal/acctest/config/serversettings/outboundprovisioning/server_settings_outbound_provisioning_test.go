@@ -0,0 +1,118 @@
+package serversettingsoutboundprovisioning_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const outboundProvisioningDataStoreId = "acctestOutboundProvisioningDS"
+
+// The data store referenced by a default PingFederate server for outbound provisioning
+const defaultProvisionerDataStoreId = "ProvisionerDS"
+
+func TestAccServerSettingsOutboundProvisioning(t *testing.T) {
+	resourceName := "myServerSettingsOutboundProvisioning"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		Steps: []resource.TestStep{
+			{
+				// Minimal model referencing a new data store
+				Config: testAccServerSettingsOutboundProvisioning(resourceName, "pingfederate_data_store."+resourceName+".id", nil),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedServerSettingsOutboundProvisioningAttributes(outboundProvisioningDataStoreId, 60),
+					resource.TestCheckResourceAttr("pingfederate_server_settings_outbound_provisioning."+resourceName, "data_store_ref.id", outboundProvisioningDataStoreId),
+					resource.TestCheckResourceAttr("pingfederate_server_settings_outbound_provisioning."+resourceName, "synchronization_frequency", "60"),
+				),
+			},
+			{
+				// Update the synchronization frequency
+				Config: testAccServerSettingsOutboundProvisioning(resourceName, "pingfederate_data_store."+resourceName+".id", 120),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedServerSettingsOutboundProvisioningAttributes(outboundProvisioningDataStoreId, 120),
+					resource.TestCheckResourceAttr("pingfederate_server_settings_outbound_provisioning."+resourceName, "synchronization_frequency", "120"),
+					resource.TestCheckResourceAttr("data.pingfederate_server_settings_outbound_provisioning."+resourceName, "data_store_ref.id", outboundProvisioningDataStoreId),
+					resource.TestCheckResourceAttr("data.pingfederate_server_settings_outbound_provisioning."+resourceName, "synchronization_frequency", "120"),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               testAccServerSettingsOutboundProvisioning(resourceName, "pingfederate_data_store."+resourceName+".id", 120),
+				ResourceName:                         "pingfederate_server_settings_outbound_provisioning." + resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "data_store_ref.id",
+			},
+			{
+				// Point back at the default data store, so the new data store can be deleted
+				Config: testAccServerSettingsOutboundProvisioning(resourceName, strconv.Quote(defaultProvisionerDataStoreId), nil),
+				Check:  testAccCheckExpectedServerSettingsOutboundProvisioningAttributes(defaultProvisionerDataStoreId, 60),
+			},
+		},
+	})
+}
+
+func testAccServerSettingsOutboundProvisioning(resourceName, dataStoreIdHcl string, synchronizationFrequency any) string {
+	optionalHcl := ""
+	if synchronizationFrequency != nil {
+		optionalHcl = fmt.Sprintf("synchronization_frequency = %v", synchronizationFrequency)
+	}
+	return fmt.Sprintf(`
+resource "pingfederate_data_store" "%[1]s" {
+  data_store_id = "%[2]s"
+  jdbc_data_store = {
+    name           = "Outbound Provisioning Data Store"
+    connection_url = "jdbc:hsqldb:$${pf.server.data.dir}$${/}hypersonic$${/}ProvisionerDefaultDB;hsqldb.lock_file=false"
+    driver_class   = "org.hsqldb.jdbcDriver"
+    user_name      = "sa"
+    password       = "secretpass"
+  }
+}
+
+resource "pingfederate_server_settings_outbound_provisioning" "%[1]s" {
+  data_store_ref = {
+    id = %[3]s
+  }
+  %[4]s
+}
+
+data "pingfederate_server_settings_outbound_provisioning" "%[1]s" {
+  depends_on = [pingfederate_server_settings_outbound_provisioning.%[1]s]
+}`, resourceName,
+		outboundProvisioningDataStoreId,
+		dataStoreIdHcl,
+		optionalHcl,
+	)
+}
+
+// Test that the expected attributes are set on the PingFederate server
+func testAccCheckExpectedServerSettingsOutboundProvisioningAttributes(dataStoreId string, synchronizationFrequency int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceType := "ServerSettingsOutboundProvisioning"
+		testClient := acctest.TestClient()
+		ctx := acctest.TestBasicAuthContext()
+		response, _, err := testClient.ServerSettingsAPI.GetOutBoundProvisioningSettings(ctx).Execute()
+		if err != nil {
+			return err
+		}
+
+		// Verify that attributes have expected values
+		err = acctest.TestAttributesMatchString(resourceType, nil, "data_store_ref.id", dataStoreId, response.DataStoreRef.Id)
+		if err != nil {
+			return err
+		}
+
+		return acctest.TestAttributesMatchInt(resourceType, nil, "synchronization_frequency", synchronizationFrequency, response.GetSynchronizationFrequency())
+	}
+}
@@ -0,0 +1,75 @@
+package sessionsettings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/utils"
+)
+
+var retainedSessionSettings = sessionSettingsResourceModel{
+	trackAdapterSessionsForLogout: true,
+	revokeUserSessionOnLogout:     false,
+	sessionRevocationLifetime:     120,
+}
+
+func TestAccSessionSettingsOnDestroyRetain(t *testing.T) {
+	resourceName := "mySessionSettings"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: sessionSettingsOnDestroy_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionSettingsOnDestroy(resourceName, retainedSessionSettings, "retain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedSessionSettingsAttributes(&retainedSessionSettings),
+					resource.TestCheckResourceAttr("pingfederate_session_settings."+resourceName, "on_destroy", "retain"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSessionSettingsOnDestroy(resourceName string, resourceModel sessionSettingsResourceModel, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_session_settings" "%s" {
+  track_adapter_sessions_for_logout = %t
+  revoke_user_session_on_logout     = %t
+  session_revocation_lifetime       = %d
+  on_destroy                        = "%s"
+}`, resourceName,
+		resourceModel.trackAdapterSessionsForLogout,
+		resourceModel.revokeUserSessionOnLogout,
+		resourceModel.sessionRevocationLifetime,
+		onDestroy,
+	)
+}
+
+// Test that the session settings were left in place
+func sessionSettingsOnDestroy_CheckDestroy(s *terraform.State) error {
+	err := testAccCheckExpectedSessionSettingsAttributes(&retainedSessionSettings)(s)
+	if err != nil {
+		return err
+	}
+
+	// Clean up the retained settings
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	_, _, err = testClient.SessionAPI.UpdateSessionSettings(ctx).Body(client.SessionSettings{
+		TrackAdapterSessionsForLogout: utils.Pointer(false),
+		RevokeUserSessionOnLogout:     utils.Pointer(true),
+		SessionRevocationLifetime:     utils.Pointer(int64(40)),
+	}).Execute()
+	return err
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const spAdapterAdapterId = "spAdapterAdapterId"
+const spAdapterAdapterId = "acctestSpAdapter"
 
 func TestAccSpAdapter_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -17,8 +17,8 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const spAuthenticationPolicyContractMappingId = "authenticationpolicycontractid|spadapter"
-const apcSourceId = "authenticationpolicycontractid"
+const spAuthenticationPolicyContractMappingId = "acctestSpApcMappingContract|spadapter"
+const apcSourceId = "acctestSpApcMappingContract"
 const spTargetId = "spadapter"
 
 type spAuthenticationPolicyContractMappingResourceModel struct {
@@ -99,7 +99,7 @@ func testAccSpAuthenticationPolicyContractMapping(resourceName string, resourceM
 resource "pingfederate_authentication_policy_contract" "authenticationPolicyContractExample" {
   extended_attributes = [{ name = "extended_attribute" }, { name = "extended_attribute2" }]
   name                = "example"
-  contract_id         = "authenticationpolicycontractid"
+  contract_id         = "acctestSpApcMappingContract"
 }
 resource "pingfederate_sp_authentication_policy_contract_mapping" "%[1]s" {
   source_id = pingfederate_authentication_policy_contract.authenticationPolicyContractExample.id
@@ -0,0 +1,127 @@
+package spidpconnectioncredentialcert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	connectionId       = "acctestCredentialCertIdpConn"
+	parentCertId       = "parentcert"
+	parentCertFileData = "MIIDOjCCAiICCQCjbB7XBVkxCzANBgkqhkiG9w0BAQsFADBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwHhcNMjMwNzE0MDI1NDUzWhcNMjQwNzEzMDI1NDUzWjBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC5yFrh9VR2wk9IjzMz+Ei80K453g1j1/Gv3EQ/SC9h7HZBI6aV9FaEYhGnaquRT5q87p8lzCphKNXVyeL6T/pDJOW70zXItkl8Ryoc0tIaknRQmj8+YA0Hr9GDdmYev2yrxSoVS7s5Bl8poasn3DljgnWT07vsQz+hw3NY4SPp7IFGP2PpGUBBIIvrOaDWpPGsXeznBxSFtis6Qo+JiEoaVql9b9/XyKZj65wOsVyZhFWeM1nCQITSP9OqOc9FSoDFYQ1AVogm4A2AzUrkMnT1SrN2dCuTmNbeVw7gOMqMrVf0CiTv9hI0cATbO5we1sPAlJxscSkJjsaI+sQfjiAnAgMBAAEwDQYJKoZIhvcNAQELBQADggEBACgwoH1qklPF1nI9+WbIJ4K12Dl9+U3ZMZa2lP4hAk1rMBHk9SHboOU1CHDQKT1Z6uxi0NI4JZHmP1qP8KPNEWTI8Q76ue4Q3aiA53EQguzGb3SEtyp36JGBq05Jor9erEebFftVl83NFvio72Fn0N2xvu8zCnlylf2hpz9x1i01Xnz5UNtZ2ppsf2zzT+4U6w3frH+pkp0RDPuoe9mnBF001AguP31hSBZyZzWcwQltuNELnSRCcgJl4kC2h3mAgaVtYalrFxLRa3tA2XF2BHRHmKgocedVhTq+81xrqj+WQuDmUe06DnrS3Ohmyj3jhsCCluznAolmrBhT/SaDuGg="
+	certId             = "credentialcert"
+	certFileData       = `-----BEGIN CERTIFICATE-----\nMIIE/zCCAumgAwIBAgIQLYHoX089T3MMLt57qv0KBDALBgkqhkiG9w0BAQswLDEL\nMAkGA1UEBgwCdXMxDDAKBgNVBAoMA29yZzEPMA0GA1UEAwwGY29tbW9uMB4XDTI0\nMDYyNDIwNDkxMVoXDTI1MDYyNDIwNDkxMVowLDELMAkGA1UEBgwCdXMxDDAKBgNV\nBAoMA29yZzEPMA0GA1UEAwwGY29tbW9uMIICIjANBgkqhkiG9w0BAQEFAAOCAg8A\nMIICCgKCAgEAwG9aAPfIVzqXOUQgXKpcykmTzmGZV+O0HLjuV4WrnvFZ1k0PxjuX\njFtuFbpX99MFURVBX989tm8FUd8yWS0b9pLg+YRIC/dg1KSvE7t80Vp9Zw9WrChp\nPF39HI8SCth/6TAU6oajU4UxSDXNI2MAyL7JJuQ/UuTdYgmQWa+plIjSWMTNzlMX\nLZyitg2GBc6h0JMPFs52I5lfG0Ju+lG+kxlW8jb2H86+mSqeArVlc2Y2FalymcPa\n8yjO7Zwn5sIZlLDrSlO0DgJhfPtM1Gq1B5xbOTPaC8As2tVd8X6dlk7lr+8kiQOo\nflXHDvItM1WD+3fGHgdf/PnSciUpQ0Ss2S+CwowpYDgCrONaKmpBoKpJcslN0TtP\nkFTmk0tw9Fu7rJhgMN+xkm8U7MMZDXq/tGevtxjfrtfeJT+BD8+7wbl4G4W2Vo66\nhbX2HB4ACR2k60KKyeem6Z5oMkKsOnSEgv6Sw4QpXauiJBQuq9AnpbzN/OtinSlt\n9S0NtdfajHoJWaebI1T4MDUndh1ldzpXgE6hC/r6p59oN7aQ4r7S3ta3AGp0Cd0l\nZFD7hKQPah7fRWo8OZrWrno8RVfnBUkjKIkBZTjag647iLjN0WKYUsb95FFikVOT\nR/xjzSIrq9oWbPWmQG+MR2XXeoGXM+9gZKjdWWgG90VOm0Xxli2co20CAwEAAaMh\nMB8wHQYDVR0OBBYEFCENlVBTH/3D9Gwd5xX2QE21KTc7MAsGCSqGSIb3DQEBCwOC\nAgEASwQGIWcwQ5BsUo7fKCitD/y9KqgYy02Q4t3yCUHCs1kI4/2nIvNzhfknI7+n\njPxPCQ/Xdb/YDHkXMjkKg2J1490URFQzB8fC5yrL0Fe4VXrG1zXxTOdQ+vq7Imxa\nJ9U8mg6YiOfa0BXK8JkXF3pRLTkMO0WUAOl+24/paziKPJyNTJ5bSM6r9pVmAww7\nfkUAl64ZmxCwNaJGvY6LMCE0BlLAl3uUFu1+e1k0oS5S58Ru6jUfPX6cxk3+kOl1\nX+41f4/ZmAywTlMRrwdzki2GTBiwjd1DjridVQwHnUIC34kdTvzxddQk2VyTk8Qm\n2wO7ZCNUkCMa79waM+j7R+M3V3RWg/dNvIxuRPI7pFGz3ODJT2gZ26oB8252uD0w\nmllQx3LB+M+MDmylnNzkZLl5LoGzfrDQyJmMCUGENgZYpwxU08Zu2eFVuUGhtb/8\nRhXl1hq2+ijJ0WoFdE/AaVamBlBtczI7U0mhsq4xbTD+QBpn/XGKBTxfmrOmVnGJ\nGbGOpVA28KXwuOnpUxb5s0Kr+ADtbeqESyXTI8P1NA1n9QBr6e0RUuF13RjQE9Rr\nmlm7cm7kGfmDnhYsg6udgz6V9QKIw0SLgLAk89tFosivM7elnYc8gio8DWlrqpQe\nsrBL9AecFcuCR/4sBq5snNcCd+QUfkxfcGazfUpJwxRbSig=\n-----END CERTIFICATE-----`
+)
+
+func TestAccSpIdpConnectionCredentialCert(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: spIdpConnectionCredentialCert_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Add a certificate alongside the certificate managed by the connection
+				Config: spIdpConnectionCredentialCert_HCL("connection name", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_credential_cert.example", "id", certId),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_credential_cert.example", "encryption_cert", "false"),
+					resource.TestCheckResourceAttrSet("pingfederate_sp_idp_connection_credential_cert.example", "sha256_fingerprint"),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.example", "credentials.certs.#", "1"),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.example", "credentials.certs.0.x509_file.id", parentCertId),
+				),
+			},
+			{
+				// Updating the connection retains the certificate
+				Config: spIdpConnectionCredentialCert_HCL("updated connection name", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_credential_cert.example", "encryption_cert", "true"),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.example", "credentials.certs.#", "1"),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               spIdpConnectionCredentialCert_HCL("updated connection name", true),
+				ResourceName:                         "pingfederate_sp_idp_connection_credential_cert.example",
+				ImportStateId:                        connectionId + "/" + certId,
+				ImportStateVerifyIdentifierAttribute: "cert_id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				// file_data is reformatted by PingFederate
+				ImportStateVerifyIgnore: []string{"file_data"},
+			},
+		},
+	})
+}
+
+func spIdpConnectionCredentialCert_HCL(connectionName string, encryptionCert bool) string {
+	return fmt.Sprintf(`
+resource "pingfederate_sp_idp_connection" "example" {
+  connection_id      = "%[1]s"
+  name               = "%[2]s"
+  entity_id          = "credentialCertIdpEntity"
+  virtual_entity_ids = []
+  credentials = {
+    certs = [{
+      x509_file = {
+        id        = "%[3]s"
+        file_data = "%[4]s"
+      }
+      active_verification_cert  = true
+      primary_verification_cert = true
+    }]
+  }
+  ws_trust = {
+    attribute_contract = {
+      core_attributes = [
+        {
+          name   = "TOKEN_SUBJECT"
+          masked = false
+        }
+      ]
+    }
+    token_generator_mappings = [
+      {
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "NO_MAPPING"
+            }
+          }
+        }
+        sp_token_generator_ref = {
+          id = "tokengenerator"
+        }
+        default_mapping = true
+      }
+    ]
+    generate_local_token = true
+  }
+}
+
+resource "pingfederate_sp_idp_connection_credential_cert" "example" {
+  connection_id   = pingfederate_sp_idp_connection.example.connection_id
+  cert_id         = "%[5]s"
+  file_data       = "%[6]s"
+  encryption_cert = %[7]t
+}
+`, connectionId, connectionName, parentCertId, parentCertFileData, certId, certFileData, encryptionCert)
+}
+
+// Test that any objects created by the test are destroyed
+func spIdpConnectionCredentialCert_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, err := testClient.SpIdpConnectionsAPI.DeleteConnection(acctest.TestBasicAuthContext(), connectionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("SP IdP Connection", connectionId)
+	}
+	return nil
+}
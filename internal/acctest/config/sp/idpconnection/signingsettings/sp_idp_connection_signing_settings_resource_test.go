@@ -0,0 +1,115 @@
+package spidpconnectionsigningsettings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	connectionId = "acctestSigningSettingsIdpConn"
+	signingKeyId = "419x9yg43rlawqwq9v6az997k"
+)
+
+func TestAccSpIdpConnectionSigningSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: spIdpConnectionSigningSettings_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Add signing settings to a connection that doesn't manage its own signing settings
+				Config: spIdpConnectionSigningSettings_HCL("connection name", "SHA256withRSA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_signing_settings.example", "id", connectionId),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_signing_settings.example", "signing_key_pair_ref.id", signingKeyId),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_signing_settings.example", "algorithm", "SHA256withRSA"),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_signing_settings.example", "include_cert_in_signature", "false"),
+					resource.TestCheckNoResourceAttr("pingfederate_sp_idp_connection.example", "credentials.signing_settings"),
+				),
+			},
+			{
+				// Updating the connection retains the signing settings
+				Config: spIdpConnectionSigningSettings_HCL("updated connection name", "SHA384withRSA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection_signing_settings.example", "algorithm", "SHA384withRSA"),
+					resource.TestCheckNoResourceAttr("pingfederate_sp_idp_connection.example", "credentials.signing_settings"),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               spIdpConnectionSigningSettings_HCL("updated connection name", "SHA384withRSA"),
+				ResourceName:                         "pingfederate_sp_idp_connection_signing_settings.example",
+				ImportStateId:                        connectionId,
+				ImportStateVerifyIdentifierAttribute: "connection_id",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func spIdpConnectionSigningSettings_HCL(connectionName, algorithm string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_sp_idp_connection" "example" {
+  connection_id      = "%[1]s"
+  name               = "%[2]s"
+  entity_id          = "signingSettingsIdpEntity"
+  virtual_entity_ids = []
+  credentials = {
+  }
+  ws_trust = {
+    attribute_contract = {
+      core_attributes = [
+        {
+          name   = "TOKEN_SUBJECT"
+          masked = false
+        }
+      ]
+    }
+    token_generator_mappings = [
+      {
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "NO_MAPPING"
+            }
+          }
+        }
+        sp_token_generator_ref = {
+          id = "tokengenerator"
+        }
+        default_mapping = true
+      }
+    ]
+    generate_local_token = true
+  }
+}
+
+resource "pingfederate_sp_idp_connection_signing_settings" "example" {
+  connection_id = pingfederate_sp_idp_connection.example.connection_id
+  signing_key_pair_ref = {
+    id = "%[3]s"
+  }
+  algorithm = "%[4]s"
+}
+`, connectionId, connectionName, signingKeyId, algorithm)
+}
+
+// Test that any objects created by the test are destroyed
+func spIdpConnectionSigningSettings_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, err := testClient.SpIdpConnectionsAPI.DeleteConnection(acctest.TestBasicAuthContext(), connectionId).Execute()
+	if err == nil {
+		return acctest.ExpectedDestroyError("SP IdP Connection", connectionId)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package resource_sp_idp_connection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	metadataIdpConnectionId = "acctestMetadataIdpConn"
+	metadataIdpEntityId     = "https://idp.bxretail.org/metadata"
+	metadataIdpCert         = "MIIDOjCCAiICCQCjbB7XBVkxCzANBgkqhkiG9w0BAQsFADBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwHhcNMjMwNzE0MDI1NDUzWhcNMjQwNzEzMDI1NDUzWjBfMRIwEAYDVQQDDAlsb2NhbGhvc3QxDjAMBgNVBAgMBVRFWEFTMQ8wDQYDVQQHDAZBVVNUSU4xDTALBgNVBAsMBFBJTkcxDDAKBgNVBAoMA0NEUjELMAkGA1UEBhMCVVMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC5yFrh9VR2wk9IjzMz+Ei80K453g1j1/Gv3EQ/SC9h7HZBI6aV9FaEYhGnaquRT5q87p8lzCphKNXVyeL6T/pDJOW70zXItkl8Ryoc0tIaknRQmj8+YA0Hr9GDdmYev2yrxSoVS7s5Bl8poasn3DljgnWT07vsQz+hw3NY4SPp7IFGP2PpGUBBIIvrOaDWpPGsXeznBxSFtis6Qo+JiEoaVql9b9/XyKZj65wOsVyZhFWeM1nCQITSP9OqOc9FSoDFYQ1AVogm4A2AzUrkMnT1SrN2dCuTmNbeVw7gOMqMrVf0CiTv9hI0cATbO5we1sPAlJxscSkJjsaI+sQfjiAnAgMBAAEwDQYJKoZIhvcNAQELBQADggEBACgwoH1qklPF1nI9+WbIJ4K12Dl9+U3ZMZa2lP4hAk1rMBHk9SHboOU1CHDQKT1Z6uxi0NI4JZHmP1qP8KPNEWTI8Q76ue4Q3aiA53EQguzGb3SEtyp36JGBq05Jor9erEebFftVl83NFvio72Fn0N2xvu8zCnlylf2hpz9x1i01Xnz5UNtZ2ppsf2zzT+4U6w3frH+pkp0RDPuoe9mnBF001AguP31hSBZyZzWcwQltuNELnSRCcgJl4kC2h3mAgaVtYalrFxLRa3tA2XF2BHRHmKgocedVhTq+81xrqj+WQuDmUe06DnrS3Ohmyj3jhsCCluznAolmrBhT/SaDuGg="
+)
+
+func TestAccSpIdpConnection_Metadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: metadataIdpConnection_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Populate the connection from metadata
+				Config: metadataIdpConnection_HCL(metadataIdpEntityId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.metadata", "entity_id", metadataIdpEntityId),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.metadata", "credentials.certs.#", "1"),
+					resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.metadata", "credentials.certs.0.x509_file.file_data", metadataIdpCert),
+				),
+			},
+			{
+				// Changes to the metadata are applied to the connection
+				Config: metadataIdpConnection_HCL(metadataIdpEntityId + "/updated"),
+				Check:  resource.TestCheckResourceAttr("pingfederate_sp_idp_connection.metadata", "entity_id", metadataIdpEntityId+"/updated"),
+			},
+		},
+	})
+}
+
+func metadataIdpConnection_HCL(entityId string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_sp_idp_connection" "metadata" {
+  connection_id = "%s"
+  name          = "acctestMetadataIdpConn"
+  metadata = {
+    xml = <<EOT
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="%s">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo>
+        <ds:X509Data>
+          <ds:X509Certificate>%s</ds:X509Certificate>
+        </ds:X509Data>
+      </ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.bxretail.org/idp/SSO.saml2"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>
+EOT
+  }
+  ws_trust = {
+    attribute_contract = {
+      core_attributes = [
+        {
+          name   = "TOKEN_SUBJECT"
+          masked = false
+        }
+      ]
+    }
+    token_generator_mappings = [
+      {
+        attribute_contract_fulfillment = {
+          "SAML_SUBJECT" = {
+            source = {
+              type = "NO_MAPPING"
+            }
+          }
+        }
+        sp_token_generator_ref = {
+          id = "tokengenerator"
+        }
+        default_mapping = true
+      }
+    ]
+    generate_local_token = true
+  }
+}
+`, metadataIdpConnectionId, entityId, metadataIdpCert)
+}
+
+// Test that any objects created by the test are destroyed
+func metadataIdpConnection_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	_, err := testClient.SpIdpConnectionsAPI.DeleteConnection(acctest.TestBasicAuthContext(), metadataIdpConnectionId).Execute()
+	if err == nil {
+		return fmt.Errorf("sp_idp_connection still exists after tests. Expected it to be destroyed")
+	}
+	return nil
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
-const spIdpConnectionConnectionId = "sp_idp_connection_connection_id"
+const spIdpConnectionConnectionId = "acctest_sp_idp_connection"
 
 func TestAccSpIdpConnection_RemovalDrift(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -0,0 +1,161 @@
+package sptargeturlmapping_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	mappingUrl        = "https://www.example.com/targeturlmapping"
+	updatedMappingUrl = "https://www.example.com/targeturlmapping/*"
+	// Mapping that is not managed by terraform, which should be left in place
+	unmanagedMappingUrl = "https://www.example.com/unmanaged"
+)
+
+func TestAccSpTargetUrlMapping(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: spTargetUrlMapping_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					spTargetUrlMapping_Modify(t, func(items []client.SpUrlMapping) []client.SpUrlMapping {
+						return append(items, client.SpUrlMapping{
+							Url:  pointers.String(unmanagedMappingUrl),
+							Type: pointers.String("SP_ADAPTER"),
+							Ref:  client.NewResourceLink("spadapter"),
+						})
+					})
+				},
+				Config: spTargetUrlMapping_HCL(mappingUrl),
+				Check: resource.ComposeTestCheckFunc(
+					spTargetUrlMapping_CheckPresent(mappingUrl),
+					resource.TestCheckResourceAttr("pingfederate_sp_target_url_mapping.example", "id", mappingUrl),
+				),
+			},
+			{
+				// Changing the URL replaces the mapping
+				Config: spTargetUrlMapping_HCL(updatedMappingUrl),
+				Check: resource.ComposeTestCheckFunc(
+					spTargetUrlMapping_CheckPresent(updatedMappingUrl),
+					spTargetUrlMapping_CheckAbsent(mappingUrl),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               spTargetUrlMapping_HCL(updatedMappingUrl),
+				ResourceName:                         "pingfederate_sp_target_url_mapping.example",
+				ImportStateId:                        updatedMappingUrl,
+				ImportStateVerifyIdentifierAttribute: "url",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func spTargetUrlMapping_HCL(url string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_sp_target_url_mapping" "example" {
+  url  = "%s"
+  type = "SP_ADAPTER"
+  ref = {
+    id = "spadapter"
+  }
+}
+`, url)
+}
+
+func getSpUrlMappings() ([]client.SpUrlMapping, error) {
+	testClient := acctest.TestClient()
+	response, _, err := testClient.SpTargetUrlMappingsAPI.GetSpUrlMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+func putSpUrlMappings(items []client.SpUrlMapping) error {
+	testClient := acctest.TestClient()
+	body := client.SpUrlMappings{
+		Items: items,
+	}
+	_, _, err := testClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(acctest.TestBasicAuthContext()).Body(body).Execute()
+	return err
+}
+
+func containsUrl(items []client.SpUrlMapping, url string) bool {
+	return slices.ContainsFunc(items, func(item client.SpUrlMapping) bool {
+		return item.GetUrl() == url
+	})
+}
+
+func spTargetUrlMapping_CheckPresent(url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		items, err := getSpUrlMappings()
+		if err != nil {
+			return err
+		}
+		if !containsUrl(items, url) {
+			return fmt.Errorf("SP target URL mapping '%s' not found in PingFederate", url)
+		}
+		return nil
+	}
+}
+
+func spTargetUrlMapping_CheckAbsent(url string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		items, err := getSpUrlMappings()
+		if err != nil {
+			return err
+		}
+		if containsUrl(items, url) {
+			return acctest.ExpectedDestroyError("SpTargetUrlMapping", url)
+		}
+		return nil
+	}
+}
+
+func spTargetUrlMapping_Modify(t *testing.T, modify func(items []client.SpUrlMapping) []client.SpUrlMapping) {
+	items, err := getSpUrlMappings()
+	if err != nil {
+		t.Fatalf("Failed to get SP target URL mappings: %v", err)
+	}
+	if err = putSpUrlMappings(modify(items)); err != nil {
+		t.Fatalf("Failed to update SP target URL mappings: %v", err)
+	}
+}
+
+// Test that any mappings created by the test are destroyed, and that the unmanaged mapping was left in place
+func spTargetUrlMapping_CheckDestroy(s *terraform.State) error {
+	err := resource.ComposeTestCheckFunc(
+		spTargetUrlMapping_CheckAbsent(mappingUrl),
+		spTargetUrlMapping_CheckAbsent(updatedMappingUrl),
+		spTargetUrlMapping_CheckPresent(unmanagedMappingUrl),
+	)(s)
+	if err != nil {
+		return err
+	}
+
+	// Clean up the unmanaged mapping
+	items, err := getSpUrlMappings()
+	if err != nil {
+		return err
+	}
+	return putSpUrlMappings(slices.DeleteFunc(items, func(item client.SpUrlMapping) bool {
+		return item.GetUrl() == unmanagedMappingUrl
+	}))
+}
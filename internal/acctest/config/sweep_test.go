@@ -0,0 +1,610 @@
+package config_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+)
+
+// Run the sweepers with "go test ./internal/acctest/config -v -sweep=local". PingFederate has no regions, so the
+// value of the sweep flag is ignored. Sweepers delete objects whose ID or name starts with acctest.ResourceIdPrefix.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	// Mappings are removed first, since they reference adapters, access token managers, and connections
+	resource.AddTestSweepers("pingfederate_idp_to_sp_adapter_mapping", &resource.Sweeper{
+		Name: "pingfederate_idp_to_sp_adapter_mapping",
+		F:    sweepIdpToSpAdapterMappings,
+	})
+	resource.AddTestSweepers("pingfederate_oauth_access_token_mapping", &resource.Sweeper{
+		Name: "pingfederate_oauth_access_token_mapping",
+		F:    sweepOauthAccessTokenMappings,
+	})
+	resource.AddTestSweepers("pingfederate_oauth_idp_adapter_mapping", &resource.Sweeper{
+		Name: "pingfederate_oauth_idp_adapter_mapping",
+		F:    sweepOauthIdpAdapterMappings,
+	})
+	resource.AddTestSweepers("pingfederate_sp_authentication_policy_contract_mapping", &resource.Sweeper{
+		Name: "pingfederate_sp_authentication_policy_contract_mapping",
+		F:    sweepSpAuthenticationPolicyContractMappings,
+	})
+	resource.AddTestSweepers("pingfederate_oauth_authentication_policy_contract_mapping", &resource.Sweeper{
+		Name: "pingfederate_oauth_authentication_policy_contract_mapping",
+		F:    sweepOauthAuthenticationPolicyContractMappings,
+	})
+
+	resource.AddTestSweepers("pingfederate_oauth_client", &resource.Sweeper{
+		Name: "pingfederate_oauth_client",
+		F:    sweepOauthClients,
+	})
+	resource.AddTestSweepers("pingfederate_openid_connect_policy", &resource.Sweeper{
+		Name:         "pingfederate_openid_connect_policy",
+		Dependencies: []string{"pingfederate_oauth_client"},
+		F:            sweepOpenIdConnectPolicies,
+	})
+
+	// Fragments reference adapters, selectors, and contracts from their policy trees
+	resource.AddTestSweepers("pingfederate_authentication_policies_fragment", &resource.Sweeper{
+		Name: "pingfederate_authentication_policies_fragment",
+		F:    sweepAuthenticationPoliciesFragments,
+	})
+	resource.AddTestSweepers("pingfederate_authentication_selector", &resource.Sweeper{
+		Name:         "pingfederate_authentication_selector",
+		Dependencies: []string{"pingfederate_authentication_policies_fragment"},
+		F:            sweepAuthenticationSelectors,
+	})
+
+	resource.AddTestSweepers("pingfederate_idp_sp_connection", &resource.Sweeper{
+		Name: "pingfederate_idp_sp_connection",
+		F:    sweepIdpSpConnections,
+	})
+	resource.AddTestSweepers("pingfederate_sp_idp_connection", &resource.Sweeper{
+		Name: "pingfederate_sp_idp_connection",
+		F:    sweepSpIdpConnections,
+	})
+
+	resource.AddTestSweepers("pingfederate_idp_adapter", &resource.Sweeper{
+		Name: "pingfederate_idp_adapter",
+		Dependencies: []string{
+			"pingfederate_idp_to_sp_adapter_mapping",
+			"pingfederate_oauth_access_token_mapping",
+			"pingfederate_oauth_idp_adapter_mapping",
+			"pingfederate_idp_sp_connection",
+			"pingfederate_authentication_policies_fragment",
+		},
+		F: sweepIdpAdapters,
+	})
+	resource.AddTestSweepers("pingfederate_sp_adapter", &resource.Sweeper{
+		Name: "pingfederate_sp_adapter",
+		Dependencies: []string{
+			"pingfederate_idp_to_sp_adapter_mapping",
+			"pingfederate_sp_idp_connection",
+		},
+		F: sweepSpAdapters,
+	})
+
+	// Key pairs and certificates are referenced by connections
+	resource.AddTestSweepers("pingfederate_keypairs_signing_key", &resource.Sweeper{
+		Name:         "pingfederate_keypairs_signing_key",
+		Dependencies: []string{"pingfederate_idp_sp_connection", "pingfederate_sp_idp_connection"},
+		F:            sweepKeypairsSigningKeys,
+	})
+	resource.AddTestSweepers("pingfederate_keypairs_ssl_client_key", &resource.Sweeper{
+		Name:         "pingfederate_keypairs_ssl_client_key",
+		Dependencies: []string{"pingfederate_idp_sp_connection", "pingfederate_sp_idp_connection"},
+		F:            sweepKeypairsSslClientKeys,
+	})
+	resource.AddTestSweepers("pingfederate_keypairs_ssl_server_key", &resource.Sweeper{
+		Name:         "pingfederate_keypairs_ssl_server_key",
+		Dependencies: []string{"pingfederate_idp_sp_connection", "pingfederate_sp_idp_connection"},
+		F:            sweepKeypairsSslServerKeys,
+	})
+	resource.AddTestSweepers("pingfederate_certificate_ca", &resource.Sweeper{
+		Name:         "pingfederate_certificate_ca",
+		Dependencies: []string{"pingfederate_idp_sp_connection", "pingfederate_sp_idp_connection"},
+		F:            sweepCertificateCas,
+	})
+
+	resource.AddTestSweepers("pingfederate_authentication_policy_contract", &resource.Sweeper{
+		Name: "pingfederate_authentication_policy_contract",
+		Dependencies: []string{
+			"pingfederate_sp_authentication_policy_contract_mapping",
+			"pingfederate_oauth_authentication_policy_contract_mapping",
+			"pingfederate_authentication_policies_fragment",
+			"pingfederate_idp_sp_connection",
+			"pingfederate_sp_idp_connection",
+		},
+		F: sweepAuthenticationPolicyContracts,
+	})
+	resource.AddTestSweepers("pingfederate_oauth_access_token_manager", &resource.Sweeper{
+		Name: "pingfederate_oauth_access_token_manager",
+		Dependencies: []string{
+			"pingfederate_oauth_access_token_mapping",
+			"pingfederate_oauth_client",
+			"pingfederate_openid_connect_policy",
+			"pingfederate_sp_idp_connection",
+		},
+		F: sweepOauthAccessTokenManagers,
+	})
+	resource.AddTestSweepers("pingfederate_notification_publisher", &resource.Sweeper{
+		Name: "pingfederate_notification_publisher",
+		F:    sweepNotificationPublishers,
+	})
+	resource.AddTestSweepers("pingfederate_metadata_url", &resource.Sweeper{
+		Name:         "pingfederate_metadata_url",
+		Dependencies: []string{"pingfederate_idp_sp_connection", "pingfederate_sp_idp_connection"},
+		F:            sweepMetadataUrls,
+	})
+
+	// Password credential validators use data stores, and are referenced by adapters and connections
+	resource.AddTestSweepers("pingfederate_password_credential_validator", &resource.Sweeper{
+		Name: "pingfederate_password_credential_validator",
+		Dependencies: []string{
+			"pingfederate_idp_adapter",
+			"pingfederate_idp_sp_connection",
+			"pingfederate_sp_idp_connection",
+		},
+		F: sweepPasswordCredentialValidators,
+	})
+
+	// Data stores are removed after everything that uses them as attribute sources or directories
+	resource.AddTestSweepers("pingfederate_data_store", &resource.Sweeper{
+		Name: "pingfederate_data_store",
+		Dependencies: []string{
+			"pingfederate_idp_adapter",
+			"pingfederate_sp_adapter",
+			"pingfederate_idp_sp_connection",
+			"pingfederate_sp_idp_connection",
+			"pingfederate_password_credential_validator",
+		},
+		F: sweepDataStores,
+	})
+
+	// Secret managers and PingOne connections are referenced by data stores, adapters, and validators
+	resource.AddTestSweepers("pingfederate_secret_manager", &resource.Sweeper{
+		Name: "pingfederate_secret_manager",
+		Dependencies: []string{
+			"pingfederate_data_store",
+			"pingfederate_idp_adapter",
+			"pingfederate_sp_adapter",
+		},
+		F: sweepSecretManagers,
+	})
+	resource.AddTestSweepers("pingfederate_pingone_connection", &resource.Sweeper{
+		Name: "pingfederate_pingone_connection",
+		Dependencies: []string{
+			"pingfederate_data_store",
+			"pingfederate_password_credential_validator",
+			"pingfederate_idp_adapter",
+			"pingfederate_idp_sp_connection",
+		},
+		F: sweepPingOneConnections,
+	})
+}
+
+// Check if any of the given IDs or names belong to an object created by the acceptance tests
+func isTestObject(idsOrNames ...string) bool {
+	for _, idOrName := range idsOrNames {
+		if strings.HasPrefix(strings.ToLower(idOrName), acctest.ResourceIdPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delete the given objects, continuing past any failures so that one bad object doesn't block the rest
+func sweepObjects(resourceType string, ids []string, deleteFunc func(ctx context.Context, id string) (*http.Response, error)) error {
+	ctx := acctest.TestBasicAuthContext()
+	var errs []error
+	for _, id := range ids {
+		log.Printf("[INFO] Deleting %s %s", resourceType, id)
+		httpResp, err := deleteFunc(ctx, id)
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			errs = append(errs, fmt.Errorf("failed to delete %s %s: %w", resourceType, id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepIdpToSpAdapterMappings(_ string) error {
+	response, _, err := acctest.TestClient().IdpToSpAdapterMappingAPI.GetIdpToSpAdapterMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list IdP to SP adapter mappings: %w", err)
+	}
+	var ids []string
+	for _, mapping := range response.Items {
+		if isTestObject(mapping.SourceId, mapping.TargetId) {
+			ids = append(ids, mapping.GetId())
+		}
+	}
+	return sweepObjects("IdP to SP adapter mapping", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().IdpToSpAdapterMappingAPI.DeleteIdpToSpAdapterMappingsById(ctx, id).Execute()
+	})
+}
+
+func sweepOauthAccessTokenMappings(_ string) error {
+	response, _, err := acctest.TestClient().OauthAccessTokenMappingsAPI.GetMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OAuth access token mappings: %w", err)
+	}
+	var ids []string
+	for _, mapping := range response {
+		if isTestObject(mapping.Context.ContextRef.Id, mapping.AccessTokenManagerRef.Id) {
+			ids = append(ids, mapping.GetId())
+		}
+	}
+	return sweepObjects("OAuth access token mapping", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthAccessTokenMappingsAPI.DeleteMapping(ctx, id).Execute()
+	})
+}
+
+func sweepOauthIdpAdapterMappings(_ string) error {
+	response, _, err := acctest.TestClient().OauthIdpAdapterMappingsAPI.GetIdpAdapterMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OAuth IdP adapter mappings: %w", err)
+	}
+	var ids []string
+	for _, mapping := range response.Items {
+		if isTestObject(mapping.Id) {
+			ids = append(ids, mapping.Id)
+		}
+	}
+	return sweepObjects("OAuth IdP adapter mapping", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthIdpAdapterMappingsAPI.DeleteIdpAdapterMapping(ctx, id).Execute()
+	})
+}
+
+func sweepOauthClients(_ string) error {
+	response, _, err := acctest.TestClient().OauthClientsAPI.GetOauthClients(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OAuth clients: %w", err)
+	}
+	var ids []string
+	for _, oauthClient := range response.Items {
+		if isTestObject(oauthClient.ClientId, oauthClient.Name) {
+			ids = append(ids, oauthClient.ClientId)
+		}
+	}
+	return sweepObjects("OAuth client", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthClientsAPI.DeleteOauthClient(ctx, id).Execute()
+	})
+}
+
+func sweepIdpSpConnections(_ string) error {
+	response, _, err := acctest.TestClient().IdpSpConnectionsAPI.GetSpConnections(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list IdP SP connections: %w", err)
+	}
+	var ids []string
+	for _, connection := range response.Items {
+		if isTestObject(connection.GetId(), connection.Name) {
+			ids = append(ids, connection.GetId())
+		}
+	}
+	return sweepObjects("IdP SP connection", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().IdpSpConnectionsAPI.DeleteSpConnection(ctx, id).Execute()
+	})
+}
+
+func sweepSpIdpConnections(_ string) error {
+	response, _, err := acctest.TestClient().SpIdpConnectionsAPI.GetConnections(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list SP IdP connections: %w", err)
+	}
+	var ids []string
+	for _, connection := range response.Items {
+		if isTestObject(connection.GetId(), connection.Name) {
+			ids = append(ids, connection.GetId())
+		}
+	}
+	return sweepObjects("SP IdP connection", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().SpIdpConnectionsAPI.DeleteConnection(ctx, id).Execute()
+	})
+}
+
+func sweepIdpAdapters(_ string) error {
+	response, _, err := acctest.TestClient().IdpAdaptersAPI.GetIdpAdapters(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list IdP adapters: %w", err)
+	}
+	var ids []string
+	for _, adapter := range response.Items {
+		if isTestObject(adapter.Id, adapter.Name) {
+			ids = append(ids, adapter.Id)
+		}
+	}
+	return sweepObjects("IdP adapter", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().IdpAdaptersAPI.DeleteIdpAdapter(ctx, id).Execute()
+	})
+}
+
+func sweepSpAdapters(_ string) error {
+	response, _, err := acctest.TestClient().SpAdaptersAPI.GetSpAdapters(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list SP adapters: %w", err)
+	}
+	var ids []string
+	for _, adapter := range response.Items {
+		if isTestObject(adapter.Id, adapter.Name) {
+			ids = append(ids, adapter.Id)
+		}
+	}
+	return sweepObjects("SP adapter", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().SpAdaptersAPI.DeleteSpAdapter(ctx, id).Execute()
+	})
+}
+
+// Get the IDs of the test key pairs in a list of key pairs
+func testKeyPairIds(keyPairs *client.KeyPairViews) []string {
+	var ids []string
+	for _, keyPair := range keyPairs.Items {
+		if isTestObject(keyPair.GetId()) {
+			ids = append(ids, keyPair.GetId())
+		}
+	}
+	return ids
+}
+
+func sweepKeypairsSigningKeys(_ string) error {
+	response, _, err := acctest.TestClient().KeyPairsSigningAPI.GetSigningKeyPairs(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list signing key pairs: %w", err)
+	}
+	return sweepObjects("signing key pair", testKeyPairIds(response), func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().KeyPairsSigningAPI.DeleteSigningKeyPair(ctx, id).Execute()
+	})
+}
+
+func sweepKeypairsSslClientKeys(_ string) error {
+	response, _, err := acctest.TestClient().KeyPairsSslClientAPI.GetSslClientKeyPairs(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list SSL client key pairs: %w", err)
+	}
+	return sweepObjects("SSL client key pair", testKeyPairIds(response), func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().KeyPairsSslClientAPI.DeleteSslClientKeyPair(ctx, id).Execute()
+	})
+}
+
+func sweepKeypairsSslServerKeys(_ string) error {
+	response, _, err := acctest.TestClient().KeyPairsSslServerAPI.GetSslServerKeyPairs(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list SSL server key pairs: %w", err)
+	}
+	return sweepObjects("SSL server key pair", testKeyPairIds(response), func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().KeyPairsSslServerAPI.DeleteSslServerKeyPair(ctx, id).Execute()
+	})
+}
+
+func sweepCertificateCas(_ string) error {
+	response, _, err := acctest.TestClient().CertificatesCaAPI.GetTrustedCAs(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list trusted CA certificates: %w", err)
+	}
+	var ids []string
+	for _, cert := range response.Items {
+		if isTestObject(cert.GetId()) {
+			ids = append(ids, cert.GetId())
+		}
+	}
+	return sweepObjects("trusted CA certificate", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().CertificatesCaAPI.DeleteTrustedCA(ctx, id).Execute()
+	})
+}
+
+func sweepDataStores(_ string) error {
+	response, _, err := acctest.TestClient().DataStoresAPI.GetDataStores(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list data stores: %w", err)
+	}
+	var ids []string
+	for _, dataStore := range response.Items {
+		if isTestObject(dataStore.GetId()) {
+			ids = append(ids, dataStore.GetId())
+		}
+	}
+	return sweepObjects("data store", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().DataStoresAPI.DeleteDataStore(ctx, id).Execute()
+	})
+}
+
+func sweepSpAuthenticationPolicyContractMappings(_ string) error {
+	response, _, err := acctest.TestClient().SpAuthenticationPolicyContractMappingsAPI.GetApcToSpAdapterMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list SP authentication policy contract mappings: %w", err)
+	}
+	var ids []string
+	for _, mapping := range response.Items {
+		if isTestObject(mapping.SourceId, mapping.TargetId) {
+			ids = append(ids, mapping.GetId())
+		}
+	}
+	return sweepObjects("SP authentication policy contract mapping", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().SpAuthenticationPolicyContractMappingsAPI.DeleteApcToSpAdapterMappingById(ctx, id).Execute()
+	})
+}
+
+func sweepOauthAuthenticationPolicyContractMappings(_ string) error {
+	response, _, err := acctest.TestClient().OauthAuthenticationPolicyContractMappingsAPI.GetApcMappings(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OAuth authentication policy contract mappings: %w", err)
+	}
+	var ids []string
+	for _, mapping := range response.Items {
+		if isTestObject(mapping.GetId(), mapping.AuthenticationPolicyContractRef.Id) {
+			ids = append(ids, mapping.GetId())
+		}
+	}
+	return sweepObjects("OAuth authentication policy contract mapping", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthAuthenticationPolicyContractMappingsAPI.DeleteApcMapping(ctx, id).Execute()
+	})
+}
+
+func sweepOpenIdConnectPolicies(_ string) error {
+	response, _, err := acctest.TestClient().OauthOpenIdConnectAPI.GetOIDCPolicies(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OpenID Connect policies: %w", err)
+	}
+	var ids []string
+	for _, policy := range response.Items {
+		if isTestObject(policy.Id, policy.Name) {
+			ids = append(ids, policy.Id)
+		}
+	}
+	return sweepObjects("OpenID Connect policy", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthOpenIdConnectAPI.DeleteOIDCPolicy(ctx, id).Execute()
+	})
+}
+
+func sweepAuthenticationPoliciesFragments(_ string) error {
+	response, _, err := acctest.TestClient().AuthenticationPoliciesAPI.GetFragments(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list authentication policies fragments: %w", err)
+	}
+	var ids []string
+	for _, fragment := range response.Items {
+		if isTestObject(fragment.GetId(), fragment.GetName()) {
+			ids = append(ids, fragment.GetId())
+		}
+	}
+	return sweepObjects("authentication policies fragment", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().AuthenticationPoliciesAPI.DeleteFragment(ctx, id).Execute()
+	})
+}
+
+func sweepAuthenticationSelectors(_ string) error {
+	response, _, err := acctest.TestClient().AuthenticationSelectorsAPI.GetAuthenticationSelectors(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list authentication selectors: %w", err)
+	}
+	var ids []string
+	for _, selector := range response.Items {
+		if isTestObject(selector.Id, selector.Name) {
+			ids = append(ids, selector.Id)
+		}
+	}
+	return sweepObjects("authentication selector", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().AuthenticationSelectorsAPI.DeleteAuthenticationSelector(ctx, id).Execute()
+	})
+}
+
+func sweepAuthenticationPolicyContracts(_ string) error {
+	response, _, err := acctest.TestClient().AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContracts(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list authentication policy contracts: %w", err)
+	}
+	var ids []string
+	for _, contract := range response.Items {
+		if isTestObject(contract.GetId(), contract.GetName()) {
+			ids = append(ids, contract.GetId())
+		}
+	}
+	return sweepObjects("authentication policy contract", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().AuthenticationPolicyContractsAPI.DeleteAuthenticationPolicyContract(ctx, id).Execute()
+	})
+}
+
+func sweepOauthAccessTokenManagers(_ string) error {
+	response, _, err := acctest.TestClient().OauthAccessTokenManagersAPI.GetTokenManagers(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list OAuth access token managers: %w", err)
+	}
+	var ids []string
+	for _, manager := range response.Items {
+		if isTestObject(manager.Id, manager.Name) {
+			ids = append(ids, manager.Id)
+		}
+	}
+	return sweepObjects("OAuth access token manager", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().OauthAccessTokenManagersAPI.DeleteTokenManager(ctx, id).Execute()
+	})
+}
+
+func sweepNotificationPublishers(_ string) error {
+	response, _, err := acctest.TestClient().NotificationPublishersAPI.GetNotificationPublishers(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list notification publishers: %w", err)
+	}
+	var ids []string
+	for _, publisher := range response.Items {
+		if isTestObject(publisher.Id, publisher.Name) {
+			ids = append(ids, publisher.Id)
+		}
+	}
+	return sweepObjects("notification publisher", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().NotificationPublishersAPI.DeleteNotificationPublisher(ctx, id).Execute()
+	})
+}
+
+func sweepMetadataUrls(_ string) error {
+	response, _, err := acctest.TestClient().MetadataUrlsAPI.GetMetadataUrls(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list metadata URLs: %w", err)
+	}
+	var ids []string
+	for _, metadataUrl := range response.Items {
+		if isTestObject(metadataUrl.GetId(), metadataUrl.Name) {
+			ids = append(ids, metadataUrl.GetId())
+		}
+	}
+	return sweepObjects("metadata URL", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().MetadataUrlsAPI.DeleteMetadataUrl(ctx, id).Execute()
+	})
+}
+
+func sweepPasswordCredentialValidators(_ string) error {
+	response, _, err := acctest.TestClient().PasswordCredentialValidatorsAPI.GetPasswordCredentialValidators(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list password credential validators: %w", err)
+	}
+	var ids []string
+	for _, validator := range response.Items {
+		if isTestObject(validator.Id, validator.Name) {
+			ids = append(ids, validator.Id)
+		}
+	}
+	return sweepObjects("password credential validator", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().PasswordCredentialValidatorsAPI.DeletePasswordCredentialValidator(ctx, id).Execute()
+	})
+}
+
+func sweepSecretManagers(_ string) error {
+	response, _, err := acctest.TestClient().SecretManagersAPI.GetSecretManagers(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list secret managers: %w", err)
+	}
+	var ids []string
+	for _, secretManager := range response.Items {
+		if isTestObject(secretManager.Id, secretManager.Name) {
+			ids = append(ids, secretManager.Id)
+		}
+	}
+	return sweepObjects("secret manager", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().SecretManagersAPI.DeleteSecretManager(ctx, id).Execute()
+	})
+}
+
+func sweepPingOneConnections(_ string) error {
+	response, _, err := acctest.TestClient().PingOneConnectionsAPI.GetPingOneConnections(acctest.TestBasicAuthContext()).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to list PingOne connections: %w", err)
+	}
+	var ids []string
+	for _, connection := range response.Items {
+		if isTestObject(connection.GetId(), connection.Name) {
+			ids = append(ids, connection.GetId())
+		}
+	}
+	return sweepObjects("PingOne connection", ids, func(ctx context.Context, id string) (*http.Response, error) {
+		return acctest.TestClient().PingOneConnectionsAPI.DeletePingOneConnection(ctx, id).Execute()
+	})
+}
@@ -0,0 +1,149 @@
+package virtualhostname_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+const (
+	virtualHostName        = "virtualhostname.example.com"
+	updatedVirtualHostName = "virtualhostname2.example.com"
+	// Entry that is not managed by terraform, which should be left in place
+	unmanagedVirtualHostName = "unmanaged.example.com"
+)
+
+func TestAccVirtualHostName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: virtualHostName_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					virtualHostName_Modify(t, func(items []string) []string {
+						return append(items, unmanagedVirtualHostName)
+					})
+				},
+				Config: virtualHostName_HCL(virtualHostName),
+				Check: resource.ComposeTestCheckFunc(
+					virtualHostName_CheckPresent(virtualHostName),
+					resource.TestCheckResourceAttr("pingfederate_virtual_host_name.example", "id", virtualHostName),
+				),
+			},
+			{
+				// Changing the name replaces the entry
+				Config: virtualHostName_HCL(updatedVirtualHostName),
+				Check: resource.ComposeTestCheckFunc(
+					virtualHostName_CheckPresent(updatedVirtualHostName),
+					virtualHostName_CheckAbsent(virtualHostName),
+				),
+			},
+			{
+				// Test importing the resource
+				Config:                               virtualHostName_HCL(updatedVirtualHostName),
+				ResourceName:                         "pingfederate_virtual_host_name.example",
+				ImportStateId:                        updatedVirtualHostName,
+				ImportStateVerifyIdentifierAttribute: "virtual_host_name",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			{
+				// Test that removing the entry outside of terraform is detected
+				PreConfig: func() {
+					virtualHostName_Modify(t, func(items []string) []string {
+						return slices.DeleteFunc(items, func(item string) bool {
+							return item == updatedVirtualHostName
+						})
+					})
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func virtualHostName_HCL(name string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_virtual_host_name" "example" {
+  virtual_host_name = "%s"
+}
+`, name)
+}
+
+func virtualHostName_CheckPresent(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		response, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(acctest.TestBasicAuthContext()).Execute()
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(response.VirtualHostNames, name) {
+			return fmt.Errorf("virtual host name '%s' not found in PingFederate", name)
+		}
+		return nil
+	}
+}
+
+func virtualHostName_CheckAbsent(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		testClient := acctest.TestClient()
+		response, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(acctest.TestBasicAuthContext()).Execute()
+		if err != nil {
+			return err
+		}
+		if slices.Contains(response.VirtualHostNames, name) {
+			return acctest.ExpectedDestroyError("VirtualHostName", name)
+		}
+		return nil
+	}
+}
+
+func virtualHostName_Modify(t *testing.T, modify func(items []string) []string) {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	response, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(ctx).Execute()
+	if err != nil {
+		t.Fatalf("Failed to get virtual host names: %v", err)
+	}
+	response.VirtualHostNames = modify(response.VirtualHostNames)
+	_, _, err = testClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(ctx).Body(*response).Execute()
+	if err != nil {
+		t.Fatalf("Failed to update virtual host names: %v", err)
+	}
+}
+
+// Test that any objects created by the test are destroyed, and that the unmanaged entry was left in place
+func virtualHostName_CheckDestroy(s *terraform.State) error {
+	err := resource.ComposeTestCheckFunc(
+		virtualHostName_CheckAbsent(virtualHostName),
+		virtualHostName_CheckAbsent(updatedVirtualHostName),
+		virtualHostName_CheckPresent(unmanagedVirtualHostName),
+	)(s)
+	if err != nil {
+		return err
+	}
+
+	// Clean up the unmanaged entry
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	response, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	response.VirtualHostNames = slices.DeleteFunc(response.VirtualHostNames, func(item string) bool {
+		return item == unmanagedVirtualHostName
+	})
+	_, _, err = testClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(ctx).Body(*response).Execute()
+	return err
+}
@@ -0,0 +1,89 @@
+package virtualhostnames_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+)
+
+// Virtual host names configured before the resource is created, which should be restored on destroy
+var originalVirtualHostNames = []string{"original.example.com"}
+
+func TestAccVirtualHostNamesOnDestroyRestoreOriginal(t *testing.T) {
+	resourceName := "myVirtualHostNames"
+	updatedResourceModel := virtualHostNamesResourceModel{
+		virtualHostNames: []string{"example1", "example2"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.ConfigurationPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pingfederate": providerserver.NewProtocol6WithError(provider.NewTestProvider()),
+		},
+		CheckDestroy: virtualHostNamesOnDestroy_CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testClient := acctest.TestClient()
+					ctx := acctest.TestBasicAuthContext()
+					original := client.NewVirtualHostNameSettings()
+					original.VirtualHostNames = originalVirtualHostNames
+					_, _, err := testClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(ctx).Body(*original).Execute()
+					if err != nil {
+						t.Fatalf("Failed to set the original virtual host names: %v", err)
+					}
+				},
+				Config: testAccVirtualHostNamesOnDestroy(resourceName, updatedResourceModel, "restore_original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExpectedVirtualHostNamesAttributes(updatedResourceModel),
+					resource.TestCheckResourceAttr("pingfederate_virtual_host_names."+resourceName, "on_destroy", "restore_original"),
+				),
+			},
+			{
+				// Test importing the resource. The on_destroy value isn't read from PingFederate, so it isn't verified
+				Config:                               testAccVirtualHostNamesOnDestroy(resourceName, updatedResourceModel, "restore_original"),
+				ResourceName:                         "pingfederate_virtual_host_names." + resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "virtual_host_names.#",
+				ImportStateVerifyIgnore:              []string{"on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccVirtualHostNamesOnDestroy(resourceName string, resourceModel virtualHostNamesResourceModel, onDestroy string) string {
+	return fmt.Sprintf(`
+resource "pingfederate_virtual_host_names" "%[1]s" {
+  virtual_host_names = %[2]s
+  on_destroy         = "%[3]s"
+}`, resourceName,
+		acctest.StringSliceToTerraformString(resourceModel.virtualHostNames),
+		onDestroy,
+	)
+}
+
+// Test that the original virtual host names were restored
+func virtualHostNamesOnDestroy_CheckDestroy(s *terraform.State) error {
+	testClient := acctest.TestClient()
+	ctx := acctest.TestBasicAuthContext()
+	response, _, err := testClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(ctx).Execute()
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(response.VirtualHostNames, originalVirtualHostNames) {
+		return fmt.Errorf("expected virtual host names %v to be restored, found %v", originalVirtualHostNames, response.VirtualHostNames)
+	}
+
+	// Clean up the original virtual host names
+	_, _, err = testClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(ctx).Body(*client.NewVirtualHostNameSettings()).Execute()
+	return err
+}
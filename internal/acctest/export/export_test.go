@@ -0,0 +1,98 @@
+package export_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/fakepf"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/export"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+func TestExport(t *testing.T) {
+	server := fakepf.NewServer()
+	defer server.Close()
+	t.Setenv("PINGFEDERATE_PROVIDER_HTTPS_HOST", server.URL)
+	t.Setenv("PINGFEDERATE_PROVIDER_ADMIN_API_PATH", fakepf.AdminApiPath)
+	t.Setenv("PINGFEDERATE_PROVIDER_USERNAME", "administrator")
+	t.Setenv("PINGFEDERATE_PROVIDER_PASSWORD", "2FederateM0re")
+	t.Setenv("PINGFEDERATE_PROVIDER_PRODUCT_VERSION", "12.2")
+	t.Setenv("PINGFEDERATE_PROVIDER_INSECURE_TRUST_ALL_TLS", "true")
+
+	clientConfig := client.NewConfiguration()
+	clientConfig.Servers = client.ServerConfigurations{
+		{
+			URL: server.URL + fakepf.AdminApiPath,
+		},
+	}
+	clientConfig.HTTPClient = server.Client()
+	testClient := client.NewAPIClient(clientConfig)
+	ctx := config.BasicAuthContext(context.Background(), "administrator", "2FederateM0re")
+
+	issuer := client.NewIssuer("Example Issuer", "example.com")
+	issuer.Id = client.PtrString("exampleissuer")
+	_, _, err := testClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*issuer).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+
+	// A validator with a sensitive field, and a second validator referencing it as its parent
+	descriptorRef := client.ResourceLink{Id: "org.sourceid.saml20.domain.SimpleUsernamePasswordCredentialValidator"}
+	parent := client.NewPasswordCredentialValidator("parentvalidator", "Parent Validator", descriptorRef, client.PluginConfiguration{
+		Fields: []client.ConfigField{
+			{
+				Name:           "Password",
+				EncryptedValue: client.PtrString("encryptedvalue"),
+			},
+		},
+	})
+	_, _, err = testClient.PasswordCredentialValidatorsAPI.CreatePasswordCredentialValidator(ctx).Body(*parent).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+	child := client.NewPasswordCredentialValidator("childvalidator", "Child Validator", descriptorRef, client.PluginConfiguration{})
+	child.ParentRef = &client.ResourceLink{Id: "parentvalidator"}
+	_, _, err = testClient.PasswordCredentialValidatorsAPI.CreatePasswordCredentialValidator(ctx).Body(*child).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create validator: %v", err)
+	}
+
+	exporter, err := export.New(context.Background(), "test")
+	if err != nil {
+		t.Fatalf("Failed to configure exporter: %v", err)
+	}
+	content, warnings, err := exporter.Export(context.Background(), []string{"pingfederate_oauth_issuer", "pingfederate_password_credential_validator"})
+	if err != nil {
+		t.Fatalf("Failed to export: %v", err)
+	}
+	if len(warnings) > 0 {
+		t.Errorf("Unexpected warnings: %v", warnings)
+	}
+
+	generated := string(content)
+	expectedContent := []string{
+		"to = pingfederate_oauth_issuer.example_issuer",
+		`id = "exampleissuer"`,
+		`resource "pingfederate_oauth_issuer" "example_issuer"`,
+		`= "example.com"`,
+		`resource "pingfederate_password_credential_validator" "child_validator"`,
+		"id = pingfederate_password_credential_validator.parent_validator.validator_id",
+		"value = var.password_credential_validator_parent_validator_configuration_sensitive_fields_password_value",
+		`variable "password_credential_validator_parent_validator_configuration_sensitive_fields_password_value"`,
+	}
+	for _, expected := range expectedContent {
+		if !strings.Contains(generated, expected) {
+			t.Errorf("Expected generated configuration to contain '%s'", expected)
+		}
+	}
+	if strings.Contains(generated, "encryptedvalue") {
+		t.Error("Expected the encrypted value to be replaced with a variable")
+	}
+
+	_, _, err = exporter.Export(context.Background(), []string{"pingfederate_server_settings"})
+	if err == nil {
+		t.Error("Expected an error exporting an unsupported resource type")
+	}
+}
@@ -0,0 +1,204 @@
+package export_test
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/export"
+)
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+func refAttribute(name string) *tfprotov6.SchemaAttribute {
+	return &tfprotov6.SchemaAttribute{
+		Name:     name,
+		Optional: true,
+		NestedType: &tfprotov6.SchemaObject{
+			Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+			Attributes: []*tfprotov6.SchemaAttribute{
+				{Name: "id", Type: tftypes.String, Required: true},
+			},
+		},
+	}
+}
+
+var contractSchema = &tfprotov6.Schema{
+	Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "contract_id", Type: tftypes.String, Required: true},
+			{Name: "name", Type: tftypes.String, Required: true},
+		},
+	},
+}
+
+var adapterSchema = &tfprotov6.Schema{
+	Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "adapter_id", Type: tftypes.String, Required: true},
+			{Name: "id", Type: tftypes.String, Computed: true},
+			{Name: "password", Type: tftypes.String, Optional: true, Sensitive: true},
+			refAttribute("authentication_policy_contract_ref"),
+			refAttribute("parent_ref"),
+			refAttribute("plugin_descriptor_ref"),
+			refAttribute("target_ref"),
+			{
+				Name:     "configuration",
+				Required: true,
+				NestedType: &tfprotov6.SchemaObject{
+					Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "fields",
+							Optional: true,
+							NestedType: &tfprotov6.SchemaObject{
+								Nesting: tfprotov6.SchemaObjectNestingModeList,
+								Attributes: []*tfprotov6.SchemaAttribute{
+									{Name: "name", Type: tftypes.String, Required: true},
+									{Name: "value", Type: tftypes.String, Optional: true, Sensitive: true},
+									{Name: "encrypted_value", Type: tftypes.String, Optional: true, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func stringValue(value string) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, value)
+}
+
+func refValue(id string) tftypes.Value {
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+		"id": stringValue(id),
+	})
+}
+
+func contractObject(id, label string) export.Object {
+	return export.Object{
+		ResourceType: "pingfederate_authentication_policy_contract",
+		Label:        label,
+		Id:           id,
+		Schema:       contractSchema,
+		State: tftypes.NewValue(contractSchema.ValueType(), map[string]tftypes.Value{
+			"contract_id": stringValue(id),
+			"name":        stringValue(label),
+		}),
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	adapterType := adapterSchema.ValueType().(tftypes.Object)
+	configurationType := adapterType.AttributeTypes["configuration"].(tftypes.Object)
+	fieldsType := configurationType.AttributeTypes["fields"].(tftypes.List)
+	fieldType := fieldsType.ElementType.(tftypes.Object)
+	adapterState := tftypes.NewValue(adapterType, map[string]tftypes.Value{
+		"adapter_id": stringValue("mainadapter"),
+		"id":         stringValue("mainadapter"),
+		"password":   stringValue("secret"),
+		// Resolved by the resource type in the attribute name, although an adapter has the same ID
+		"authentication_policy_contract_ref": refValue("shared"),
+		// Not resolved, since more than one exported object has the ID
+		"parent_ref": refValue("shared"),
+		// Not resolved, since no exported object has the ID
+		"plugin_descriptor_ref": refValue("com.example.Adapter"),
+		// Resolved, since a single exported object has the ID
+		"target_ref": refValue("othercontract"),
+		"configuration": tftypes.NewValue(configurationType, map[string]tftypes.Value{
+			"fields": tftypes.NewValue(fieldsType, []tftypes.Value{
+				tftypes.NewValue(fieldType, map[string]tftypes.Value{
+					"name":            stringValue("Client Secret"),
+					"value":           tftypes.NewValue(tftypes.String, nil),
+					"encrypted_value": stringValue("encryptedsecret"),
+				}),
+				tftypes.NewValue(fieldType, map[string]tftypes.Value{
+					"name":            stringValue("Host"),
+					"value":           stringValue("example.com"),
+					"encrypted_value": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		}),
+	})
+	sharedAdapterState := tftypes.NewValue(adapterType, map[string]tftypes.Value{
+		"adapter_id":                         stringValue("shared"),
+		"id":                                 stringValue("shared"),
+		"password":                           tftypes.NewValue(tftypes.String, nil),
+		"authentication_policy_contract_ref": tftypes.NewValue(adapterType.AttributeTypes["authentication_policy_contract_ref"], nil),
+		"parent_ref":                         tftypes.NewValue(adapterType.AttributeTypes["parent_ref"], nil),
+		"plugin_descriptor_ref":              refValue("com.example.Adapter"),
+		"target_ref":                         tftypes.NewValue(adapterType.AttributeTypes["target_ref"], nil),
+		"configuration": tftypes.NewValue(configurationType, map[string]tftypes.Value{
+			"fields": tftypes.NewValue(fieldsType, nil),
+		}),
+	})
+
+	content, err := export.Generate([]export.Object{
+		contractObject("shared", "shared_contract"),
+		contractObject("othercontract", "other_contract"),
+		{
+			ResourceType: "pingfederate_idp_adapter",
+			Label:        "shared_adapter",
+			Id:           "shared",
+			Schema:       adapterSchema,
+			State:        sharedAdapterState,
+		},
+		{
+			ResourceType: "pingfederate_idp_adapter",
+			Label:        "main_adapter",
+			Id:           "mainadapter",
+			Schema:       adapterSchema,
+			State:        adapterState,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to generate configuration: %v", err)
+	}
+
+	// Compare without the alignment whitespace added by formatting
+	generated := whitespace.ReplaceAllString(string(content), " ")
+	expectedContent := []string{
+		`import { to = pingfederate_idp_adapter.main_adapter id = "mainadapter" }`,
+		`resource "pingfederate_idp_adapter" "main_adapter" { adapter_id = "mainadapter" authentication_policy_contract_ref = { id = pingfederate_authentication_policy_contract.shared_contract.contract_id }`,
+		`parent_ref = { id = "shared" }`,
+		`password = var.idp_adapter_main_adapter_password`,
+		`plugin_descriptor_ref = { id = "com.example.Adapter" }`,
+		`target_ref = { id = pingfederate_authentication_policy_contract.other_contract.contract_id }`,
+		`{ name = "Client Secret" value = var.idp_adapter_main_adapter_configuration_fields_client_secret_value }`,
+		`{ name = "Host" value = var.idp_adapter_main_adapter_configuration_fields_host_value }`,
+		`variable "idp_adapter_main_adapter_password" { description = "Sensitive value for pingfederate_idp_adapter.main_adapter" type = string sensitive = true }`,
+		`variable "idp_adapter_main_adapter_configuration_fields_client_secret_value" {`,
+	}
+	for _, expected := range expectedContent {
+		if !strings.Contains(generated, expected) {
+			t.Errorf("Expected generated configuration to contain '%s', found:\n%s", expected, string(content))
+		}
+	}
+	unexpectedContent := []string{
+		"encryptedsecret",
+		"encrypted_value",
+		`"secret"`,
+		`"example.com"`,
+	}
+	for _, unexpected := range unexpectedContent {
+		if strings.Contains(generated, unexpected) {
+			t.Errorf("Expected generated configuration not to contain '%s'", unexpected)
+		}
+	}
+
+	// The computed id attribute is only used in the import block
+	if strings.Count(generated, ` id = "mainadapter"`) != 1 {
+		t.Error("Expected the computed id attribute to be left out of the resource block")
+	}
+
+	_, err = export.Generate([]export.Object{
+		{ResourceType: "pingfederate_server_settings"},
+	})
+	if err == nil {
+		t.Error("Expected an error generating configuration for an unsupported resource type")
+	}
+}
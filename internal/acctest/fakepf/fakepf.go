@@ -0,0 +1,327 @@
+// Package fakepf provides an in-memory fake of the PingFederate admin API, for running tests without a live
+// PingFederate server.
+//
+// The fake stores the JSON objects sent to each endpoint and returns them unchanged. It does not apply
+// PingFederate defaults or validation beyond basic 404 and 422 responses, so it is only suitable for tests that
+// configure the values they check.
+package fakepf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The admin API path served by the fake
+const AdminApiPath = "/pf-admin-api/v1"
+
+// Endpoints whose objects are identified by a field other than "id"
+var idFields = map[string]string{
+	"/administrativeAccounts":                               "username",
+	"/oauth/clients":                                        "clientId",
+	"/oauth/authServerSettings/scopes/commonScopes":         "name",
+	"/oauth/authServerSettings/scopes/exclusiveScopes":      "name",
+	"/oauth/authServerSettings/scopes/commonScopeGroups":    "name",
+	"/oauth/authServerSettings/scopes/exclusiveScopeGroups": "name",
+}
+
+// Collections that exist on every server, so that reading a missing object in them returns a 404 even before any
+// object has been created
+var knownCollections = []string{
+	"/administrativeAccounts",
+	"/authenticationApi/applications",
+	"/authenticationPolicies/fragments",
+	"/authenticationPolicyContracts",
+	"/authenticationSelectors",
+	"/captchaProviders",
+	"/certificates/ca",
+	"/certificates/revocation/ocspCertificates",
+	"/dataStores",
+	"/identityStoreProvisioners",
+	"/idp/adapters",
+	"/idp/spConnections",
+	"/idp/stsRequestParametersContracts",
+	"/idp/tokenProcessors",
+	"/idpToSpAdapterMapping",
+	"/kerberos/realms",
+	"/keyPairs/oauthOpenIdConnect/additionalKeySets",
+	"/keyPairs/signing",
+	"/keyPairs/sslClient",
+	"/keyPairs/sslServer",
+	"/localIdentity/identityProfiles",
+	"/metadataUrls",
+	"/notificationPublishers",
+	"/oauth/accessTokenManagers",
+	"/oauth/accessTokenMappings",
+	"/oauth/authServerSettings/scopes/commonScopeGroups",
+	"/oauth/authServerSettings/scopes/commonScopes",
+	"/oauth/authServerSettings/scopes/exclusiveScopeGroups",
+	"/oauth/authServerSettings/scopes/exclusiveScopes",
+	"/oauth/authenticationPolicyContractMappings",
+	"/oauth/authorizationDetailProcessors",
+	"/oauth/authorizationDetailTypes",
+	"/oauth/cibaServerPolicy/requestPolicies",
+	"/oauth/clientRegistrationPolicies",
+	"/oauth/clients",
+	"/oauth/idpAdapterMappings",
+	"/oauth/issuers",
+	"/oauth/openIdConnect/policies",
+	"/oauth/outOfBandAuthPlugins",
+	"/oauth/resourceOwnerCredentialsMappings",
+	"/oauth/tokenExchange/generator/groups",
+	"/oauth/tokenExchange/processor/policies",
+	"/oauth/tokenExchange/tokenGeneratorMappings",
+	"/passwordCredentialValidators",
+	"/pingOneConnections",
+	"/secretManagers",
+	"/serverSettings/wsTrustStsSettings/issuerCertificates",
+	"/session/authenticationSessionPolicies",
+	"/sp/adapters",
+	"/sp/authenticationPolicyContractMappings",
+	"/sp/idpConnections",
+	"/sp/tokenGenerators",
+	"/tokenProcessorToTokenGeneratorMappings",
+}
+
+// Final path segments of endpoints within a collection that are not objects of the collection, such as
+// GET /keyPairs/sslServer/settings
+var collectionSettings = map[string]bool{
+	"changePassword": true,
+	"descriptors":    true,
+	"settings":       true,
+	"urlMappings":    true,
+}
+
+// Collections that PingFederate returns as a plain JSON array rather than an object with an items field
+var arrayCollections = map[string]bool{
+	"/oauth/accessTokenMappings": true,
+}
+
+// Final path segments of endpoints that create an object in the parent collection, such as
+// POST /keyPairs/signing/generate
+var createActions = map[string]bool{
+	"generate": true,
+	"import":   true,
+}
+
+type Server struct {
+	*httptest.Server
+
+	mutex sync.Mutex
+	// Stored objects, keyed by path relative to the admin API path
+	objects map[string]map[string]any
+	// Paths that hold a collection of objects, such as /oauth/clients
+	collections map[string]bool
+	nextId      int
+}
+
+type validationError struct {
+	Message   string `json:"message"`
+	FieldPath string `json:"fieldPath,omitempty"`
+	ErrorId   string `json:"errorId"`
+}
+
+type errorResponse struct {
+	ResultId         string            `json:"resultId"`
+	Message          string            `json:"message"`
+	ValidationErrors []validationError `json:"validationErrors,omitempty"`
+}
+
+// Start a new fake admin API server. The server uses TLS with a self-signed certificate, so clients
+// must skip certificate verification. Call Close when done with the server.
+func NewServer() *Server {
+	s := &Server{
+		objects:     map[string]map[string]any{},
+		collections: map[string]bool{},
+	}
+	for _, collection := range knownCollections {
+		s.collections[collection] = true
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, AdminApiPath+"/") {
+		writeNotFound(w)
+		return
+	}
+	objectPath := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, AdminApiPath), "/")
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, objectPath)
+	case http.MethodPost:
+		s.post(w, r, objectPath)
+	case http.MethodPut:
+		s.put(w, r, objectPath)
+	case http.MethodDelete:
+		s.delete(w, objectPath)
+	default:
+		writeJson(w, http.StatusMethodNotAllowed, errorResponse{
+			ResultId: "method_not_allowed",
+			Message:  fmt.Sprintf("Method %s is not supported.", r.Method),
+		})
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, objectPath string) {
+	if object, ok := s.objects[objectPath]; ok {
+		writeJson(w, http.StatusOK, object)
+		return
+	}
+	if s.collections[objectPath] {
+		if arrayCollections[objectPath] {
+			writeJson(w, http.StatusOK, s.items(objectPath))
+		} else {
+			writeJson(w, http.StatusOK, map[string]any{"items": s.items(objectPath)})
+		}
+		return
+	}
+	if s.isCollectionItem(objectPath) {
+		writeNotFound(w)
+		return
+	}
+	// Settings that have never been written are returned empty
+	writeJson(w, http.StatusOK, map[string]any{})
+}
+
+func (s *Server) post(w http.ResponseWriter, r *http.Request, objectPath string) {
+	object, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	collection := objectPath
+	if createActions[path.Base(objectPath)] {
+		collection = path.Dir(objectPath)
+	}
+	idField := idFieldFor(collection)
+	id, _ := object[idField].(string)
+	if id == "" {
+		s.nextId++
+		id = fmt.Sprintf("fakepf%d", s.nextId)
+		object[idField] = id
+	}
+	itemPath := collection + "/" + id
+	if _, exists := s.objects[itemPath]; exists {
+		writeValidationError(w, idField, fmt.Sprintf("An object with %s '%s' already exists.", idField, id))
+		return
+	}
+	s.objects[itemPath] = object
+	s.collections[collection] = true
+	writeJson(w, http.StatusCreated, object)
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, objectPath string) {
+	object, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	parent := path.Dir(objectPath)
+	if s.isCollectionItem(objectPath) {
+		if _, exists := s.objects[objectPath]; !exists {
+			writeNotFound(w)
+			return
+		}
+		idField := idFieldFor(parent)
+		if id, ok := object[idField].(string); ok && id != path.Base(objectPath) {
+			writeValidationError(w, idField, fmt.Sprintf("The %s '%s' does not match the %s in the request path.", idField, id, idField))
+			return
+		}
+	}
+	s.objects[objectPath] = object
+	writeJson(w, http.StatusOK, object)
+}
+
+func (s *Server) delete(w http.ResponseWriter, objectPath string) {
+	if _, exists := s.objects[objectPath]; exists {
+		delete(s.objects, objectPath)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if s.isCollectionItem(objectPath) {
+		writeNotFound(w)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Check if a path refers to an object in a collection, rather than to settings within the collection
+func (s *Server) isCollectionItem(objectPath string) bool {
+	return s.collections[path.Dir(objectPath)] && !collectionSettings[path.Base(objectPath)]
+}
+
+// Get the objects in a collection, ordered by path
+func (s *Server) items(collection string) []map[string]any {
+	var itemPaths []string
+	for objectPath := range s.objects {
+		if path.Dir(objectPath) == collection {
+			itemPaths = append(itemPaths, objectPath)
+		}
+	}
+	sort.Strings(itemPaths)
+	items := []map[string]any{}
+	for _, itemPath := range itemPaths {
+		items = append(items, s.objects[itemPath])
+	}
+	return items
+}
+
+func idFieldFor(collection string) string {
+	if idField, ok := idFields[collection]; ok {
+		return idField
+	}
+	return "id"
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeValidationError(w, "", "Unable to read the request body: "+err.Error())
+		return nil, false
+	}
+	var object map[string]any
+	if len(body) == 0 {
+		object = map[string]any{}
+	} else if err := json.Unmarshal(body, &object); err != nil || object == nil {
+		writeValidationError(w, "", "The request body must be a JSON object.")
+		return nil, false
+	}
+	return object, true
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJson(w, http.StatusNotFound, errorResponse{
+		ResultId: "resource_not_found",
+		Message:  "Resource not found.",
+	})
+}
+
+func writeValidationError(w http.ResponseWriter, fieldPath, message string) {
+	writeJson(w, http.StatusUnprocessableEntity, errorResponse{
+		ResultId: "validation_error",
+		Message:  "Validation error(s) occurred. Please review the error(s) and address accordingly.",
+		ValidationErrors: []validationError{
+			{
+				Message:   message,
+				FieldPath: fieldPath,
+				ErrorId:   "validation_error",
+			},
+		},
+	})
+}
+
+func writeJson(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
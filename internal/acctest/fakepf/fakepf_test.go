@@ -0,0 +1,182 @@
+package fakepf_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/fakepf"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+func testClient(server *fakepf.Server) *client.APIClient {
+	clientConfig := client.NewConfiguration()
+	clientConfig.Servers = client.ServerConfigurations{
+		{
+			URL: server.URL + fakepf.AdminApiPath,
+		},
+	}
+	clientConfig.HTTPClient = server.Client()
+	return client.NewAPIClient(clientConfig)
+}
+
+func testContext() context.Context {
+	return config.BasicAuthContext(context.Background(), "administrator", "2FederateM0re")
+}
+
+func TestFakePingFederateCrud(t *testing.T) {
+	server := fakepf.NewServer()
+	defer server.Close()
+	testClient := testClient(server)
+	ctx := testContext()
+
+	// Create an issuer, letting the server assign the ID
+	issuer, _, err := testClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*client.NewIssuer("issuer", "example.com")).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+	if issuer.Id == nil || *issuer.Id == "" {
+		t.Fatal("Expected an ID to be assigned to the issuer")
+	}
+	id := *issuer.Id
+
+	// Update the issuer and read it back
+	issuer.Host = "updated.example.com"
+	_, _, err = testClient.OauthIssuersAPI.UpdateOauthIssuer(ctx, id).Body(*issuer).Execute()
+	if err != nil {
+		t.Fatalf("Failed to update issuer: %v", err)
+	}
+	issuer, _, err = testClient.OauthIssuersAPI.GetOauthIssuerById(ctx, id).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read issuer: %v", err)
+	}
+	if issuer.Host != "updated.example.com" {
+		t.Errorf("Expected updated host, found %s", issuer.Host)
+	}
+
+	issuers, _, err := testClient.OauthIssuersAPI.GetOauthIssuers(ctx).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read issuers: %v", err)
+	}
+	if len(issuers.Items) != 1 {
+		t.Errorf("Expected 1 issuer, found %d", len(issuers.Items))
+	}
+
+	// Delete the issuer, after which it should not be found
+	_, err = testClient.OauthIssuersAPI.DeleteOauthIssuer(ctx, id).Execute()
+	if err != nil {
+		t.Fatalf("Failed to delete issuer: %v", err)
+	}
+	_, httpResp, err := testClient.OauthIssuersAPI.GetOauthIssuerById(ctx, id).Execute()
+	if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 reading deleted issuer, found %v", err)
+	}
+	_, err = testClient.OauthIssuersAPI.DeleteOauthIssuer(ctx, id).Execute()
+	if err == nil {
+		t.Error("Expected an error deleting an issuer that was already deleted")
+	}
+}
+
+func TestFakePingFederateValidationErrors(t *testing.T) {
+	server := fakepf.NewServer()
+	defer server.Close()
+	testClient := testClient(server)
+	ctx := testContext()
+
+	issuer := client.NewIssuer("issuer", "example.com")
+	issuer.Id = client.PtrString("myissuer")
+	_, _, err := testClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*issuer).Execute()
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+
+	// Creating a duplicate should fail with a validation error on the ID
+	_, httpResp, err := testClient.OauthIssuersAPI.AddOauthIssuer(ctx).Body(*issuer).Execute()
+	if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected 422 creating duplicate issuer, found %v", err)
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response body: %v", err)
+	}
+	var errorResponse struct {
+		ValidationErrors []struct {
+			FieldPath string `json:"fieldPath"`
+		} `json:"validationErrors"`
+	}
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		t.Fatalf("Failed to parse response body: %v", err)
+	}
+	if len(errorResponse.ValidationErrors) != 1 || errorResponse.ValidationErrors[0].FieldPath != "id" {
+		t.Errorf("Expected a validation error for the id field, found %s", string(body))
+	}
+
+	// Updating an issuer that doesn't exist should fail
+	_, httpResp, err = testClient.OauthIssuersAPI.UpdateOauthIssuer(ctx, "otherissuer").Body(*issuer).Execute()
+	if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 updating missing issuer, found %v", err)
+	}
+}
+
+func TestFakePingFederateSeed(t *testing.T) {
+	server := fakepf.NewServer()
+	defer server.Close()
+	profile, err := fakepf.ServerProfilePath("12.2.0")
+	if err != nil {
+		t.Fatalf("Failed to find server profile: %v", err)
+	}
+	if err := server.SeedFromFile(profile); err != nil {
+		t.Fatalf("Failed to seed server: %v", err)
+	}
+	testClient := testClient(server)
+	ctx := testContext()
+
+	application, _, err := testClient.AuthenticationApiAPI.GetApplication(ctx, "myauthenticationapiapplication").Execute()
+	if err != nil {
+		t.Fatalf("Failed to read seeded authentication API application: %v", err)
+	}
+	if application.Url != "https://example.com" {
+		t.Errorf("Expected seeded application url, found %s", application.Url)
+	}
+
+	dataStores, _, err := testClient.DataStoresAPI.GetDataStores(ctx).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read seeded data stores: %v", err)
+	}
+	if len(dataStores.Items) == 0 {
+		t.Error("Expected seeded data stores")
+	}
+}
+
+func TestFakePingFederateMissingObjects(t *testing.T) {
+	server := fakepf.NewServer()
+	defer server.Close()
+	testClient := testClient(server)
+	ctx := testContext()
+
+	// Objects in collections that have never been written to should not be found
+	_, httpResp, err := testClient.OauthClientsAPI.GetOauthClientById(ctx, "missing").Execute()
+	if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 reading missing OAuth client, found %v", err)
+	}
+	_, httpResp, err = testClient.KeyPairsSigningAPI.GetSigningKeyPair(ctx, "missing").Execute()
+	if err == nil || httpResp == nil || httpResp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 reading missing signing key pair, found %v", err)
+	}
+	clients, _, err := testClient.OauthClientsAPI.GetOauthClients(ctx).Execute()
+	if err != nil {
+		t.Fatalf("Failed to read OAuth clients: %v", err)
+	}
+	if len(clients.Items) != 0 {
+		t.Errorf("Expected no OAuth clients, found %d", len(clients.Items))
+	}
+
+	// Settings within a collection are not objects of the collection
+	_, _, err = testClient.KeyPairsSslServerAPI.GetSslServerSettings(ctx).Execute()
+	if err != nil {
+		t.Errorf("Failed to read SSL server settings: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package fakepf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The bulk export format used by the server profiles
+type bulkExport struct {
+	Operations []struct {
+		OperationType string           `json:"operationType"`
+		ResourceType  string           `json:"resourceType"`
+		Items         []map[string]any `json:"items"`
+	} `json:"operations"`
+}
+
+// Bulk export resource types that don't match the admin API path of their objects
+var bulkResourcePaths = map[string]string{
+	"/authenticationApi": "/authenticationApi/applications",
+}
+
+// Seed the server with the objects from a bulk export, such as the data.json files in the server profiles
+func (s *Server) Seed(data []byte) error {
+	var export bulkExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return fmt.Errorf("unable to parse bulk export: %w", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, operation := range export.Operations {
+		if operation.OperationType != "SAVE" || len(operation.Items) == 0 {
+			continue
+		}
+		resourcePath := operation.ResourceType
+		if mappedPath, ok := bulkResourcePaths[resourcePath]; ok {
+			resourcePath = mappedPath
+		}
+		idField := idFieldFor(resourcePath)
+		isCollection := true
+		for _, item := range operation.Items {
+			if id, _ := item[idField].(string); id == "" {
+				isCollection = false
+				break
+			}
+		}
+		switch {
+		case isCollection:
+			for _, item := range operation.Items {
+				s.objects[resourcePath+"/"+item[idField].(string)] = item
+			}
+			s.collections[resourcePath] = true
+		case len(operation.Items) == 1:
+			s.objects[resourcePath] = operation.Items[0]
+		default:
+			s.objects[resourcePath] = map[string]any{"items": operation.Items}
+		}
+	}
+	return nil
+}
+
+// Seed the server from a server profile data.json.subst file. Variables in the file are replaced with the
+// matching environment variables, or removed if the environment variable is not set.
+func (s *Server) SeedFromFile(filename string) error {
+	data, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return err
+	}
+	return s.Seed([]byte(os.ExpandEnv(string(data))))
+}
+
+// Get the path to the data.json.subst file of the server profile for a PingFederate version, such as "12.2"
+// or "12.2.1". The server profiles are found by searching up from the working directory for the module root.
+func ServerProfilePath(productVersion string) (string, error) {
+	versionParts := strings.Split(productVersion, ".")
+	if len(versionParts) < 2 {
+		return "", fmt.Errorf("invalid PingFederate version '%s'", productVersion)
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return filepath.Join(dir, "server-profiles", versionParts[0]+"."+versionParts[1], "data.json.subst"), nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errors.New("unable to find the module root containing the server profiles")
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,48 @@
+//go:build fakepf
+
+package acctest
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/fakepf"
+)
+
+// When built with the fakepf tag, the acceptance tests run against an in-memory fake of the PingFederate admin API
+// rather than a live server. Each test binary starts its own fake, seeded from the server profile matching
+// PINGFEDERATE_PROVIDER_PRODUCT_VERSION. The fake runs until the test binary exits.
+func init() {
+	defaultEnvVars := map[string]string{
+		"TF_ACC": "1",
+		"PINGFEDERATE_PROVIDER_INSECURE_TRUST_ALL_TLS":              "true",
+		"PINGFEDERATE_PROVIDER_X_BYPASS_EXTERNAL_VALIDATION_HEADER": "true",
+		"PINGFEDERATE_PROVIDER_PRODUCT_VERSION":                     "12.2",
+		"PINGFEDERATE_PROVIDER_USERNAME":                            "administrator",
+		"PINGFEDERATE_PROVIDER_PASSWORD":                            "2FederateM0re",
+	}
+	for envVar, value := range defaultEnvVars {
+		if os.Getenv(envVar) == "" {
+			setEnv(envVar, value)
+		}
+	}
+
+	server := fakepf.NewServer()
+	profile, err := fakepf.ServerProfilePath(os.Getenv("PINGFEDERATE_PROVIDER_PRODUCT_VERSION"))
+	if err == nil {
+		err = server.SeedFromFile(profile)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("Failed to seed the fake PingFederate server: %v", err))
+	}
+
+	// Always point the tests at the fake, even if a live server is configured in the environment
+	setEnv("PINGFEDERATE_PROVIDER_HTTPS_HOST", server.URL)
+	setEnv("PINGFEDERATE_PROVIDER_ADMIN_API_PATH", fakepf.AdminApiPath)
+}
+
+func setEnv(envVar, value string) {
+	if err := os.Setenv(envVar, value); err != nil {
+		panic(fmt.Sprintf("Failed to set the '%s' environment variable: %v", envVar, err))
+	}
+}
@@ -0,0 +1,285 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	internalprovider "github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Exporter reads objects from PingFederate through the provider, and generates the Terraform configuration
+// to manage them. The provider is configured from the PINGFEDERATE_PROVIDER_* environment variables, in the
+// same way as when it is run by Terraform with no provider configuration block.
+type Exporter struct {
+	server         tfprotov6.ProviderServer
+	schemas        map[string]*tfprotov6.Schema
+	apiClient      *client.APIClient
+	providerConfig internaltypes.ProviderConfiguration
+}
+
+// An object read from PingFederate, to be written as an import block and resource block
+type Object struct {
+	// The Terraform resource type name, which must be one of the supported resource types
+	ResourceType string
+	// The label of the resource block, which must be unique for the resource type
+	Label string
+	// The PingFederate ID, used in the import block
+	Id string
+	// The resource schema and the object state read with it
+	Schema *tfprotov6.Schema
+	State  tftypes.Value
+}
+
+// An Object with its resource type details, used when generating the configuration
+type exportedObject struct {
+	resourceType *resourceType
+	label        string
+	id           string
+	schema       *tfprotov6.Schema
+	state        tftypes.Value
+}
+
+// New configures the provider and returns an Exporter using it
+func New(ctx context.Context, providerVersion string) (*Exporter, error) {
+	// The provider is configured directly to get the API client used for the listing endpoints
+	p := internalprovider.NewFactory(providerVersion)()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	if err := diagnosticsError("Invalid provider schema", schemaResp.Diagnostics); err != nil {
+		return nil, err
+	}
+	var configureResp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    nullAttributes(schemaResp.Schema.Type().TerraformType(ctx)),
+		},
+	}, &configureResp)
+	if err := diagnosticsError("Unable to configure the provider", configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+	resourceConfig, ok := configureResp.ResourceData.(internaltypes.ResourceConfiguration)
+	if !ok {
+		return nil, errors.New("unexpected provider configuration type")
+	}
+
+	// Objects are read through the provider server, so that import and read behave as they do in Terraform
+	server := providerserver.NewProtocol6(internalprovider.NewFactory(providerVersion)())()
+	providerSchemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if err := protocolDiagnosticsError("Invalid provider schema", providerSchemaResp.Diagnostics); err != nil {
+		return nil, err
+	}
+	providerConfig, err := tfprotov6.NewDynamicValue(providerSchemaResp.Provider.ValueType(), nullAttributes(providerSchemaResp.Provider.ValueType()))
+	if err != nil {
+		return nil, err
+	}
+	configureProviderResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: &providerConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := protocolDiagnosticsError("Unable to configure the provider", configureProviderResp.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	return &Exporter{
+		server:         server,
+		schemas:        providerSchemaResp.ResourceSchemas,
+		apiClient:      resourceConfig.ApiClient,
+		providerConfig: resourceConfig.ProviderConfig,
+	}, nil
+}
+
+// Export reads the objects of the given resource types, or of all supported resource types if none are given,
+// and returns the generated configuration. Objects that can't be listed or read are skipped, and a warning is
+// returned for each of them.
+func (e *Exporter) Export(ctx context.Context, resourceTypeNames []string) ([]byte, []string, error) {
+	selectedTypes, err := selectResourceTypes(resourceTypeNames)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var objects []Object
+	var warnings []string
+	for _, selectedType := range selectedTypes {
+		schema, ok := e.schemas[selectedType.name]
+		if !ok {
+			return nil, nil, fmt.Errorf("no schema found for resource type %s", selectedType.name)
+		}
+		listed, httpResp, err := selectedType.list(config.AuthContext(ctx, e.providerConfig), e.apiClient)
+		if err != nil {
+			if httpResp != nil {
+				err = fmt.Errorf("%w (HTTP status %d)", err, httpResp.StatusCode)
+			}
+			warnings = append(warnings, fmt.Sprintf("Unable to list %s objects: %v", selectedType.name, err))
+			continue
+		}
+
+		labels := map[string]bool{}
+		for _, listedObject := range listed {
+			state, err := e.read(ctx, selectedType.name, schema, listedObject.Id)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("Unable to read %s '%s': %v", selectedType.name, listedObject.Id, err))
+				continue
+			}
+			labelSource := listedObject.Name
+			if labelSource == "" {
+				labelSource = listedObject.Id
+			}
+			objects = append(objects, Object{
+				ResourceType: selectedType.name,
+				Label:        uniqueName(toIdentifier(labelSource), labels),
+				Id:           listedObject.Id,
+				Schema:       schema,
+				State:        state,
+			})
+		}
+	}
+
+	content, err := Generate(objects)
+	if err != nil {
+		return nil, warnings, err
+	}
+	return content, warnings, nil
+}
+
+// Generate returns the import blocks and resource blocks for the given objects. References to other objects in
+// _ref attributes are replaced with references to their resources, and sensitive values are replaced with variables.
+func Generate(objects []Object) ([]byte, error) {
+	var exportedObjects []*exportedObject
+	for _, object := range objects {
+		selectedTypes, err := selectResourceTypes([]string{object.ResourceType})
+		if err != nil {
+			return nil, err
+		}
+		exportedObjects = append(exportedObjects, &exportedObject{
+			resourceType: selectedTypes[0],
+			label:        object.Label,
+			id:           object.Id,
+			schema:       object.Schema,
+			state:        object.State,
+		})
+	}
+	return newGenerator(exportedObjects).generate()
+}
+
+// Import and then read an object, returning its state
+func (e *Exporter) read(ctx context.Context, typeName string, schema *tfprotov6.Schema, id string) (tftypes.Value, error) {
+	importResp, err := e.server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := protocolDiagnosticsError("Import failed", importResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if len(importResp.ImportedResources) != 1 {
+		return tftypes.Value{}, fmt.Errorf("expected one imported resource, found %d", len(importResp.ImportedResources))
+	}
+	imported := importResp.ImportedResources[0]
+
+	readResp, err := e.server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: imported.State,
+		Private:      imported.Private,
+	})
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if err := protocolDiagnosticsError("Read failed", readResp.Diagnostics); err != nil {
+		return tftypes.Value{}, err
+	}
+	if readResp.NewState == nil {
+		return tftypes.Value{}, errors.New("no state returned")
+	}
+	state, err := readResp.NewState.Unmarshal(schema.ValueType())
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+	if state.IsNull() {
+		return tftypes.Value{}, errors.New("the object was not found")
+	}
+	return state, nil
+}
+
+func selectResourceTypes(names []string) ([]*resourceType, error) {
+	var selected []*resourceType
+	if len(names) == 0 {
+		for i := range resourceTypes {
+			selected = append(selected, &resourceTypes[i])
+		}
+		return selected, nil
+	}
+	for _, name := range names {
+		found := false
+		for i := range resourceTypes {
+			if resourceTypes[i].name == name {
+				selected = append(selected, &resourceTypes[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unsupported resource type '%s', supported resource types are: %s", name, strings.Join(SupportedResourceTypes(), ", "))
+		}
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].name < selected[j].name
+	})
+	return selected, nil
+}
+
+// Build an object value with all attributes null, used as an empty provider configuration
+func nullAttributes(valueType tftypes.Type) tftypes.Value {
+	objectType, ok := valueType.(tftypes.Object)
+	if !ok {
+		return tftypes.NewValue(valueType, nil)
+	}
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func diagnosticsError(summary string, diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+	var messages []string
+	for _, d := range diags.Errors() {
+		messages = append(messages, d.Summary()+": "+d.Detail())
+	}
+	return fmt.Errorf("%s: %s", summary, strings.Join(messages, "; "))
+}
+
+func protocolDiagnosticsError(summary string, diags []*tfprotov6.Diagnostic) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			messages = append(messages, d.Summary+": "+d.Detail)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s: %s", summary, strings.Join(messages, "; "))
+}
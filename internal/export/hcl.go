@@ -0,0 +1,352 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const fileHeader = `# Generated by pingfederate-tf-export. Review the configuration before running terraform plan,
+# and provide values for the variables holding sensitive fields.
+
+`
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// A variable generated for a sensitive attribute
+type variable struct {
+	name        string
+	description string
+	isString    bool
+}
+
+// generator writes the import blocks, resource blocks, and variables for the exported objects
+type generator struct {
+	objects []*exportedObject
+	// Exported objects by resource type and ID, and by ID alone, for resolving references
+	objectsByType map[string]map[string]*exportedObject
+	objectsById   map[string][]*exportedObject
+	variables     []variable
+	variableNames map[string]bool
+}
+
+func newGenerator(objects []*exportedObject) *generator {
+	g := &generator{
+		objects:       objects,
+		objectsByType: map[string]map[string]*exportedObject{},
+		objectsById:   map[string][]*exportedObject{},
+		variableNames: map[string]bool{},
+	}
+	for _, object := range objects {
+		if g.objectsByType[object.resourceType.name] == nil {
+			g.objectsByType[object.resourceType.name] = map[string]*exportedObject{}
+		}
+		g.objectsByType[object.resourceType.name][object.id] = object
+		g.objectsById[object.id] = append(g.objectsById[object.id], object)
+	}
+	return g
+}
+
+func (g *generator) generate() ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for _, object := range g.objects {
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: object.resourceType.name},
+			hcl.TraverseAttr{Name: object.label},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(object.id))
+		body.AppendNewline()
+
+		resourceBlock := body.AppendNewBlock("resource", []string{object.resourceType.name, object.label})
+		variablePrefix := strings.TrimPrefix(object.resourceType.name, "pingfederate_") + "_" + object.label
+		attributes, err := g.objectAttributes(object.schema.Block.Attributes, object.state, "", variablePrefix, object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate configuration for %s.%s: %w", object.resourceType.name, object.label, err)
+		}
+		for _, attribute := range attributes {
+			resourceBlock.Body().SetAttributeRaw(string(attribute.Name.Bytes()), attribute.Value)
+		}
+		body.AppendNewline()
+	}
+
+	for _, v := range g.variables {
+		variableBlock := body.AppendNewBlock("variable", []string{v.name})
+		variableBlock.Body().SetAttributeValue("description", cty.StringVal(v.description))
+		if v.isString {
+			variableBlock.Body().SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+		}
+		variableBlock.Body().SetAttributeValue("sensitive", cty.True)
+		body.AppendNewline()
+	}
+
+	content := hclwrite.Format(append([]byte(fileHeader), file.Bytes()...))
+	return append(bytes.TrimRight(content, "\n"), '\n'), nil
+}
+
+// Get the configurable attributes of an object value. Computed-only and null attributes are left out.
+// Sensitive attributes are replaced with variables, along with their encrypted_ counterparts when those are set.
+func (g *generator) objectAttributes(attributes []*tfprotov6.SchemaAttribute, value tftypes.Value, parentName, variablePrefix string, object *exportedObject) ([]hclwrite.ObjectAttrTokens, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+
+	sortedAttributes := make([]*tfprotov6.SchemaAttribute, len(attributes))
+	copy(sortedAttributes, attributes)
+	sort.Slice(sortedAttributes, func(i, j int) bool {
+		return sortedAttributes[i].Name < sortedAttributes[j].Name
+	})
+
+	// PingFederate returns encrypted values in place of sensitive values, so a sensitive attribute is only known
+	// to be set when its encrypted counterpart is.
+	replacedEncrypted := map[string]bool{}
+	for _, attribute := range sortedAttributes {
+		encryptedName := "encrypted_" + attribute.Name
+		if attribute.Sensitive && isSet(values[encryptedName]) {
+			replacedEncrypted[encryptedName] = true
+		}
+	}
+
+	var result []hclwrite.ObjectAttrTokens
+	for _, attribute := range sortedAttributes {
+		if attribute.Computed && !attribute.Optional && !attribute.Required {
+			continue
+		}
+		if replacedEncrypted[attribute.Name] {
+			continue
+		}
+		attributeValue := values[attribute.Name]
+		attributePrefix := variablePrefix + "_" + attribute.Name
+
+		var tokens hclwrite.Tokens
+		switch {
+		case attribute.Sensitive:
+			if !isSet(attributeValue) && !replacedEncrypted["encrypted_"+attribute.Name] {
+				continue
+			}
+			tokens = g.variable(attributePrefix, object, attribute.Type != nil && attribute.Type.Is(tftypes.String))
+		case !isSet(attributeValue):
+			continue
+		case attribute.Name == "id" && strings.HasSuffix(parentName, "_ref") && attributeValue.Type().Is(tftypes.String):
+			var id string
+			if err := attributeValue.As(&id); err != nil {
+				return nil, err
+			}
+			tokens = g.reference(parentName, id)
+		case attribute.NestedType != nil:
+			var err error
+			tokens, err = g.nestedTokens(attribute, attributeValue, attributePrefix, object)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			var err error
+			tokens, err = valueTokens(attributeValue)
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attribute.Name),
+			Value: tokens,
+		})
+	}
+	return result, nil
+}
+
+// Get the tokens for a nested attribute value
+func (g *generator) nestedTokens(attribute *tfprotov6.SchemaAttribute, value tftypes.Value, variablePrefix string, object *exportedObject) (hclwrite.Tokens, error) {
+	nestedAttributes := attribute.NestedType.Attributes
+	switch attribute.NestedType.Nesting {
+	case tfprotov6.SchemaObjectNestingModeSingle:
+		attributes, err := g.objectAttributes(nestedAttributes, value, attribute.Name, variablePrefix, object)
+		if err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForObject(attributes), nil
+	case tfprotov6.SchemaObjectNestingModeList, tfprotov6.SchemaObjectNestingModeSet:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var elementTokens []hclwrite.Tokens
+		for i, element := range elements {
+			attributes, err := g.objectAttributes(nestedAttributes, element, attribute.Name, variablePrefix+"_"+elementKey(element, i), object)
+			if err != nil {
+				return nil, err
+			}
+			elementTokens = append(elementTokens, hclwrite.TokensForObject(attributes))
+		}
+		return hclwrite.TokensForTuple(elementTokens), nil
+	case tfprotov6.SchemaObjectNestingModeMap:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var entries []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(elements) {
+			attributes, err := g.objectAttributes(nestedAttributes, elements[key], attribute.Name, variablePrefix+"_"+toIdentifier(key), object)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: hclwrite.TokensForObject(attributes),
+			})
+		}
+		return hclwrite.TokensForObject(entries), nil
+	default:
+		return nil, fmt.Errorf("unsupported nesting mode for attribute %s", attribute.Name)
+	}
+}
+
+// Get the tokens for an ID in a _ref attribute, replacing it with a reference when it matches an exported object.
+// The object type is taken from the attribute name when possible, such as authentication_policy_contract_ref.
+// Otherwise the ID is replaced only if a single exported object has it.
+func (g *generator) reference(refName, id string) hclwrite.Tokens {
+	target := g.objectsByType["pingfederate_"+strings.TrimSuffix(refName, "_ref")][id]
+	if target == nil && len(g.objectsById[id]) == 1 {
+		target = g.objectsById[id][0]
+	}
+	if target == nil {
+		return hclwrite.TokensForValue(cty.StringVal(id))
+	}
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: target.resourceType.name},
+		hcl.TraverseAttr{Name: target.label},
+		hcl.TraverseAttr{Name: target.resourceType.idAttribute},
+	})
+}
+
+// Add a variable for a sensitive attribute and return the tokens referencing it
+func (g *generator) variable(name string, object *exportedObject, isString bool) hclwrite.Tokens {
+	name = uniqueName(toIdentifier(name), g.variableNames)
+	g.variables = append(g.variables, variable{
+		name:        name,
+		description: fmt.Sprintf("Sensitive value for %s.%s", object.resourceType.name, object.label),
+		isString:    isString,
+	})
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// Get the tokens for a value of a non-nested attribute
+func valueTokens(value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() {
+		return hclwrite.TokensForIdentifier("null"), nil
+	}
+	valueType := value.Type()
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+	case valueType.Is(tftypes.Number):
+		var n big.Float
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(&n)), nil
+	case valueType.Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var elementTokens []hclwrite.Tokens
+		for _, element := range elements {
+			tokens, err := valueTokens(element)
+			if err != nil {
+				return nil, err
+			}
+			elementTokens = append(elementTokens, tokens)
+		}
+		return hclwrite.TokensForTuple(elementTokens), nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		var entries []hclwrite.ObjectAttrTokens
+		for _, key := range sortedKeys(elements) {
+			tokens, err := valueTokens(elements[key])
+			if err != nil {
+				return nil, err
+			}
+			name := hclwrite.TokensForValue(cty.StringVal(key))
+			if valueType.Is(tftypes.Object{}) {
+				name = hclwrite.TokensForIdentifier(key)
+			}
+			entries = append(entries, hclwrite.ObjectAttrTokens{Name: name, Value: tokens})
+		}
+		return hclwrite.TokensForObject(entries), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", valueType)
+	}
+}
+
+func isSet(value tftypes.Value) bool {
+	return value.Type() != nil && value.IsKnown() && !value.IsNull()
+}
+
+// Get a key for a list or set element, used in variable names. Elements with a name attribute, such as
+// plugin configuration fields, use the name. Other elements use their index.
+func elementKey(element tftypes.Value, index int) string {
+	var attributes map[string]tftypes.Value
+	if err := element.As(&attributes); err == nil {
+		var name string
+		if nameValue, ok := attributes["name"]; ok && isSet(nameValue) && nameValue.As(&name) == nil && name != "" {
+			return name
+		}
+	}
+	return strconv.Itoa(index)
+}
+
+func sortedKeys(values map[string]tftypes.Value) []string {
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Convert a string to a valid Terraform identifier
+func toIdentifier(value string) string {
+	identifier := strings.Trim(nonIdentifierCharacters.ReplaceAllString(strings.ToLower(value), "_"), "_")
+	if identifier == "" || (identifier[0] >= '0' && identifier[0] <= '9') {
+		identifier = "_" + identifier
+	}
+	return identifier
+}
+
+// Return the name, with a numeric suffix if needed to make it unique among the used names
+func uniqueName(name string, used map[string]bool) string {
+	unique := name
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[unique] = true
+	return unique
+}
@@ -0,0 +1,251 @@
+package export
+
+import (
+	"context"
+	"net/http"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
+)
+
+// A resource type that can be exported, with the listing endpoint used to find its objects
+type resourceType struct {
+	// The Terraform resource type name
+	name string
+	// The attribute holding the PingFederate ID, used when other objects reference this object
+	idAttribute string
+	list        func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error)
+}
+
+func toObjects[T any](items []T, httpResp *http.Response, err error, toObject func(item T) importid.Object) ([]importid.Object, *http.Response, error) {
+	if err != nil {
+		return nil, httpResp, err
+	}
+	var objects []importid.Object
+	for _, item := range items {
+		objects = append(objects, toObject(item))
+	}
+	return objects, httpResp, nil
+}
+
+var resourceTypes = []resourceType{
+	{
+		name:        "pingfederate_authentication_policy_contract",
+		idAttribute: "contract_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContracts(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(contract client.AuthenticationPolicyContract) importid.Object {
+				return importid.Object{Id: contract.GetId(), Name: contract.GetName()}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_authentication_selector",
+		idAttribute: "selector_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.AuthenticationSelectorsAPI.GetAuthenticationSelectors(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(selector client.AuthenticationSelector) importid.Object {
+				return importid.Object{Id: selector.Id, Name: selector.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_captcha_provider",
+		idAttribute: "provider_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.CaptchaProvidersAPI.GetCaptchaProviders(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(provider client.CaptchaProvider) importid.Object {
+				return importid.Object{Id: provider.Id, Name: provider.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_data_store",
+		idAttribute: "data_store_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.DataStoresAPI.GetDataStores(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(dataStore client.DataStore) importid.Object {
+				return importid.Object{Id: dataStore.GetId()}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_identity_store_provisioner",
+		idAttribute: "provisioner_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.IdentityStoreProvisionersAPI.GetIdentityStoreProvisioners(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(provisioner client.IdentityStoreProvisioner) importid.Object {
+				return importid.Object{Id: provisioner.Id, Name: provisioner.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_idp_adapter",
+		idAttribute: "adapter_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.IdpAdaptersAPI.GetIdpAdapters(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(adapter client.IdpAdapter) importid.Object {
+				return importid.Object{Id: adapter.Id, Name: adapter.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_idp_sp_connection",
+		idAttribute: "connection_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.IdpSpConnectionsAPI.GetSpConnections(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(connection client.SpConnection) importid.Object {
+				return importid.Object{Id: connection.GetId(), Name: connection.GetName(), EntityId: connection.GetEntityId()}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_idp_token_processor",
+		idAttribute: "processor_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.IdpTokenProcessorsAPI.GetTokenProcessors(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(processor client.TokenProcessor) importid.Object {
+				return importid.Object{Id: processor.Id, Name: processor.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_kerberos_realm",
+		idAttribute: "realm_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.KerberosRealmsAPI.GetKerberosRealms(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(realm client.KerberosRealm) importid.Object {
+				return importid.Object{Id: realm.GetId(), Name: realm.KerberosRealmName}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_local_identity_profile",
+		idAttribute: "profile_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.LocalIdentityIdentityProfilesAPI.GetIdentityProfiles(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(profile client.LocalIdentityProfile) importid.Object {
+				return importid.Object{Id: profile.GetId(), Name: profile.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_notification_publisher",
+		idAttribute: "publisher_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.NotificationPublishersAPI.GetNotificationPublishers(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(publisher client.NotificationPublisher) importid.Object {
+				return importid.Object{Id: publisher.Id, Name: publisher.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_oauth_access_token_manager",
+		idAttribute: "manager_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthAccessTokenManagersAPI.GetTokenManagers(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(manager client.AccessTokenManager) importid.Object {
+				return importid.Object{Id: manager.Id, Name: manager.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_oauth_ciba_server_policy_request_policy",
+		idAttribute: "policy_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthCibaServerPolicyAPI.GetCibaServerPolicies(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(policy client.RequestPolicy) importid.Object {
+				return importid.Object{Id: policy.Id, Name: policy.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_oauth_client",
+		idAttribute: "client_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthClientsAPI.GetOauthClients(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(oauthClient client.Client) importid.Object {
+				return importid.Object{Id: oauthClient.ClientId, Name: oauthClient.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_oauth_client_registration_policy",
+		idAttribute: "policy_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthClientRegistrationPoliciesAPI.GetDynamicClientRegistrationPolicies(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(policy client.ClientRegistrationPolicy) importid.Object {
+				return importid.Object{Id: policy.Id, Name: policy.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_oauth_issuer",
+		idAttribute: "issuer_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthIssuersAPI.GetOauthIssuers(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(issuer client.Issuer) importid.Object {
+				return importid.Object{Id: issuer.GetId(), Name: issuer.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_openid_connect_policy",
+		idAttribute: "policy_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.OauthOpenIdConnectAPI.GetOIDCPolicies(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(policy client.OpenIdConnectPolicy) importid.Object {
+				return importid.Object{Id: policy.Id, Name: policy.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_password_credential_validator",
+		idAttribute: "validator_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidators(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(validator client.PasswordCredentialValidator) importid.Object {
+				return importid.Object{Id: validator.Id, Name: validator.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_secret_manager",
+		idAttribute: "manager_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.SecretManagersAPI.GetSecretManagers(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(manager client.SecretManager) importid.Object {
+				return importid.Object{Id: manager.Id, Name: manager.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_sp_adapter",
+		idAttribute: "adapter_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.SpAdaptersAPI.GetSpAdapters(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(adapter client.SpAdapter) importid.Object {
+				return importid.Object{Id: adapter.Id, Name: adapter.Name}
+			})
+		},
+	},
+	{
+		name:        "pingfederate_sp_idp_connection",
+		idAttribute: "connection_id",
+		list: func(ctx context.Context, apiClient *client.APIClient) ([]importid.Object, *http.Response, error) {
+			response, httpResp, err := apiClient.SpIdpConnectionsAPI.GetConnections(ctx).Execute()
+			return toObjects(response.GetItems(), httpResp, err, func(connection client.IdpConnection) importid.Object {
+				return importid.Object{Id: connection.GetId(), Name: connection.GetName(), EntityId: connection.GetEntityId()}
+			})
+		},
+	},
+}
+
+// SupportedResourceTypes returns the names of the resource types that can be exported
+func SupportedResourceTypes() []string {
+	var names []string
+	for _, resourceType := range resourceTypes {
+		names = append(names, resourceType.name)
+	}
+	return names
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -24,7 +25,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+	resourceconfig "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/administrativeaccount"
+	administrativeaccountpassword "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/administrativeaccount/password"
 	authenticationapiapplication "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationapi/application"
 	authenticationapisettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationapi/settings"
 	authenticationpolicies "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationpolicies"
@@ -32,23 +36,32 @@ import (
 	authenticationpoliciessettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationpolicies/settings"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationpolicycontract"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/authenticationselector"
+	bulkexport "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/bulk/export"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/captchaproviders"
 	captchaproviderssettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/captchaproviders/settings"
 	certificate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/ca"
 	certificatesgroups "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/groups"
 	certificatesrevocationocspcertificates "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/revocation/ocspcertificates"
 	certificatesrevocationsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/certificates/revocation/settings"
+	clusterreplication "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/replication"
 	clustersettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/settings"
 	clusterstatus "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/cluster/status"
+	configarchiveexport "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/configarchive/export"
+	configarchiveimport "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/configarchive/import"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/configstore"
 	configurationencryptionkeysrotate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/configurationencryptionkeys/rotate"
+	connectionmetadataconvert "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/connectionmetadata/convert"
 	connectionmetadataexport "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/connectionmetadata/export"
 	datastore "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/datastore"
+	datastoreconnectiontest "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/datastore/connectiontest"
 	extendedproperties "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/extendedproperties"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/extendedproperty"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/identitystoreprovisioners"
 	idpadapter "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/adapter"
 	idpdefaulturls "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/defaulturls"
 	idpspconnection "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/spconnection"
+	idpspconnectioncredentialcert "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/spconnection/credentialcert"
+	idpspconnectionsigningsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/spconnection/signingsettings"
 	idpstsrequestparameterscontracts "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/stsrequestparameterscontracts"
 	idptokenprocessors "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idp/tokenprocessors"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/idptospadaptermapping"
@@ -59,6 +72,7 @@ import (
 	keypairsoauthopenidconnectadditionalkeysets "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/oauthopenidconnect/additionalkeysets"
 	keypairsigning "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/signing"
 	keypairssigningcertificate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/signing/certificate"
+	keypairssigningpkcs12 "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/signing/pkcs12"
 	keypairssigningrotationsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/signing/rotationsettings"
 	keypairssslclient "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslclient"
 	keypairssslclientcertificate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslclient/certificate"
@@ -66,6 +80,7 @@ import (
 	keypairssslserver "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslserver"
 	keypairssslservercertificate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslserver/certificate"
 	keypairssslservercsr "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslserver/csr"
+	keypairssslserverpkcs12 "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslserver/pkcs12"
 	keypairssslserversettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/keypairs/sslserver/settings"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/license"
 	licenseagreement "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/license/agreement"
@@ -78,6 +93,8 @@ import (
 	oauthaccesstokenmapping "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/accesstokenmapping"
 	oauthauthenticationpolicycontractmappings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/authenticationpolicycontractmappings"
 	oauthauthserversettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/authserversettings"
+	oauthauthserversettingsscopegroups "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/authserversettings/scopegroups"
+	oauthauthserversettingsscopes "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/authserversettings/scopes"
 	oauthcibaserverpolicyrequestpolicies "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/cibaserverpolicy/requestpolicies"
 	oauthcibaserverpolicysettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/cibaserverpolicy/settings"
 	oauthclient "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/client"
@@ -91,13 +108,17 @@ import (
 	oauthtokenexchangetokengeneratormapping "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/oauth/tokenexchange/tokengeneratormapping"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/passwordcredentialvalidator"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/pingoneconnection"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/pluginaction"
 	protocolmetadatalifetimesettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/protocolmetadata/lifetimesettings"
 	protocolmetadatasigningsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/protocolmetadata/signingsettings"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/redirectvalidation"
+	redirectvalidationallowedurl "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/redirectvalidation/allowedurl"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/secretmanagers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serverinfo"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings"
 	serversettingsgeneralsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/generalsettings"
 	serversettingslogsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/logsettings"
+	serversettingsoutboundprovisioning "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/outboundprovisioning"
 	serversettingssystemkeys "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/systemkeys"
 	serversettingssystemkeysrotate "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/systemkeys/rotate"
 	serversettingswstruststssettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/serversettings/wstruststssettings"
@@ -111,8 +132,12 @@ import (
 	spauthenticationpolicycontractmapping "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/authenticationpolicycontractmapping"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/defaulturls"
 	spidpconnection "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/idpconnection"
+	spidpconnectioncredentialcert "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/idpconnection/credentialcert"
+	spidpconnectionsigningsettings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/idpconnection/signingsettings"
+	sptargeturlmapping "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/targeturlmapping"
 	sptargeturlmappings "github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/sp/targeturlmappings"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/tokenprocessortotokengeneratormapping"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/virtualhostname"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/virtualhostnames"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -121,7 +146,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfacesß
 var (
-	_ provider.Provider = &pingfederateProvider{}
+	_ provider.Provider                       = &pingfederateProvider{}
+	_ provider.ProviderWithEphemeralResources = &pingfederateProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -153,11 +179,15 @@ type pingfederateProviderModel struct {
 	CACertificatePEMFiles           types.Set    `tfsdk:"ca_certificate_pem_files"`
 	XBypassExternalValidationHeader types.Bool   `tfsdk:"x_bypass_external_validation_header"`
 	ProductVersion                  types.String `tfsdk:"product_version"`
+	AutoReplicateAfterApply         types.Bool   `tfsdk:"auto_replicate_after_apply"`
 }
 
 // pingfederateProvider is the provider implementation.
 type pingfederateProvider struct {
 	version string
+	// Replicates configuration changes to the cluster when auto_replicate_after_apply is set. Only set for
+	// providers served with NewProtocol6Server.
+	replicator *api.Replicator
 }
 
 // Metadata returns the provider type name.
@@ -280,6 +310,10 @@ func (p *pingfederateProvider) Schema(_ context.Context, _ provider.SchemaReques
 				Description: "Header value in request for PingFederate. When set to `true`, connectivity checks for resources such as `pingfederate_data_store` will be skipped. Default value can be set with the `PINGFEDERATE_PROVIDER_X_BYPASS_EXTERNAL_VALIDATION_HEADER` environment variable.",
 				Optional:    true,
 			},
+			"auto_replicate_after_apply": schema.BoolAttribute{
+				Description: "When set to `true`, the provider will replicate the configuration to the PingFederate cluster after it changes the configuration. Changes to resources that are applied in parallel are replicated together once none of them are still in progress. Replication failures are reported as warnings and do not fail the apply, and replication is skipped when PingFederate is not deployed in clustered mode. Use the `pingfederate_cluster_replication` resource to replicate once and wait for the cluster nodes to sync. Default value can be set with the `PINGFEDERATE_PROVIDER_AUTO_REPLICATE_AFTER_APPLY` environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -626,6 +660,18 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 		}
 	}
 
+	var autoReplicateAfterApply bool
+	var autoReplicateAfterApplyErr error
+	if !config.AutoReplicateAfterApply.IsUnknown() && !config.AutoReplicateAfterApply.IsNull() {
+		autoReplicateAfterApply = config.AutoReplicateAfterApply.ValueBool()
+	} else {
+		autoReplicateAfterApply, autoReplicateAfterApplyErr = strconv.ParseBool(os.Getenv("PINGFEDERATE_PROVIDER_AUTO_REPLICATE_AFTER_APPLY"))
+		if autoReplicateAfterApplyErr != nil {
+			autoReplicateAfterApply = false
+			tflog.Info(ctx, "Failed to parse boolean from 'PINGFEDERATE_PROVIDER_AUTO_REPLICATE_AFTER_APPLY' environment variable, defaulting 'auto_replicate_after_apply' to false")
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -677,17 +723,46 @@ func (p *pingfederateProvider) Configure(ctx context.Context, req provider.Confi
 			RootCAs:            caCertPool,
 		},
 	}
-	httpClient := &http.Client{Transport: tr}
-	resourceConfig.ProviderConfig.Transport = tr
+	var transport http.RoundTripper = tr
+	// Cassettes are only used by the acceptance tests, to record and replay PingFederate responses.
+	// They are configured directly from environment variables rather than as provider parameters.
+	if cassettePath := os.Getenv("PINGFEDERATE_TF_CASSETTE_PATH"); cassettePath != "" {
+		strict, _ := strconv.ParseBool(os.Getenv("PINGFEDERATE_TF_CASSETTE_STRICT"))
+		cassette, err := api.OpenCassette(cassettePath, os.Getenv("PINGFEDERATE_TF_CASSETTE_MODE"), strict)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to open HTTP cassette", err.Error())
+			return
+		}
+		transport = api.NewCassetteTransport(tr, cassette)
+	}
+	httpClient := &http.Client{Transport: transport}
+	var apiClient *client.APIClient
+	if autoReplicateAfterApply {
+		if p.replicator != nil {
+			httpClient.Transport = p.replicator.Transport(transport, func(ctx context.Context) (*http.Response, error) {
+				// Authenticate with the current provider credentials, which can be changed during the apply
+				_, httpResp, err := apiClient.ClusterAPI.StartReplication(resourceconfig.AuthContext(ctx, resourceConfig.ProviderConfig)).Execute()
+				return httpResp, err
+			})
+		} else {
+			tflog.Warn(ctx, "auto_replicate_after_apply is not supported by this provider server, configuration changes will not be replicated")
+		}
+	}
+	// Requests made outside of the API client, such as retrieving metadata from a URL, use the cassette as well.
+	// The client's OAuth token source requires a concrete *http.Transport, so it can't.
+	resourceConfig.ProviderConfig.Transport = transport
+	resourceConfig.ProviderConfig.OAuthTransport = tr
 	clientConfig.HTTPClient = httpClient
 	userAgentSuffix := fmt.Sprintf("terraform-provider-pingfederate/%s %s", p.version, productVersion)
 	if userAgentExtraSuffix != "" {
 		userAgentSuffix += fmt.Sprintf(" %s", userAgentExtraSuffix)
 	}
 	clientConfig.UserAgentSuffix = pointers.String(userAgentSuffix)
-	resourceConfig.ApiClient = client.NewAPIClient(clientConfig)
+	apiClient = client.NewAPIClient(clientConfig)
+	resourceConfig.ApiClient = apiClient
 	resp.ResourceData = resourceConfig
 	resp.DataSourceData = resourceConfig
+	resp.EphemeralResourceData = resourceConfig
 	tflog.Info(ctx, "Configured PingFederate client", map[string]interface{}{"success": true})
 }
 
@@ -700,11 +775,15 @@ func (p *pingfederateProvider) DataSources(_ context.Context) []func() datasourc
 		authenticationpoliciesfragments.AuthenticationPoliciesFragmentDataSource,
 		authenticationpoliciessettings.AuthenticationPoliciesSettingsDataSource,
 		authenticationpolicycontract.AuthenticationPolicyContractDataSource,
+		bulkexport.BulkExportDataSource,
 		certificate.CertificatesCAExportDataSource,
 		certificate.CertificateDataSource,
 		clusterstatus.ClusterStatusDataSource,
+		configarchiveexport.ConfigArchiveExportDataSource,
 		configstore.ConfigStoreDataSource,
+		connectionmetadataconvert.ConnectionMetadataConvertDataSource,
 		datastore.DataStoreDataSource,
+		datastoreconnectiontest.DataStoreConnectionTestDataSource,
 		idpadapter.IdpAdapterDataSource,
 		idpdefaulturls.IdpDefaultUrlsDataSource,
 		idpspconnection.IdpSpConnectionDataSource,
@@ -724,11 +803,15 @@ func (p *pingfederateProvider) DataSources(_ context.Context) []func() datasourc
 		oauthtokenexchangetokengeneratormapping.OauthTokenExchangeTokenGeneratorMappingDataSource,
 		oauthopenidconnectpolicy.OpenidConnectPolicyDataSource,
 		passwordcredentialvalidator.PasswordCredentialValidatorDataSource,
+		pingoneconnection.PingoneConnectionStatusDataSource,
+		pluginaction.PluginActionsDataSource,
 		protocolmetadatalifetimesettings.ProtocolMetadataLifetimeSettingsDataSource,
 		redirectvalidation.RedirectValidationDataSource,
+		serverinfo.ServerInfoDataSource,
 		serversettings.ServerSettingsDataSource,
 		serversettingsgeneralsettings.ServerSettingsGeneralDataSource,
 		serversettingslogsettings.ServerSettingsLoggingDataSource,
+		serversettingsoutboundprovisioning.ServerSettingsOutboundProvisioningDataSource,
 		serversettingssystemkeys.ServerSettingsSystemKeysDataSource,
 		sessionapplicationsessionpolicy.SessionApplicationPolicyDataSource,
 		sessionauthenticationsessionpoliciesglobal.SessionAuthenticationPoliciesGlobalDataSource,
@@ -743,6 +826,7 @@ func (p *pingfederateProvider) DataSources(_ context.Context) []func() datasourc
 func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		administrativeaccount.AdministrativeAccountResource,
+		administrativeaccountpassword.AdministrativeAccountPasswordResource,
 		authenticationapiapplication.AuthenticationApiApplicationResource,
 		authenticationapisettings.AuthenticationApiSettingsResource,
 		authenticationpolicies.AuthenticationPoliciesResource,
@@ -756,15 +840,20 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		certificatesgroups.CertificatesGroupResource,
 		certificatesrevocationocspcertificates.CertificatesRevocationOcspCertificateResource,
 		certificatesrevocationsettings.CertificatesRevocationSettingsResource,
+		clusterreplication.ClusterReplicationResource,
 		clustersettings.ClusterSettingsResource,
+		configarchiveimport.ConfigArchiveImportResource,
 		configstore.ConfigStoreResource,
 		configurationencryptionkeysrotate.ConfigurationEncryptionKeysRotateResource,
 		connectionmetadataexport.ConnectionMetadataExportResource,
 		defaulturls.DefaultUrlsResource,
 		extendedproperties.ExtendedPropertiesResource,
+		extendedproperty.ExtendedPropertyResource,
 		identitystoreprovisioners.IdentityStoreProvisionerResource,
 		idpadapter.IdpAdapterResource,
 		idpspconnection.IdpSpConnectionResource,
+		idpspconnectioncredentialcert.IdpSpConnectionCredentialCertResource,
+		idpspconnectionsigningsettings.IdpSpConnectionSigningSettingsResource,
 		idpstsrequestparameterscontracts.IdpStsRequestParametersContractResource,
 		idptospadaptermapping.IdpToSpAdapterMappingResource,
 		idptokenprocessors.IdpTokenProcessorResource,
@@ -794,6 +883,10 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		oauthaccesstokenmapping.OauthAccessTokenMappingResource,
 		oauthauthenticationpolicycontractmappings.OauthAuthenticationPolicyContractMappingResource,
 		oauthauthserversettings.OauthServerSettingsResource,
+		oauthauthserversettingsscopegroups.OauthExclusiveScopeGroupResource,
+		oauthauthserversettingsscopegroups.OauthScopeGroupResource,
+		oauthauthserversettingsscopes.OauthExclusiveScopeResource,
+		oauthauthserversettingsscopes.OauthScopeResource,
 		oauthcibaserverpolicyrequestpolicies.OauthCibaServerPolicyRequestPolicyResource,
 		oauthcibaserverpolicysettings.OauthCibaServerPolicySettingsResource,
 		oauthclient.OauthClientResource,
@@ -807,13 +900,16 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		oauthtokenexchangetokengeneratormapping.OauthTokenExchangeTokenGeneratorMappingResource,
 		passwordcredentialvalidator.PasswordCredentialValidatorResource,
 		pingoneconnection.PingoneConnectionResource,
+		pluginaction.PluginActionResource,
 		protocolmetadatalifetimesettings.ProtocolMetadataLifetimeSettingsResource,
 		protocolmetadatasigningsettings.ProtocolMetadataSigningSettingsResource,
 		redirectvalidation.RedirectValidationResource,
+		redirectvalidationallowedurl.RedirectValidationAllowedUrlResource,
 		secretmanagers.SecretManagerResource,
 		serversettings.ServerSettingsResource,
 		serversettingsgeneralsettings.ServerSettingsGeneralResource,
 		serversettingslogsettings.ServerSettingsLoggingResource,
+		serversettingsoutboundprovisioning.ServerSettingsOutboundProvisioningResource,
 		serversettingssystemkeysrotate.ServerSettingsSystemKeysRotateResource,
 		serversettingswstruststssettingsissuercertificates.ServerSettingsWsTrustStsSettingsIssuerCertificateResource,
 		serversettingswstruststssettings.ServerSettingsWsTrustStsSettingsResource,
@@ -824,9 +920,22 @@ func (p *pingfederateProvider) Resources(_ context.Context) []func() resource.Re
 		sessionsettings.SessionSettingsResource,
 		spadapters.SpAdapterResource,
 		spidpconnection.SpIdpConnectionResource,
+		spidpconnectioncredentialcert.SpIdpConnectionCredentialCertResource,
+		spidpconnectionsigningsettings.SpIdpConnectionSigningSettingsResource,
 		spauthenticationpolicycontractmapping.SpAuthenticationPolicyContractMappingResource,
 		sptargeturlmappings.SpTargetUrlMappingsResource,
+		sptargeturlmapping.SpTargetUrlMappingResource,
 		tokenprocessortotokengeneratormapping.TokenProcessorToTokenGeneratorMappingResource,
 		virtualhostnames.VirtualHostNamesResource,
+		virtualhostname.VirtualHostNameResource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *pingfederateProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		administrativeaccountpassword.AdministrativeAccountGeneratedPasswordEphemeralResource,
+		keypairssigningpkcs12.KeypairsSigningKeyPkcs12EphemeralResource,
+		keypairssslserverpkcs12.KeypairsSslServerKeyPkcs12EphemeralResource,
 	}
 }
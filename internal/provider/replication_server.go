@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
+)
+
+// replicationServer replicates the configuration to the PingFederate cluster after resources are applied, when
+// auto_replicate_after_apply is enabled. Replication failures are added to the apply response as warnings.
+type replicationServer struct {
+	tfprotov6.ProviderServer
+	replicator *api.Replicator
+}
+
+// NewProtocol6Server returns a factory for protocol version 6 servers for the provider. Unlike servers created
+// from NewFactory, these servers support auto_replicate_after_apply.
+func NewProtocol6Server(version string) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		replicator := api.NewReplicator()
+		p := &pingfederateProvider{
+			version:    version,
+			replicator: replicator,
+		}
+		return &replicationServer{
+			ProviderServer: providerserver.NewProtocol6(p)(),
+			replicator:     replicator,
+		}
+	}
+}
+
+func (s *replicationServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	s.replicator.BeginChange()
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if replicateErr := s.replicator.EndChange(ctx); replicateErr != nil && resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov6.Diagnostic{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "Cluster replication failed",
+			Detail:   "The configuration change was applied, but could not be replicated to the PingFederate cluster: " + replicateErr.Error(),
+		})
+	}
+	return resp, err
+}
@@ -0,0 +1,295 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	// Send requests to PingFederate and save each request and response to the cassette
+	CassetteModeRecord = "record"
+	// Serve responses from the cassette without contacting PingFederate
+	CassetteModeReplay = "replay"
+
+	scrubbedValue = "********"
+)
+
+var (
+	// JSON fields that are replaced in recorded request and response bodies
+	secretFields = map[string]bool{
+		"accesstoken":       true,
+		"clientsecret":      true,
+		"encryptedpassword": true,
+		"encryptedsecret":   true,
+		"encryptedvalue":    true,
+		"filedata":          true,
+		"password":          true,
+		"privatekey":        true,
+		"secret":            true,
+	}
+	// Names of plugin configuration fields whose value is replaced in recorded bodies
+	secretFieldNames = regexp.MustCompile(`(?i)password|secret|private key`)
+
+	// Cassettes that are open in this process, keyed by file path. The provider is configured again for each
+	// test step, so the cassette must be shared between the transports created for a single test.
+	openCassettes      = map[string]*Cassette{}
+	openCassettesMutex sync.Mutex
+)
+
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Url    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type CassetteResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// Cassette holds the HTTP interactions recorded for a single test
+type Cassette struct {
+	Interactions []CassetteInteraction `json:"interactions"`
+
+	mutex  sync.Mutex
+	path   string
+	mode   string
+	strict bool
+	// Index of the next interaction to replay in strict mode, and the interactions already replayed otherwise
+	next int
+	used []bool
+}
+
+// Open the cassette at the given path, or return it if it is already open in this process.
+// In record mode a new empty cassette is started. In replay mode the cassette is loaded from the file.
+// With strict matching, replayed requests must match the recorded requests in order, including the request body.
+func OpenCassette(path, mode string, strict bool) (*Cassette, error) {
+	openCassettesMutex.Lock()
+	defer openCassettesMutex.Unlock()
+	if cassette, ok := openCassettes[path]; ok {
+		return cassette, nil
+	}
+
+	cassette := &Cassette{
+		path:   path,
+		mode:   mode,
+		strict: strict,
+	}
+	switch mode {
+	case CassetteModeRecord:
+	case CassetteModeReplay:
+		data, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette %s: %w", path, err)
+		}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			return nil, fmt.Errorf("unable to parse cassette %s: %w", path, err)
+		}
+		cassette.used = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("invalid cassette mode '%s', must be '%s' or '%s'", mode, CassetteModeRecord, CassetteModeReplay)
+	}
+	openCassettes[path] = cassette
+	return cassette, nil
+}
+
+// Close the cassette at the given path. In record mode, the recorded interactions are written to the file.
+func CloseCassette(path string) error {
+	openCassettesMutex.Lock()
+	cassette, ok := openCassettes[path]
+	delete(openCassettes, path)
+	openCassettesMutex.Unlock()
+	if !ok || cassette.mode != CassetteModeRecord {
+		return nil
+	}
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+	data, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// cassetteTransport records requests to a cassette, or replays responses from it
+type cassetteTransport struct {
+	base     http.RoundTripper
+	cassette *Cassette
+}
+
+// NewCassetteTransport wraps the given transport so that requests are recorded to or replayed from the cassette,
+// depending on the mode the cassette was opened with. Secrets are scrubbed from recorded bodies.
+func NewCassetteTransport(base http.RoundTripper, cassette *Cassette) http.RoundTripper {
+	return &cassetteTransport{
+		base:     base,
+		cassette: cassette,
+	}
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	recordedRequest := CassetteRequest{
+		Method: req.Method,
+		Url:    req.URL.RequestURI(),
+		Body:   scrubBody(requestBody),
+	}
+
+	if t.cassette.mode == CassetteModeReplay {
+		recordedResponse, err := t.cassette.replay(recordedRequest)
+		if err != nil {
+			return nil, err
+		}
+		return toHttpResponse(req, recordedResponse), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	t.cassette.record(CassetteInteraction{
+		Request: recordedRequest,
+		Response: CassetteResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        scrubBody(responseBody),
+		},
+	})
+	return resp, nil
+}
+
+func (c *Cassette) record(interaction CassetteInteraction) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+}
+
+// Find the recorded response for a request. Without strict matching, the first unused interaction with the same
+// method and URL is returned, falling back to the last matching interaction for repeated requests.
+func (c *Cassette) replay(request CassetteRequest) (CassetteResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.strict {
+		if c.next >= len(c.Interactions) {
+			return CassetteResponse{}, fmt.Errorf("cassette %s has no more recorded interactions for %s %s", c.path, request.Method, request.Url)
+		}
+		recorded := c.Interactions[c.next]
+		if recorded.Request != request {
+			return CassetteResponse{}, fmt.Errorf("cassette %s expected request %s %s with body %s, found %s %s with body %s",
+				c.path, recorded.Request.Method, recorded.Request.Url, recorded.Request.Body, request.Method, request.Url, request.Body)
+		}
+		c.next++
+		return recorded.Response, nil
+	}
+
+	lastMatch := -1
+	for i, recorded := range c.Interactions {
+		if recorded.Request.Method != request.Method || recorded.Request.Url != request.Url {
+			continue
+		}
+		if !c.used[i] {
+			c.used[i] = true
+			return recorded.Response, nil
+		}
+		lastMatch = i
+	}
+	if lastMatch >= 0 {
+		return c.Interactions[lastMatch].Response, nil
+	}
+	return CassetteResponse{}, fmt.Errorf("cassette %s has no recorded interaction for %s %s", c.path, request.Method, request.Url)
+}
+
+func toHttpResponse(req *http.Request, recorded CassetteResponse) *http.Response {
+	header := http.Header{}
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+// Replace secrets in a JSON body. The body is also normalized, so that equivalent requests can be matched.
+// Bodies that are not JSON are recorded as they are.
+func scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+	scrubbed, err := json.Marshal(scrubValue(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+func scrubValue(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		// Plugin configuration fields hold their value in a separate field from the name
+		if name, ok := typedValue["name"].(string); ok && secretFieldNames.MatchString(name) {
+			if _, hasValue := typedValue["value"]; hasValue {
+				typedValue["value"] = scrubbedValue
+			}
+		}
+		for key, fieldValue := range typedValue {
+			if secretFields[strings.ToLower(key)] {
+				typedValue[key] = scrubbedValue
+			} else {
+				typedValue[key] = scrubValue(fieldValue)
+			}
+		}
+		return typedValue
+	case []any:
+		for i, element := range typedValue {
+			typedValue[i] = scrubValue(element)
+		}
+		return typedValue
+	default:
+		return value
+	}
+}
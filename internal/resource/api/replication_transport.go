@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// POST endpoints that don't change the configuration, such as plugin actions, metadata conversion, and key pair
+// exports. Requests to these endpoints are not replicated.
+var nonConfigurationPaths = []*regexp.Regexp{
+	regexp.MustCompile(`/actions/[^/]+/invokeAction$`),
+	regexp.MustCompile(`/connectionMetadata/(convert|export)$`),
+	regexp.MustCompile(`/keyPairs/[^/]+/[^/]+/(pkcs12|pem)$`),
+	regexp.MustCompile(`/collectSupportData/archives/collect$`),
+	regexp.MustCompile(`/cluster/(replicate|adminNode/role/active)$`),
+}
+
+// Replicator replicates the configuration to the PingFederate cluster after the provider changes it.
+// Configuration changes are recorded by the transport returned from Transport, and are replicated when no
+// resource changes are in progress, so that resources applied in parallel are replicated together.
+type Replicator struct {
+	mutex sync.Mutex
+	// Sends the replication request
+	replicateFunc ReplicateFunc
+	// Whether there are configuration changes that have not been replicated
+	pending bool
+	// The number of resource changes in progress
+	inProgress int
+}
+
+type replicationTransport struct {
+	base       http.RoundTripper
+	replicator *Replicator
+}
+
+// Send a request to replicate the cluster configuration. The request must authenticate with the current provider
+// credentials rather than those of an earlier request, since resources such as
+// pingfederate_administrative_account_password can change them during an apply.
+type ReplicateFunc func(ctx context.Context) (*http.Response, error)
+
+func NewReplicator() *Replicator {
+	return &Replicator{}
+}
+
+// Transport wraps the given transport so that every successful configuration change made through it is
+// recorded for replication. The configuration is replicated with the given function.
+func (r *Replicator) Transport(base http.RoundTripper, replicate ReplicateFunc) http.RoundTripper {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.replicateFunc = replicate
+	return &replicationTransport{
+		base:       base,
+		replicator: r,
+	}
+}
+
+func (t *replicationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && IsConfigurationChange(req, resp) {
+		t.replicator.recordChange(req)
+	}
+	return resp, err
+}
+
+// IsConfigurationChange returns whether a request successfully changed the PingFederate configuration
+func IsConfigurationChange(req *http.Request, resp *http.Response) bool {
+	if req.Method != http.MethodPost && req.Method != http.MethodPut && req.Method != http.MethodDelete {
+		return false
+	}
+	if req.Method == http.MethodPost {
+		for _, nonConfigurationPath := range nonConfigurationPaths {
+			if nonConfigurationPath.MatchString(path.Clean(req.URL.Path)) {
+				return false
+			}
+		}
+	}
+	return resp.StatusCode >= 200 && resp.StatusCode < 300
+}
+
+func (r *Replicator) recordChange(req *http.Request) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.pending = true
+	tflog.Debug(req.Context(), fmt.Sprintf("Recorded configuration change for replication: %s %s", req.Method, req.URL.Path))
+}
+
+// BeginChange records that a resource change is in progress
+func (r *Replicator) BeginChange() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.inProgress++
+}
+
+// EndChange records that a resource change has completed. When no other resource changes are in progress
+// and there are configuration changes that have not been replicated, the configuration is replicated.
+// Replication errors are returned so that they can be reported as warnings, since the configuration changes
+// have already been applied.
+func (r *Replicator) EndChange(ctx context.Context) error {
+	r.mutex.Lock()
+	r.inProgress--
+	if r.inProgress > 0 || !r.pending || r.replicateFunc == nil {
+		r.mutex.Unlock()
+		return nil
+	}
+	r.pending = false
+	replicate := r.replicateFunc
+	r.mutex.Unlock()
+
+	err := r.replicate(ctx, replicate)
+	if err != nil {
+		// Leave the changes pending, so that replication is attempted again after the next resource change
+		r.mutex.Lock()
+		r.pending = true
+		r.mutex.Unlock()
+	}
+	return err
+}
+
+// Replicate the configuration, treating a PingFederate server that is not clustered as a success
+func (r *Replicator) replicate(ctx context.Context, replicate ReplicateFunc) error {
+	resp, err := replicate(ctx)
+	if resp == nil {
+		if err == nil {
+			err = errors.New("no response received")
+		}
+		return fmt.Errorf("failed to replicate the cluster configuration: %w", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		tflog.Debug(ctx, "Replicated the cluster configuration")
+	case strings.Contains(string(body), "not deployed in clustered mode"):
+		tflog.Debug(ctx, "PingFederate is not deployed in clustered mode, skipping cluster replication")
+	default:
+		return fmt.Errorf("failed to replicate the cluster configuration, status %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
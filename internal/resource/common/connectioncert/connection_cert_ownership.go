@@ -0,0 +1,59 @@
+package connectioncert
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// SplitCerts separates the certificates of a connection into those managed by the connection resource, as
+// identified by the x509_file IDs in its certs value, and those managed elsewhere, such as by the connection
+// credential cert resources. If the certs value is unknown, all certificates are considered managed.
+func SplitCerts(certs []client.ConnectionCert, managedCerts attr.Value) (managed, unmanaged []client.ConnectionCert) {
+	if managedCerts == nil || managedCerts.IsUnknown() {
+		return certs, nil
+	}
+
+	managedIds := map[string]bool{}
+	if managedCertsList, ok := managedCerts.(types.List); ok && internaltypes.IsDefined(managedCertsList) {
+		for _, cert := range managedCertsList.Elements() {
+			x509File, ok := cert.(types.Object).Attributes()["x509_file"].(types.Object)
+			if !ok || !internaltypes.IsDefined(x509File) {
+				continue
+			}
+			id, ok := x509File.Attributes()["id"].(types.String)
+			if !ok || id.IsUnknown() {
+				// The ID will be assigned by PingFederate, so the certificates can't be reliably separated
+				return certs, nil
+			}
+			managedIds[id.ValueString()] = true
+		}
+	}
+
+	for _, cert := range certs {
+		if cert.X509File.Id != nil && managedIds[*cert.X509File.Id] {
+			managed = append(managed, cert)
+		} else {
+			unmanaged = append(unmanaged, cert)
+		}
+	}
+	return managed, unmanaged
+}
+
+// RemoveCerts returns the given certificates, excluding any with the same x509_file ID as a certificate in remove
+func RemoveCerts(certs, remove []client.ConnectionCert) []client.ConnectionCert {
+	removeIds := map[string]bool{}
+	for _, cert := range remove {
+		if cert.X509File.Id != nil {
+			removeIds[*cert.X509File.Id] = true
+		}
+	}
+	var result []client.ConnectionCert
+	for _, cert := range certs {
+		if cert.X509File.Id == nil || !removeIds[*cert.X509File.Id] {
+			result = append(result, cert)
+		}
+	}
+	return result
+}
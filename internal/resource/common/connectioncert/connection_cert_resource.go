@@ -0,0 +1,491 @@
+package connectioncert
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource                = &credentialCertResource{}
+	_ resource.ResourceWithConfigure   = &credentialCertResource{}
+	_ resource.ResourceWithImportState = &credentialCertResource{}
+
+	certIdCustomId = "cert_id"
+)
+
+// CredentialCertResourceOptions describes the connection type managed by a credential cert resource
+type CredentialCertResourceOptions struct {
+	// The resource type name, without the provider prefix
+	TypeName string
+	// The PingFederate connection type, either SP or IDP
+	ConnectionType string
+	// The name of the connection type used in messages, such as "IdP SP Connection"
+	ConnectionName string
+	// The type name of the connection resource, including the provider prefix
+	ConnectionResourceTypeName string
+	ConnectionIdDescription    string
+	EncryptionCertDescription  string
+	GetCerts                   func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.ConnectionCerts, *http.Response, error)
+	AddCert                    func(ctx context.Context, apiClient *client.APIClient, connectionId string, cert client.ConnectionCert) (*client.ConnectionCert, *http.Response, error)
+	UpdateCerts                func(ctx context.Context, apiClient *client.APIClient, connectionId string, certs client.ConnectionCerts) (*client.ConnectionCerts, *http.Response, error)
+}
+
+// NewCredentialCertResource returns a resource that manages a single certificate of a connection
+func NewCredentialCertResource(options CredentialCertResourceOptions) resource.Resource {
+	return &credentialCertResource{
+		options: options,
+	}
+}
+
+type credentialCertResource struct {
+	options        CredentialCertResourceOptions
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+func (r *credentialCertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.options.TypeName
+}
+
+func (r *credentialCertResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type credentialCertResourceModel struct {
+	ActiveVerificationCert    types.Bool   `tfsdk:"active_verification_cert"`
+	CertId                    types.String `tfsdk:"cert_id"`
+	ConnectionId              types.String `tfsdk:"connection_id"`
+	CryptoProvider            types.String `tfsdk:"crypto_provider"`
+	EncryptionCert            types.Bool   `tfsdk:"encryption_cert"`
+	Expires                   types.String `tfsdk:"expires"`
+	FileData                  types.String `tfsdk:"file_data"`
+	FormattedFileData         types.String `tfsdk:"formatted_file_data"`
+	Id                        types.String `tfsdk:"id"`
+	IssuerDn                  types.String `tfsdk:"issuer_dn"`
+	KeyAlgorithm              types.String `tfsdk:"key_algorithm"`
+	KeySize                   types.Int64  `tfsdk:"key_size"`
+	PrimaryVerificationCert   types.Bool   `tfsdk:"primary_verification_cert"`
+	SecondaryVerificationCert types.Bool   `tfsdk:"secondary_verification_cert"`
+	SerialNumber              types.String `tfsdk:"serial_number"`
+	Sha1Fingerprint           types.String `tfsdk:"sha1_fingerprint"`
+	Sha256Fingerprint         types.String `tfsdk:"sha256_fingerprint"`
+	SignatureAlgorithm        types.String `tfsdk:"signature_algorithm"`
+	Status                    types.String `tfsdk:"status"`
+	SubjectAlternativeNames   types.Set    `tfsdk:"subject_alternative_names"`
+	SubjectDn                 types.String `tfsdk:"subject_dn"`
+	ValidFrom                 types.String `tfsdk:"valid_from"`
+	Version                   types.Int64  `tfsdk:"version"`
+}
+
+func (r *credentialCertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage a single certificate of an " + r.options.ConnectionName + ", used for signature verification and XML encryption. Certificates managed with this resource are ignored by the `credentials.certs` attribute of the `" + r.options.ConnectionResourceTypeName + "` resource.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: r.options.ConnectionIdDescription + " This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cert_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The persistent, unique ID for the certificate. It can be any combination of `[a-z0-9._-]`. This property is system-assigned if not specified. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					configvalidators.LowercaseId(),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_data": schema.StringAttribute{
+				Required:    true,
+				Description: "The certificate data in PEM format. New line characters should be omitted or encoded in this value. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"formatted_file_data": schema.StringAttribute{
+				Computed:    true,
+				Description: "The certificate data in PEM format, formatted by PingFederate.",
+			},
+			"crypto_provider": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cryptographic Provider. This is only applicable if Hybrid HSM mode is true. Options are `LOCAL` or `HSM`. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"LOCAL",
+						"HSM",
+					),
+				},
+			},
+			"active_verification_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Indicates whether this is an active signature verification certificate. The default value is `false`.",
+			},
+			"primary_verification_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Indicates whether this is the primary signature verification certificate. Only one certificate of the connection can have this flag set. The default value is `false`.",
+			},
+			"secondary_verification_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Indicates whether this is the secondary signature verification certificate. Only one certificate of the connection can have this flag set. The default value is `false`.",
+			},
+			"encryption_cert": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: r.options.EncryptionCertDescription + " Only one certificate of the connection can have this flag set. The default value is `false`.",
+			},
+			"expires": schema.StringAttribute{
+				Computed:    true,
+				Description: "The end date up until which the item is valid, in ISO 8601 format (UTC).",
+			},
+			"issuer_dn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The issuer's distinguished name.",
+			},
+			"key_algorithm": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key algorithm.",
+			},
+			"key_size": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The public key size.",
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The serial number assigned by the CA.",
+			},
+			"sha1_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-1 fingerprint in Hex encoding.",
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 fingerprint in Hex encoding.",
+			},
+			"signature_algorithm": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signature algorithm.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Status of the item.",
+			},
+			"subject_alternative_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The subject alternative names (SAN).",
+			},
+			"subject_dn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject's distinguished name.",
+			},
+			"valid_from": schema.StringAttribute{
+				Computed:    true,
+				Description: "The start date from which the item is valid, in ISO 8601 format (UTC).",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The X.509 version to which the item conforms.",
+			},
+		},
+	}
+	id.ToSchema(&resp.Schema)
+}
+
+func (model *credentialCertResourceModel) buildClientStruct() *client.ConnectionCert {
+	result := client.NewConnectionCert(*client.NewX509File(model.FileData.ValueString()))
+	result.X509File.Id = model.CertId.ValueStringPointer()
+	result.X509File.CryptoProvider = model.CryptoProvider.ValueStringPointer()
+	result.ActiveVerificationCert = model.ActiveVerificationCert.ValueBoolPointer()
+	result.PrimaryVerificationCert = model.PrimaryVerificationCert.ValueBoolPointer()
+	result.SecondaryVerificationCert = model.SecondaryVerificationCert.ValueBoolPointer()
+	result.EncryptionCert = model.EncryptionCert.ValueBoolPointer()
+	return result
+}
+
+func (state *credentialCertResourceModel) readClientResponse(response *client.ConnectionCert, isImportRead bool) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	// id
+	state.Id = types.StringPointerValue(response.X509File.Id)
+	// cert_id
+	state.CertId = types.StringPointerValue(response.X509File.Id)
+	// file_data
+	// PingFederate reformats the certificate data, so the configured value is retained unless importing
+	if isImportRead {
+		state.FileData = types.StringValue(response.X509File.FileData)
+	}
+	// formatted_file_data
+	state.FormattedFileData = types.StringValue(response.X509File.FileData)
+	// crypto_provider
+	state.CryptoProvider = types.StringPointerValue(response.X509File.CryptoProvider)
+	// active_verification_cert
+	state.ActiveVerificationCert = types.BoolValue(response.GetActiveVerificationCert())
+	// primary_verification_cert
+	state.PrimaryVerificationCert = types.BoolValue(response.GetPrimaryVerificationCert())
+	// secondary_verification_cert
+	state.SecondaryVerificationCert = types.BoolValue(response.GetSecondaryVerificationCert())
+	// encryption_cert
+	state.EncryptionCert = types.BoolValue(response.GetEncryptionCert())
+
+	certView := response.CertView
+	if certView == nil {
+		certView = &client.CertView{}
+	}
+	// expires
+	state.Expires = types.StringNull()
+	if certView.Expires != nil {
+		state.Expires = types.StringValue(certView.Expires.Format(time.RFC3339))
+	}
+	// issuer_dn
+	state.IssuerDn = types.StringPointerValue(certView.IssuerDN)
+	// key_algorithm
+	state.KeyAlgorithm = types.StringPointerValue(certView.KeyAlgorithm)
+	// key_size
+	state.KeySize = types.Int64PointerValue(certView.KeySize)
+	// serial_number
+	state.SerialNumber = types.StringPointerValue(certView.SerialNumber)
+	// sha1_fingerprint
+	state.Sha1Fingerprint = types.StringPointerValue(certView.Sha1Fingerprint)
+	// sha256_fingerprint
+	state.Sha256Fingerprint = types.StringPointerValue(certView.Sha256Fingerprint)
+	// signature_algorithm
+	state.SignatureAlgorithm = types.StringPointerValue(certView.SignatureAlgorithm)
+	// status
+	state.Status = types.StringPointerValue(certView.Status)
+	// subject_alternative_names
+	state.SubjectAlternativeNames, diags = types.SetValueFrom(context.Background(), types.StringType, certView.SubjectAlternativeNames)
+	respDiags.Append(diags...)
+	// subject_dn
+	state.SubjectDn = types.StringPointerValue(certView.SubjectDN)
+	// valid_from
+	state.ValidFrom = types.StringNull()
+	if certView.ValidFrom != nil {
+		state.ValidFrom = types.StringValue(certView.ValidFrom.Format(time.RFC3339))
+	}
+	// version
+	state.Version = types.Int64PointerValue(certView.Version)
+	return respDiags
+}
+
+// Find the certificate with the given ID in the list of the connection's certificates
+func findCert(certs []client.ConnectionCert, certId string) *client.ConnectionCert {
+	for i, cert := range certs {
+		if cert.X509File.Id != nil && *cert.X509File.Id == certId {
+			return &certs[i]
+		}
+	}
+	return nil
+}
+
+func (r *credentialCertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data credentialCertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer LockCredentials(r.options.ConnectionType, data.ConnectionId.ValueString())()
+
+	// Create API call logic
+	responseData, httpResp, err := r.options.AddCert(config.AuthContext(ctx, r.providerConfig), r.apiClient, data.ConnectionId.ValueString(), *data.buildClientStruct())
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while adding the "+r.options.ConnectionName+" certificate", err, httpResp, &certIdCustomId)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData, false)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *credentialCertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	isImportRead, diags := importprivatestate.IsImportRead(ctx, req, resp)
+	resp.Diagnostics.Append(diags...)
+
+	var data credentialCertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	responseData, httpResp, err := r.options.GetCerts(config.AuthContext(ctx, r.providerConfig), r.apiClient, data.ConnectionId.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, r.options.ConnectionName, httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while reading the "+r.options.ConnectionName+" certificates", err, httpResp, &certIdCustomId)
+		}
+		return
+	}
+
+	cert := findCert(responseData.Items, data.CertId.ValueString())
+	if cert == nil {
+		config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, r.options.ConnectionName+" Credential Cert", nil)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(cert, isImportRead)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *credentialCertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data credentialCertResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer LockCredentials(r.options.ConnectionType, data.ConnectionId.ValueString())()
+
+	// Update API call logic
+	authCtx := config.AuthContext(ctx, r.providerConfig)
+	certs, httpResp, err := r.options.GetCerts(authCtx, r.apiClient, data.ConnectionId.ValueString())
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while reading the "+r.options.ConnectionName+" certificates", err, httpResp, &certIdCustomId)
+		return
+	}
+	cert := findCert(certs.Items, data.CertId.ValueString())
+	if cert == nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "The certificate with ID '"+data.CertId.ValueString()+"' was not found in the "+r.options.ConnectionName+" '"+data.ConnectionId.ValueString()+"'. It may have been removed outside of Terraform.")
+		return
+	}
+	cert.ActiveVerificationCert = data.ActiveVerificationCert.ValueBoolPointer()
+	cert.PrimaryVerificationCert = data.PrimaryVerificationCert.ValueBoolPointer()
+	cert.SecondaryVerificationCert = data.SecondaryVerificationCert.ValueBoolPointer()
+	cert.EncryptionCert = data.EncryptionCert.ValueBoolPointer()
+
+	responseData, httpResp, err := r.options.UpdateCerts(authCtx, r.apiClient, data.ConnectionId.ValueString(), *certs)
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while updating the "+r.options.ConnectionName+" certificate", err, httpResp, &certIdCustomId)
+		return
+	}
+	cert = findCert(responseData.Items, data.CertId.ValueString())
+	if cert == nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "The certificate with ID '"+data.CertId.ValueString()+"' was not returned by PingFederate after updating the "+r.options.ConnectionName+" certificates.")
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(cert, false)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *credentialCertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data credentialCertResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer LockCredentials(r.options.ConnectionType, data.ConnectionId.ValueString())()
+
+	// Delete API call logic
+	authCtx := config.AuthContext(ctx, r.providerConfig)
+	certs, httpResp, err := r.options.GetCerts(authCtx, r.apiClient, data.ConnectionId.ValueString())
+	if err != nil {
+		if httpResp == nil || httpResp.StatusCode != 404 {
+			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while reading the "+r.options.ConnectionName+" certificates", err, httpResp, &certIdCustomId)
+		}
+		return
+	}
+
+	remainingCerts := []client.ConnectionCert{}
+	for _, cert := range certs.Items {
+		if cert.X509File.Id == nil || *cert.X509File.Id != data.CertId.ValueString() {
+			remainingCerts = append(remainingCerts, cert)
+		}
+	}
+	if len(remainingCerts) == len(certs.Items) {
+		// The certificate has already been removed
+		return
+	}
+	certs.Items = remainingCerts
+
+	_, httpResp, err = r.options.UpdateCerts(authCtx, r.apiClient, data.ConnectionId.ValueString(), *certs)
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while removing the "+r.options.ConnectionName+" certificate", err, httpResp, &certIdCustomId)
+	}
+}
+
+func (r *credentialCertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	split := strings.Split(req.ID, "/")
+	if len(split) != 2 {
+		resp.Diagnostics.AddError(providererror.InvalidResourceIdForImport, "Expected [connection_id]/[cert_id]. Got: "+req.ID)
+		return
+	}
+	// Set the required attributes to read the resource
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), split[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cert_id"), split[1])...)
+	importprivatestate.MarkPrivateStateForImport(ctx, resp)
+}
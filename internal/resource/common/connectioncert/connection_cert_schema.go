@@ -27,6 +27,14 @@ func ToSchema(description string) schema.ListNestedAttribute {
 	}
 }
 
+// ToSchemaOptionalComputed returns the certs schema for resources that can populate the certificates themselves,
+// such as from the partner's SAML metadata
+func ToSchemaOptionalComputed(description string) schema.ListNestedAttribute {
+	certsSchema := ToSchema(description)
+	certsSchema.Computed = true
+	return certsSchema
+}
+
 func toSchemaAttributes() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"cert_view": schema.SingleNestedAttribute{
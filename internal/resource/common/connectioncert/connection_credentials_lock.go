@@ -0,0 +1,28 @@
+package connectioncert
+
+import (
+	"sync"
+)
+
+var (
+	credentialsMutexesLock sync.Mutex
+	credentialsMutexes     = map[string]*sync.Mutex{}
+)
+
+// LockCredentials locks the credentials of a connection, returning a function to unlock them. The certificates
+// of a connection can only be updated by replacing the entire list, and the connection resources retain the
+// certificates and signing settings they don't manage by reading them before updating the connection, so these
+// read-modify-write changes to the same connection are made one at a time. The connection type is either SP or IDP.
+func LockCredentials(connectionType, connectionId string) (unlock func()) {
+	key := connectionType + "/" + connectionId
+	credentialsMutexesLock.Lock()
+	mutex, ok := credentialsMutexes[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		credentialsMutexes[key] = mutex
+	}
+	credentialsMutexesLock.Unlock()
+
+	mutex.Lock()
+	return mutex.Unlock
+}
@@ -0,0 +1,289 @@
+package connectionmetadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+const (
+	// Timeout when retrieving metadata from a URL
+	metadataUrlTimeout = 30 * time.Second
+	// Maximum size of metadata retrieved from a URL
+	maxMetadataSize = 10 * 1024 * 1024
+)
+
+func AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"xml":              types.StringType,
+		"metadata_url_ref": types.ObjectType{AttrTypes: resourcelink.AttrType()},
+	}
+}
+
+func ToSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"xml": schema.StringAttribute{
+				Description: "The partner's SAML metadata XML. Exactly one of `xml` or `metadata_url_ref` must be specified.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("metadata_url_ref")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"metadata_url_ref": schema.SingleNestedAttribute{
+				Description: "A reference to a `pingfederate_metadata_url` resource. The metadata is retrieved from the URL whenever the connection is planned, using the provider's TLS settings, so changes to the metadata at the URL show as differences in the values populated from it. The signature is verified with the metadata URL's `x509_file` certificate, and a verified signature is required when the metadata URL has `validate_signature` enabled. Exactly one of `xml` or `metadata_url_ref` must be specified.",
+				Optional:    true,
+				Attributes:  resourcelink.ToSchema(),
+			},
+		},
+	}
+}
+
+// IsFromUrl returns true when the metadata attribute refers to a metadata URL. Metadata from a URL can change without
+// any change to the configuration, so it must be retrieved again whenever the connection is planned.
+func IsFromUrl(metadata types.Object) bool {
+	if !internaltypes.IsDefined(metadata) {
+		return false
+	}
+	metadataUrlRef, ok := metadata.Attributes()["metadata_url_ref"].(types.Object)
+	return ok && !metadataUrlRef.IsNull()
+}
+
+// Document is SAML metadata to be converted into a connection
+type Document struct {
+	Xml string
+	// The certificate to verify the metadata signature with, if any
+	VerificationCertificate *string
+	// Whether the metadata must have a verified signature
+	RequireVerifiedSignature bool
+}
+
+// Retrieve SAML metadata from a URL, using the provider's TLS settings
+func getMetadataFromUrl(ctx context.Context, providerConfig internaltypes.ProviderConfiguration, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, metadataUrlTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	var transport http.RoundTripper = http.DefaultTransport
+	if providerConfig.Transport != nil {
+		transport = providerConfig.Transport
+	}
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// GetMetadataUrlDocument retrieves the SAML metadata for a pingfederate_metadata_url. The metadata signature is
+// verified with the certificate configured on the metadata URL, and when the metadata URL has validate_signature
+// enabled a verified signature is required, as it is when PingFederate retrieves the metadata itself.
+func GetMetadataUrlDocument(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, metadataUrlId string, attributePath path.Path) (*Document, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	metadataUrl, httpResp, err := apiClient.MetadataUrlsAPI.GetMetadataUrl(config.AuthContext(ctx, providerConfig), metadataUrlId).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while getting the metadata URL", err, httpResp)
+		return nil, diags
+	}
+
+	// PingFederate validates the signature by default
+	document := &Document{
+		RequireVerifiedSignature: metadataUrl.ValidateSignature == nil || *metadataUrl.ValidateSignature,
+	}
+	if metadataUrl.X509File != nil && metadataUrl.X509File.FileData != "" {
+		document.VerificationCertificate = &metadataUrl.X509File.FileData
+	}
+	if document.RequireVerifiedSignature && document.VerificationCertificate == nil {
+		diags.AddAttributeError(attributePath, providererror.InvalidAttributeConfiguration,
+			fmt.Sprintf("The metadata URL '%s' has validate_signature enabled, but does not have an x509_file certificate to verify the metadata signature with. Set x509_file on the metadata URL, or disable validate_signature.", metadataUrlId))
+		return nil, diags
+	}
+
+	document.Xml, err = getMetadataFromUrl(ctx, providerConfig, metadataUrl.Url)
+	if err != nil {
+		diags.AddAttributeError(attributePath, providererror.InvalidAttributeConfiguration,
+			"Failed to retrieve SAML metadata from "+metadataUrl.Url+": "+err.Error())
+		return nil, diags
+	}
+	return document, diags
+}
+
+// ConvertDocument converts SAML metadata into a connection of the given type. Along with the response, the complete
+// converted connection is returned as JSON, since the client model only includes the fields common to all
+// connection types.
+func ConvertDocument(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, document *Document, connectionType, expectedProtocol string, expectedEntityId *string, attributePath path.Path) (*client.ConvertMetadataResponse, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	convertRequest := client.NewConvertMetadataRequest(connectionType, expectedProtocol, base64.StdEncoding.EncodeToString([]byte(document.Xml)))
+	convertRequest.ExpectedEntityId = expectedEntityId
+	convertRequest.VerificationCertificate = document.VerificationCertificate
+	response, httpResp, err := apiClient.ConnectionMetadataAPI.Convert(config.AuthContext(ctx, providerConfig)).Body(*convertRequest).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &diags, "An error occurred while converting the SAML metadata", err, httpResp)
+		return nil, nil, diags
+	}
+	if document.RequireVerifiedSignature && response.GetSignatureStatus() != "VERIFIED" {
+		diags.AddAttributeError(attributePath, providererror.InvalidAttributeConfiguration,
+			fmt.Sprintf("The SAML metadata signature could not be verified with the metadata URL's certificate. The signature status is '%s'.", response.GetSignatureStatus()))
+		return nil, nil, diags
+	}
+
+	var rawResponse struct {
+		Connection json.RawMessage `json:"connection"`
+	}
+	body, err := io.ReadAll(httpResp.Body)
+	if err == nil {
+		err = json.Unmarshal(body, &rawResponse)
+	}
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to read the converted connection from the response: "+err.Error())
+		return nil, nil, diags
+	}
+	return response, rawResponse.Connection, diags
+}
+
+// Convert retrieves the SAML metadata described by the metadata attribute and converts it into a connection of the
+// given type. The complete converted connection is returned as JSON, so that it can be unmarshaled into the
+// connection type expected by the caller. If the metadata depends on values that are not yet known, nil is returned.
+// The expected protocol defaults to SAML20 when it is not set.
+func Convert(ctx context.Context, apiClient *client.APIClient, providerConfig internaltypes.ProviderConfiguration, metadata types.Object, connectionType string, expectedProtocol, expectedEntityId types.String) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !internaltypes.IsDefined(metadata) || expectedProtocol.IsUnknown() || expectedEntityId.IsUnknown() {
+		return nil, diags
+	}
+
+	document := &Document{}
+	xml := metadata.Attributes()["xml"].(types.String)
+	metadataUrlRef := metadata.Attributes()["metadata_url_ref"].(types.Object)
+	if xml.IsUnknown() || metadataUrlRef.IsUnknown() {
+		return nil, diags
+	}
+	if internaltypes.IsDefined(xml) {
+		document.Xml = xml.ValueString()
+	} else if internaltypes.IsDefined(metadataUrlRef) {
+		metadataUrlId := metadataUrlRef.Attributes()["id"].(types.String)
+		if metadataUrlId.IsUnknown() {
+			return nil, diags
+		}
+		var getDiags diag.Diagnostics
+		document, getDiags = GetMetadataUrlDocument(ctx, apiClient, providerConfig, metadataUrlId.ValueString(), path.Root("metadata").AtName("metadata_url_ref"))
+		diags.Append(getDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	protocol := "SAML20"
+	if internaltypes.IsDefined(expectedProtocol) {
+		protocol = expectedProtocol.ValueString()
+	}
+	var entityId *string
+	if internaltypes.IsDefined(expectedEntityId) {
+		entityId = expectedEntityId.ValueStringPointer()
+	}
+	_, connectionJson, convertDiags := ConvertDocument(ctx, apiClient, providerConfig, document, connectionType, protocol, entityId, path.Root("metadata").AtName("metadata_url_ref"))
+	diags.Append(convertDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(connectionJson) == 0 {
+		diags.AddAttributeError(path.Root("metadata"), providererror.InvalidAttributeConfiguration,
+			"PingFederate did not return a connection for the SAML metadata. Verify that the metadata describes a partner of the expected type.")
+		return nil, diags
+	}
+	return connectionJson, diags
+}
+
+// Certificates from metadata are given an ID derived from the certificate data, so that the ID is known when
+// planning and stays the same as long as the partner's certificate doesn't change
+func certId(fileData string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(fileData)))[:25]
+}
+
+// CertsPlanValue builds the planned value of a connection's certs from the certificates in converted metadata.
+// If the prior state already contains the same certificates, the prior value is reused so that unchanged
+// metadata does not produce a plan difference.
+func CertsPlanValue(certs []client.ConnectionCert, priorCerts attr.Value) (types.List, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	certValues := []attr.Value{}
+	for _, cert := range certs {
+		x509FileValue, diags := types.ObjectValue(connectioncert.X509FileAttrType(), map[string]attr.Value{
+			"id":                  types.StringValue(certId(cert.X509File.FileData)),
+			"file_data":           types.StringValue(cert.X509File.FileData),
+			"formatted_file_data": types.StringUnknown(),
+			"crypto_provider":     types.StringPointerValue(cert.X509File.CryptoProvider),
+		})
+		respDiags.Append(diags...)
+		certValue, diags := types.ObjectValue(connectioncert.AttrTypes(), map[string]attr.Value{
+			"cert_view":                   types.ObjectUnknown(connectioncert.CertViewAttrType()),
+			"x509_file":                   x509FileValue,
+			"active_verification_cert":    types.BoolValue(cert.GetActiveVerificationCert()),
+			"primary_verification_cert":   types.BoolValue(cert.GetPrimaryVerificationCert()),
+			"secondary_verification_cert": types.BoolValue(cert.GetSecondaryVerificationCert()),
+			"encryption_cert":             types.BoolValue(cert.GetEncryptionCert()),
+		})
+		respDiags.Append(diags...)
+		certValues = append(certValues, certValue)
+	}
+
+	priorCertsList, ok := priorCerts.(types.List)
+	if ok && internaltypes.IsDefined(priorCertsList) && len(priorCertsList.Elements()) == len(certValues) {
+		matches := true
+		for i, priorCert := range priorCertsList.Elements() {
+			priorCertAttrs := priorCert.(types.Object).Attributes()
+			planCertAttrs := certValues[i].(types.Object).Attributes()
+			for _, name := range []string{"active_verification_cert", "primary_verification_cert", "secondary_verification_cert", "encryption_cert"} {
+				if !priorCertAttrs[name].Equal(planCertAttrs[name]) {
+					matches = false
+				}
+			}
+			priorX509FileAttrs := priorCertAttrs["x509_file"].(types.Object).Attributes()
+			planX509FileAttrs := planCertAttrs["x509_file"].(types.Object).Attributes()
+			for _, name := range []string{"id", "file_data", "crypto_provider"} {
+				if !priorX509FileAttrs[name].Equal(planX509FileAttrs[name]) {
+					matches = false
+				}
+			}
+		}
+		if matches {
+			return priorCertsList, respDiags
+		}
+	}
+
+	certsValue, diags := types.ListValue(connectioncert.ObjType(), certValues)
+	respDiags.Append(diags...)
+	return certsValue, respDiags
+}
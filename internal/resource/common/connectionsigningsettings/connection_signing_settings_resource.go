@@ -0,0 +1,280 @@
+package connectionsigningsettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource                = &signingSettingsResource{}
+	_ resource.ResourceWithConfigure   = &signingSettingsResource{}
+	_ resource.ResourceWithImportState = &signingSettingsResource{}
+
+	connectionIdCustomId = "connection_id"
+
+	alternativeSigningKeyPairRefsElemType = types.ObjectType{AttrTypes: resourcelink.AttrType()}
+)
+
+// SigningSettingsResourceOptions describes the connection type managed by a signing settings resource
+type SigningSettingsResourceOptions struct {
+	// The resource type name, without the provider prefix
+	TypeName string
+	// The PingFederate connection type, either SP or IDP
+	ConnectionType string
+	// The name of the connection type used in messages, such as "IdP SP Connection"
+	ConnectionName string
+	// The type name of the connection resource, including the provider prefix
+	ConnectionResourceTypeName string
+	ConnectionIdDescription    string
+	GetSigningSettings         func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.SigningSettings, *http.Response, error)
+	UpdateSigningSettings      func(ctx context.Context, apiClient *client.APIClient, connectionId string, signingSettings client.SigningSettings) (*client.SigningSettings, *http.Response, error)
+}
+
+// NewSigningSettingsResource returns a resource that manages the signing settings of a connection
+func NewSigningSettingsResource(options SigningSettingsResourceOptions) resource.Resource {
+	return &signingSettingsResource{
+		options: options,
+	}
+}
+
+type signingSettingsResource struct {
+	options        SigningSettingsResourceOptions
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type signingSettingsResourceModel struct {
+	AlternativeSigningKeyPairRefs types.Set    `tfsdk:"alternative_signing_key_pair_refs"`
+	Algorithm                     types.String `tfsdk:"algorithm"`
+	ConnectionId                  types.String `tfsdk:"connection_id"`
+	Id                            types.String `tfsdk:"id"`
+	IncludeCertInSignature        types.Bool   `tfsdk:"include_cert_in_signature"`
+	IncludeRawKeyInSignature      types.Bool   `tfsdk:"include_raw_key_in_signature"`
+	SigningKeyPairRef             types.Object `tfsdk:"signing_key_pair_ref"`
+}
+
+func (r *signingSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.options.TypeName
+}
+
+func (r *signingSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *signingSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage the settings used to sign messages sent to the partner of an " + r.options.ConnectionName + ". When this resource is used, the `credentials.signing_settings` attribute of the `" + r.options.ConnectionResourceTypeName + "` resource should not be set, and the signing settings are left in place by that resource. Signing settings can't be removed from a connection, so destroying this resource only removes it from Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Required:    true,
+				Description: r.options.ConnectionIdDescription + " This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"signing_key_pair_ref": resourcelink.CompleteSingleNestedAttribute(
+				false,
+				false,
+				true,
+				"A reference to the key pair used to sign messages sent to this partner.",
+			),
+			"alternative_signing_key_pair_refs": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: resourcelink.ToSchema(),
+				},
+				Optional:    true,
+				Description: "The list of IDs of alternative key pairs used to sign messages sent to this partner. The ID of the key pair is also known as the alias and can be found by viewing the corresponding certificate under 'Signing & Decryption Keys & Certificates' in the PingFederate admin console.",
+			},
+			"algorithm": schema.StringAttribute{
+				Optional:    true,
+				Description: "The algorithm used to sign messages sent to this partner. The default is `SHA1withDSA` for DSA certs, `SHA256withRSA` for RSA certs, and `SHA256withECDSA` for EC certs. For RSA certs, `SHA1withRSA`, `SHA384withRSA`, `SHA512withRSA`, `SHA256withRSAandMGF1`, `SHA384withRSAandMGF1` and `SHA512withRSAandMGF1` are also supported. For EC certs, `SHA384withECDSA` and `SHA512withECDSA` are also supported. If the connection is WS-Federation with JWT token type, then the possible values are RSA SHA256, RSA SHA384, RSA SHA512, RSASSA-PSS SHA256, RSASSA-PSS SHA384, RSASSA-PSS SHA512, ECDSA SHA256, ECDSA SHA384, ECDSA SHA512",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"include_cert_in_signature": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Determines whether the signing certificate is included in the signature <KeyInfo> element. The default value is `false`.",
+			},
+			"include_raw_key_in_signature": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Determines whether the <KeyValue> element with the raw public key is included in the signature <KeyInfo> element.",
+			},
+		},
+	}
+	id.ToSchema(&resp.Schema)
+}
+
+func (model *signingSettingsResourceModel) buildClientStruct() (*client.SigningSettings, error) {
+	signingKeyPairRef, err := resourcelink.ClientStruct(model.SigningKeyPairRef)
+	if err != nil {
+		return nil, err
+	}
+	result := client.NewSigningSettings(*signingKeyPairRef)
+	for _, alternativeSigningKeyPairRef := range model.AlternativeSigningKeyPairRefs.Elements() {
+		alternativeSigningKeyPairRefValue, err := resourcelink.ClientStruct(alternativeSigningKeyPairRef.(types.Object))
+		if err != nil {
+			return nil, err
+		}
+		result.AlternativeSigningKeyPairRefs = append(result.AlternativeSigningKeyPairRefs, *alternativeSigningKeyPairRefValue)
+	}
+	result.Algorithm = model.Algorithm.ValueStringPointer()
+	result.IncludeCertInSignature = model.IncludeCertInSignature.ValueBoolPointer()
+	result.IncludeRawKeyInSignature = model.IncludeRawKeyInSignature.ValueBoolPointer()
+	return result, nil
+}
+
+func (state *signingSettingsResourceModel) readClientResponse(response *client.SigningSettings) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	// id
+	state.Id = state.ConnectionId
+	// signing_key_pair_ref
+	state.SigningKeyPairRef, diags = resourcelink.ToState(context.Background(), &response.SigningKeyPairRef)
+	respDiags.Append(diags...)
+	// alternative_signing_key_pair_refs
+	state.AlternativeSigningKeyPairRefs = types.SetNull(alternativeSigningKeyPairRefsElemType)
+	if len(response.AlternativeSigningKeyPairRefs) > 0 {
+		state.AlternativeSigningKeyPairRefs, diags = types.SetValueFrom(context.Background(), alternativeSigningKeyPairRefsElemType, response.AlternativeSigningKeyPairRefs)
+		respDiags.Append(diags...)
+	}
+	// algorithm
+	state.Algorithm = types.StringPointerValue(response.Algorithm)
+	// include_cert_in_signature
+	// PF will return false include_cert_in_signature as nil
+	state.IncludeCertInSignature = types.BoolValue(response.GetIncludeCertInSignature())
+	// include_raw_key_in_signature
+	state.IncludeRawKeyInSignature = types.BoolPointerValue(response.IncludeRawKeyInSignature)
+	return respDiags
+}
+
+// Replace the signing settings of the connection with the planned settings, and read the response into the model.
+// Create and Update both replace the signing settings.
+func (r *signingSettingsResource) putSigningSettings(ctx context.Context, data *signingSettingsResourceModel) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+	clientStruct, err := data.buildClientStruct()
+	if err != nil {
+		respDiags.AddError(providererror.InternalProviderError, "Failed to build the signing settings request: "+err.Error())
+		return respDiags
+	}
+
+	defer connectioncert.LockCredentials(r.options.ConnectionType, data.ConnectionId.ValueString())()
+
+	responseData, httpResp, err := r.options.UpdateSigningSettings(config.AuthContext(ctx, r.providerConfig), r.apiClient, data.ConnectionId.ValueString(), *clientStruct)
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &respDiags, "An error occurred while updating the "+r.options.ConnectionName+" signing settings", err, httpResp, &connectionIdCustomId)
+		return respDiags
+	}
+
+	// Read response into the model
+	respDiags.Append(data.readClientResponse(responseData)...)
+	return respDiags
+}
+
+func (r *signingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data signingSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	resp.Diagnostics.Append(r.putSigningSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *signingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data signingSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	responseData, httpResp, err := r.options.GetSigningSettings(config.AuthContext(ctx, r.providerConfig), r.apiClient, data.ConnectionId.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, r.options.ConnectionName+" Signing Settings", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while reading the "+r.options.ConnectionName+" signing settings", err, httpResp, &connectionIdCustomId)
+		}
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *signingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data signingSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	resp.Diagnostics.Append(r.putSigningSettings(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *signingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Signing settings can't be removed from a connection. Deleting this resource will remove it from Terraform state.
+}
+
+func (r *signingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to connection_id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+}
@@ -0,0 +1,135 @@
+package importid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+)
+
+// Prefixes for import IDs that identify an object by something other than its PingFederate ID
+const (
+	EntityIdPrefix = "entity_id:"
+	NamePrefix     = "name:"
+)
+
+// An object returned by a listing endpoint, with the fields that can be used to identify it on import
+type Object struct {
+	Id       string
+	Name     string
+	EntityId string
+}
+
+// List the objects of the resource type from PingFederate that may match the value of an import ID prefix. Listing
+// endpoints that support it should be filtered by the value, such as with the entityId or filter query parameters,
+// and should read every page of results with ListPages. The filters match partial values, so Resolve checks for an
+// exact match.
+type ListFunc func(ctx context.Context, prefix, value string) ([]Object, *http.Response, error)
+
+// The number of objects requested per page by ListPages
+const PageSize = 100
+
+// List one page of the objects of the resource type that may match the value of an import ID prefix. Pages are
+// numbered from 1.
+type PageFunc func(ctx context.Context, prefix, value string, page, numberPerPage int64) ([]Object, *http.Response, error)
+
+// ListPages builds a ListFunc that reads every page of a paged listing endpoint. A filter can match more objects
+// than fit on one page, and the exact match may not be on the first page.
+func ListPages(listPage PageFunc) ListFunc {
+	return func(ctx context.Context, prefix, value string) ([]Object, *http.Response, error) {
+		var objects []Object
+		seenIds := map[string]bool{}
+		for page := int64(1); ; page++ {
+			pageObjects, httpResp, err := listPage(ctx, prefix, value, page, PageSize)
+			if err != nil {
+				return nil, httpResp, err
+			}
+			newObjects := 0
+			for _, object := range pageObjects {
+				if seenIds[object.Id] {
+					continue
+				}
+				seenIds[object.Id] = true
+				objects = append(objects, object)
+				newObjects++
+			}
+			// Stop on a partial page, or if the endpoint returned the same objects again
+			if len(pageObjects) < PageSize || newObjects == 0 {
+				return objects, httpResp, nil
+			}
+		}
+	}
+}
+
+// Resolve the PingFederate ID for an import ID. Import IDs starting with one of the supported prefixes are looked
+// up with the listing function, and must match exactly one object. Any other import ID is returned unchanged.
+// The returned bool is false if the ID could not be resolved, in which case an error has been added to diags.
+func Resolve(ctx context.Context, importId, resourceType string, list ListFunc, diags *diag.Diagnostics, supportedPrefixes ...string) (string, bool) {
+	prefix, value := splitImportId(importId)
+	if prefix == "" {
+		return importId, true
+	}
+	supported := false
+	for _, supportedPrefix := range supportedPrefixes {
+		if prefix == supportedPrefix {
+			supported = true
+		}
+	}
+	if !supported {
+		diags.AddError("Unsupported import ID",
+			fmt.Sprintf("The %s resource does not support importing by %s. Supported import IDs are the object ID%s.", resourceType, strings.TrimSuffix(prefix, ":"), describePrefixes(supportedPrefixes)))
+		return "", false
+	}
+	if value == "" {
+		diags.AddError("Invalid import ID", fmt.Sprintf("The import ID '%s' must include a value after the '%s' prefix.", importId, prefix))
+		return "", false
+	}
+
+	objects, httpResp, err := list(ctx, prefix, value)
+	if err != nil {
+		config.ReportHttpError(ctx, diags, fmt.Sprintf("An error occurred while listing objects to import the %s resource", resourceType), err, httpResp)
+		return "", false
+	}
+
+	var matchingIds []string
+	for _, object := range objects {
+		if (prefix == NamePrefix && object.Name == value) || (prefix == EntityIdPrefix && object.EntityId == value) {
+			matchingIds = append(matchingIds, object.Id)
+		}
+	}
+	switch len(matchingIds) {
+	case 0:
+		diags.AddError("Object not found for import",
+			fmt.Sprintf("No object found for the %s resource with %s '%s'.", resourceType, strings.TrimSuffix(prefix, ":"), value))
+		return "", false
+	case 1:
+		return matchingIds[0], true
+	default:
+		diags.AddError("Ambiguous import ID",
+			fmt.Sprintf("Multiple objects found for the %s resource with %s '%s', with IDs: %s. Import the object by its ID instead.",
+				resourceType, strings.TrimSuffix(prefix, ":"), value, strings.Join(matchingIds, ", ")))
+		return "", false
+	}
+}
+
+// Split an import ID into one of the known prefixes and the remaining value. PingFederate IDs can't contain
+// a colon, so an ID that doesn't start with a known prefix is returned as the value with an empty prefix.
+func splitImportId(importId string) (string, string) {
+	for _, prefix := range []string{EntityIdPrefix, NamePrefix} {
+		if strings.HasPrefix(importId, prefix) {
+			return prefix, strings.TrimPrefix(importId, prefix)
+		}
+	}
+	return "", importId
+}
+
+func describePrefixes(prefixes []string) string {
+	var result strings.Builder
+	for _, prefix := range prefixes {
+		result.WriteString(fmt.Sprintf(", or '%s<%s>'", prefix, strings.TrimSuffix(prefix, ":")))
+	}
+	return result.String()
+}
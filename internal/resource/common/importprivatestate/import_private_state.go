@@ -20,6 +20,12 @@ func MarkPrivateStateForImport(ctx context.Context, resp *resource.ImportStateRe
 	return resp.Private.SetKey(ctx, importKey, value)
 }
 
+// State moved from another provider only contains the ID, so the following read is handled as an import read
+func MarkPrivateStateForMove(ctx context.Context, resp *resource.MoveStateResponse) diag.Diagnostics {
+	value := []byte(`{"isImport": true}`)
+	return resp.TargetPrivate.SetKey(ctx, importKey, value)
+}
+
 func IsImportRead(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) (bool, diag.Diagnostics) {
 	var respDiags diag.Diagnostics
 	importRead, diags := req.Private.GetKey(ctx, importKey)
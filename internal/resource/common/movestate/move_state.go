@@ -0,0 +1,89 @@
+package movestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+)
+
+// The community PingFederate provider, without the registry hostname
+const communityProviderAddressSuffix = "/iwarapter/pingfederate"
+
+// PingFederate doesn't return the key pair file or the settings used to generate a key pair, so these are copied
+// from the community provider state to avoid replacing moved key pairs
+var KeyPairCopiedAttributes = []string{"file_data", "password", "format", "common_name", "organization", "organization_unit", "city", "state", "country", "valid_days"}
+
+// A resource type in the community PingFederate provider that can be moved to a resource in this provider
+type CommunitySource struct {
+	// Resource type names in the community provider that map to the target resource type
+	TypeNames []string
+	// The target attribute that the PingFederate ID from the source id attribute is saved to
+	IdAttribute string
+	// Attributes copied from the source state because PingFederate doesn't return them on read, such as key pair
+	// file data. Each attribute must have the same name in both providers, and be a string or int64 in the target.
+	CopiedAttributes []string
+}
+
+// FromCommunityProvider returns a state mover for the given community provider resource types. Like an import,
+// only the ID and any copied attributes are moved, and the rest of the state is filled in by the read that follows.
+func FromCommunityProvider(source CommunitySource) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if !strings.HasSuffix(req.SourceProviderAddress, communityProviderAddressSuffix) || !slices.Contains(source.TypeNames, req.SourceTypeName) {
+				// Leave the target state unset, so that other state movers can handle the request
+				return
+			}
+			if req.SourceRawState == nil || len(req.SourceRawState.JSON) == 0 {
+				resp.Diagnostics.AddError("Unable to move resource state",
+					fmt.Sprintf("The source state for %s is missing or in an unsupported format.", req.SourceTypeName))
+				return
+			}
+			var sourceState map[string]any
+			if err := json.Unmarshal(req.SourceRawState.JSON, &sourceState); err != nil {
+				resp.Diagnostics.AddError("Unable to move resource state",
+					fmt.Sprintf("Failed to parse the source state for %s: %s", req.SourceTypeName, err.Error()))
+				return
+			}
+			id, _ := sourceState["id"].(string)
+			if id == "" {
+				resp.Diagnostics.AddError("Unable to move resource state",
+					fmt.Sprintf("The source state for %s does not contain an id.", req.SourceTypeName))
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root(source.IdAttribute), id)...)
+			for _, attribute := range source.CopiedAttributes {
+				copyAttribute(ctx, attribute, sourceState, resp)
+			}
+			resp.Diagnostics.Append(importprivatestate.MarkPrivateStateForMove(ctx, resp)...)
+		},
+	}
+}
+
+// Copy an attribute from the source state. The community provider stores unset attributes as empty strings
+// and zeros, so those are left null in the target state.
+func copyAttribute(ctx context.Context, attribute string, sourceState map[string]any, resp *resource.MoveStateResponse) {
+	attributePath := path.Root(attribute)
+	attributeType, diags := resp.TargetState.Schema.TypeAtPath(ctx, attributePath)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	switch sourceValue := sourceState[attribute].(type) {
+	case string:
+		if sourceValue != "" && attributeType.Equal(types.StringType) {
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, attributePath, sourceValue)...)
+		}
+	case float64:
+		if sourceValue != 0 && attributeType.Equal(types.Int64Type) {
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, attributePath, int64(sourceValue))...)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package ondestroy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+const (
+	ResetToDefault  = "reset_to_default"
+	Retain          = "retain"
+	RestoreOriginal = "restore_original"
+
+	// Private state key for the configuration that was in place before it was managed by terraform
+	originalConfigurationKey = "original_configuration"
+)
+
+// PrivateState is the private state of a resource, from a request or response
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// Default returns the default on_destroy value. Resources that can be reset to a default configuration
+// are reset by default, while other resources retain their configuration.
+func Default(canReset bool) types.String {
+	if canReset {
+		return types.StringValue(ResetToDefault)
+	}
+	return types.StringValue(Retain)
+}
+
+// ToSchema adds the on_destroy attribute to the schema of a singleton resource
+func ToSchema(s *schema.Schema, canReset bool) {
+	options := []string{Retain, RestoreOriginal}
+	description := "The behavior when this resource is destroyed. Options are "
+	if canReset {
+		options = append([]string{ResetToDefault}, options...)
+		description += "`reset_to_default` to reset the configuration to the PingFederate defaults, "
+	}
+	description += fmt.Sprintf("`retain` to leave the configuration in place, or `restore_original` to restore the configuration that was in place when the resource was created or imported. The default value is `%s`.", Default(canReset).ValueString())
+	s.Attributes["on_destroy"] = schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(Default(canReset).ValueString()),
+		Validators: []validator.String{
+			stringvalidator.OneOf(options...),
+		},
+	}
+}
+
+// SaveOriginal stores the configuration read from PingFederate in private state, so that it can be restored on destroy
+func SaveOriginal(ctx context.Context, private PrivateState, original any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	originalBytes, err := json.Marshal(original)
+	if err != nil {
+		diags.AddError(providererror.InternalProviderError, "Failed to save the original configuration to private state: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, originalConfigurationKey, originalBytes)
+}
+
+// Delete applies the on_destroy behavior of a singleton resource. The reset function should be nil for resources
+// that can't be reset to a default configuration. The restore function is called with the configuration saved by SaveOriginal.
+// When on_destroy has no value in state, such as in state written before the attribute was added, the default behavior is applied.
+func Delete[T any](ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, resourceName string,
+	reset func(ctx context.Context) (*http.Response, error), restore func(ctx context.Context, original T) (*http.Response, error)) {
+	var onDestroy types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if onDestroy.IsNull() || onDestroy.IsUnknown() {
+		onDestroy = Default(reset != nil)
+	}
+
+	switch onDestroy.ValueString() {
+	case RestoreOriginal:
+		originalBytes, diags := req.Private.GetKey(ctx, originalConfigurationKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(originalBytes) == 0 {
+			resp.Diagnostics.AddWarning("Original configuration not found",
+				fmt.Sprintf("The %s resource has been destroyed, but the configuration in place before it was created or imported was not saved, so it could not be restored. The resource has been removed from Terraform state but the configuration remains applied to the environment", resourceName))
+			return
+		}
+		var original T
+		err := json.Unmarshal(originalBytes, &original)
+		if err != nil {
+			resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to read the original configuration from private state: "+err.Error())
+			return
+		}
+		httpResp, err := restore(ctx, original)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, fmt.Sprintf("An error occurred while restoring the original configuration of the %s resource", resourceName), err, httpResp)
+		}
+	case ResetToDefault:
+		if reset == nil {
+			providererror.WarnConfigurationCannotBeReset(resourceName, &resp.Diagnostics)
+			return
+		}
+		httpResp, err := reset(ctx)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, fmt.Sprintf("An error occurred while resetting the %s resource", resourceName), err, httpResp)
+		}
+	}
+	// With the retain option, the configuration is left in place and the resource is only removed from state
+}
@@ -0,0 +1,99 @@
+package readmodifywrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	maxAttempts = 5
+	retryDelay  = 2 * time.Second
+)
+
+// The PingFederate endpoints for these lists only support replacing the entire list, with no way to
+// detect concurrent modifications. Updates made by this provider are serialized per list, and each update
+// is verified after it is written so that it can be retried if another client overwrote it.
+var (
+	locksMutex sync.Mutex
+	locks      = map[string]*sync.Mutex{}
+)
+
+var ErrConflict = errors.New("the change was overwritten by a concurrent update")
+
+// Operations for reading and writing an entire list-valued configuration object.
+type Operations[T any] struct {
+	// Unique key for the list, used to serialize updates to it
+	Key string
+	// Get the current list from PingFederate
+	Get func(ctx context.Context) ([]T, *http.Response, error)
+	// Replace the list in PingFederate
+	Put func(ctx context.Context, items []T) (*http.Response, error)
+}
+
+func lockFor(key string) *sync.Mutex {
+	locksMutex.Lock()
+	defer locksMutex.Unlock()
+	lock, ok := locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		locks[key] = lock
+	}
+	return lock
+}
+
+// Update reads the current list, applies modify to it, and writes it back. The applied function is
+// used to verify the change against the list read back after the write. If the change was lost to a
+// concurrent writer, or PingFederate reports a conflict, the whole read-modify-write is retried.
+// The final list is returned on success.
+func Update[T any](ctx context.Context, ops Operations[T], modify func(items []T) ([]T, error), applied func(items []T) bool) ([]T, *http.Response, error) {
+	lock := lockFor(ops.Key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	for attempt := 1; ; attempt++ {
+		current, httpResp, err := ops.Get(ctx)
+		if err != nil {
+			return nil, httpResp, err
+		}
+
+		updated, err := modify(current)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		httpResp, err = ops.Put(ctx, updated)
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusConflict) {
+			return nil, httpResp, err
+		}
+
+		if err == nil {
+			// Verify the change wasn't overwritten by another client
+			current, httpResp, err = ops.Get(ctx)
+			if err != nil {
+				return nil, httpResp, err
+			}
+			if applied(current) {
+				return current, httpResp, nil
+			}
+		}
+
+		if attempt >= maxAttempts {
+			return nil, nil, fmt.Errorf("%w after %d attempts", ErrConflict, attempt)
+		}
+		tflog.Warn(ctx, "Retrying update after a concurrent modification", map[string]interface{}{
+			"key":     ops.Key,
+			"attempt": attempt,
+		})
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(retryDelay):
+		}
+	}
+}
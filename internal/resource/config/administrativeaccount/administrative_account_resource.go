@@ -230,6 +230,7 @@ func readAdministrativeAccountResourceResponse(ctx context.Context, r *client.Ad
 }
 
 func (r *administrativeAccountsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan administrativeAccountResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -290,6 +291,7 @@ func (r *administrativeAccountsResource) Read(ctx context.Context, req resource.
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *administrativeAccountsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan administrativeAccountResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,118 @@
+package administrativeaccountpassword
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+)
+
+var (
+	_ ephemeral.EphemeralResource = &administrativeAccountGeneratedPasswordEphemeralResource{}
+)
+
+// Character sets used for generated passwords. A generated password always includes at least one character from each set.
+var passwordCharacterSets = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"!#%*+-.:=?@^_~",
+}
+
+func AdministrativeAccountGeneratedPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &administrativeAccountGeneratedPasswordEphemeralResource{}
+}
+
+type administrativeAccountGeneratedPasswordEphemeralResource struct{}
+
+type administrativeAccountGeneratedPasswordEphemeralResourceModel struct {
+	Length   types.Int64  `tfsdk:"length"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *administrativeAccountGeneratedPasswordEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_account_generated_password"
+}
+
+func (r *administrativeAccountGeneratedPasswordEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral resource to generate a random password for a native administrative account, for use as the write-only `new_password` of the `pingfederate_administrative_account_password` resource. A new password is generated each time the ephemeral resource is opened, and it is never stored in Terraform state or plan files, so store it elsewhere, such as in a secrets manager, if it is needed later.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Description: "The length of the generated password. The default value is `32`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(12, 128),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The generated password. It includes at least one lowercase letter, uppercase letter, digit, and special character.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// Generate a random password containing at least one character from each character set
+func generatePassword(length int) (string, error) {
+	allCharacters := ""
+	for _, characterSet := range passwordCharacterSets {
+		allCharacters += characterSet
+	}
+
+	password := make([]byte, length)
+	for i := range password {
+		characters := allCharacters
+		if i < len(passwordCharacterSets) {
+			characters = passwordCharacterSets[i]
+		}
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = characters[index.Int64()]
+	}
+
+	// Shuffle so the required characters are not always at the start
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func (r *administrativeAccountGeneratedPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data administrativeAccountGeneratedPasswordEphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(32)
+	if !data.Length.IsNull() {
+		length = data.Length.ValueInt64()
+	}
+	data.Length = types.Int64Value(length)
+
+	password, err := generatePassword(int(length))
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to generate a password: "+err.Error())
+		return
+	}
+	data.Password = types.StringValue(password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,216 @@
+package administrativeaccountpassword
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/acctest/common/pointers"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource               = &administrativeAccountPasswordResource{}
+	_ resource.ResourceWithConfigure  = &administrativeAccountPasswordResource{}
+	_ resource.ResourceWithModifyPlan = &administrativeAccountPasswordResource{}
+)
+
+func AdministrativeAccountPasswordResource() resource.Resource {
+	return &administrativeAccountPasswordResource{}
+}
+
+type administrativeAccountPasswordResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type administrativeAccountPasswordResourceModel struct {
+	Username                  types.String `tfsdk:"username"`
+	NewPassword               types.String `tfsdk:"new_password"`
+	NewPasswordVersion        types.String `tfsdk:"new_password_version"`
+	RotateProviderCredentials types.Bool   `tfsdk:"rotate_provider_credentials"`
+	RotationTriggerValues     types.Map    `tfsdk:"rotation_trigger_values"`
+}
+
+func (r *administrativeAccountPasswordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_administrative_account_password"
+}
+
+func (r *administrativeAccountPasswordResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *administrativeAccountPasswordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to reset or change the password of a native administrative account. The password is set when the resource is created and whenever `new_password_version` or the rotation trigger values change. The new password is write-only, so it is never stored in the Terraform plan or state. This resource requires Terraform 1.11 or later. If the account is also managed with the `pingfederate_administrative_account` resource, add `encrypted_password` to its `ignore_changes` lifecycle setting.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Description: "The username of the administrative account. This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_password": schema.StringAttribute{
+				Description: "The new password for the account. This value is write-only and is never stored in the Terraform plan or state, so changes to it are not detected. Change `new_password_version` or the rotation trigger values to set the password again. Use the `pingfederate_administrative_account_generated_password` ephemeral resource to generate a random password.",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"new_password_version": schema.StringAttribute{
+				Description: "A non-secret value identifying the version of `new_password`. Changing this value sets the password again, using the current value of `new_password`. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_provider_credentials": schema.BoolAttribute{
+				Description: "Set to `true` to change the password of the account the provider itself is authenticating with, using the provider's current password. `username` must match the provider `username`, and the provider must be using basic authentication. Subsequent requests made by the provider during the same run will authenticate with the new password. The provider configuration must be updated with the new password before the next run. The default value is `false`. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_trigger_values": schema.MapAttribute{
+				Description: "A meta-argument map of values that, if any values are changed, will force rotation of the password. Adding values to and removing values from the map will not trigger a rotation. This parameter can be used to control time-based rotation using Terraform.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Rotate the password via RequiresReplace when the trigger values change
+func (r *administrativeAccountPasswordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	var planValues, stateValues map[string]attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_trigger_values"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues = plan.Elements()
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_trigger_values"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateValues = state.Elements()
+
+	for k, v := range planValues {
+		if stateValue, ok := stateValues[k]; ok && (v == types.StringUnknown() || !stateValue.Equal(v)) {
+			resp.RequiresReplace = path.Paths{path.Root("rotation_trigger_values")}
+			break
+		}
+	}
+}
+
+func (r *administrativeAccountPasswordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data administrativeAccountPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotateProviderCredentials := data.RotateProviderCredentials.ValueBool()
+	if rotateProviderCredentials && (r.providerConfig.Username == nil || r.providerConfig.Password == nil) {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_provider_credentials"), providererror.InvalidAttributeConfiguration,
+			"rotate_provider_credentials can only be set to true when the provider is using basic authentication")
+		return
+	}
+	if rotateProviderCredentials && *r.providerConfig.Username != data.Username.ValueString() {
+		resp.Diagnostics.AddAttributeError(path.Root("username"), providererror.InvalidAttributeConfiguration,
+			"username must match the provider username when rotate_provider_credentials is set to true")
+		return
+	}
+
+	// The write-only password is only available in the configuration
+	var newPasswordValue types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("new_password"), &newPasswordValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newPassword := newPasswordValue.ValueString()
+	data.NewPassword = types.StringNull()
+
+	if rotateProviderCredentials {
+		credentials := client.NewUserCredentials(newPassword)
+		credentials.CurrentPassword = pointers.String(config.ProviderPassword(r.providerConfig))
+		_, httpResp, err := r.apiClient.AdministrativeAccountsAPI.ChangePassword(config.AuthContext(ctx, r.providerConfig)).Body(*credentials).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while changing the password of the provider's administrative account", err, httpResp)
+			return
+		}
+		config.SetProviderPassword(r.providerConfig, newPassword)
+	} else {
+		_, httpResp, err := r.apiClient.AdministrativeAccountsAPI.ResetPassword(config.AuthContext(ctx, r.providerConfig), data.Username.ValueString()).Body(*client.NewUserCredentials(newPassword)).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the administrative account password", err, httpResp)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *administrativeAccountPasswordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// PingFederate never returns passwords, so we'll just maintain whatever is in state
+	resp.State.Raw = req.State.Raw
+}
+
+func (r *administrativeAccountPasswordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This will only happen when adding or removing rotation trigger values. The password is only set
+	// on create, so just copy the planned values into state.
+	var plan administrativeAccountPasswordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.NewPassword = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *administrativeAccountPasswordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// There is no way to restore the previous password
+	providererror.WarnConfigurationCannotBeReset("pingfederate_administrative_account_password", &resp.Diagnostics)
+}
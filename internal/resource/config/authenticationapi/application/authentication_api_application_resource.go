@@ -145,6 +145,7 @@ func addOptionalAuthenticationApiApplicationFields(ctx context.Context, addReque
 }
 
 func (r *authenticationApiApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan authenticationApiApplicationModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -216,6 +217,7 @@ func (r *authenticationApiApplicationResource) Read(ctx context.Context, req res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationApiApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan authenticationApiApplicationModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -2,12 +2,15 @@ package authenticationapisettings
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -33,6 +36,11 @@ type authenticationApiSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type authenticationApiSettingsResourceModel struct {
+	authenticationApiSettingsModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *authenticationApiSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -70,6 +78,7 @@ func (r *authenticationApiSettingsResource) Schema(ctx context.Context, req reso
 		},
 	}
 
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -122,18 +131,34 @@ func (m *authenticationApiSettingsModel) buildDefaultClientStruct() *client.Auth
 	}
 }
 
+func (r *authenticationApiSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.AuthenticationApiAPI.GetAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original authentication API settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *authenticationApiSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan authenticationApiSettingsModel
+	var plan authenticationApiSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// Get the current state to see how any attributes are changing
 	updateAuthenticationApiSettings := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewAuthnApiSettings()
-	err := addAuthenticationApiSettingsFields(ctx, createUpdateRequest, plan)
+	err := addAuthenticationApiSettingsFields(ctx, createUpdateRequest, plan.authenticationApiSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for the authentication API settings: "+err.Error())
 		return
@@ -147,8 +172,10 @@ func (r *authenticationApiSettingsResource) Create(ctx context.Context, req reso
 	}
 
 	// Read the response
-	var state authenticationApiSettingsModel
-	diags = readAuthenticationApiSettingsResponse(ctx, updateAuthenticationApiSettingsResponse, &state)
+	state := authenticationApiSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	diags = readAuthenticationApiSettingsResponse(ctx, updateAuthenticationApiSettingsResponse, &state.authenticationApiSettingsModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Update computed values
@@ -157,7 +184,7 @@ func (r *authenticationApiSettingsResource) Create(ctx context.Context, req reso
 }
 
 func (r *authenticationApiSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state authenticationApiSettingsModel
+	var state authenticationApiSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -176,7 +203,7 @@ func (r *authenticationApiSettingsResource) Read(ctx context.Context, req resour
 	}
 
 	// Read the response into the state
-	diags = readAuthenticationApiSettingsResponse(ctx, apiReadAuthenticationApiSettings, &state)
+	diags = readAuthenticationApiSettingsResponse(ctx, apiReadAuthenticationApiSettings, &state.authenticationApiSettingsModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
@@ -186,7 +213,8 @@ func (r *authenticationApiSettingsResource) Read(ctx context.Context, req resour
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationApiSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan authenticationApiSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan authenticationApiSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -195,7 +223,7 @@ func (r *authenticationApiSettingsResource) Update(ctx context.Context, req reso
 	// Get the current state to see how any attributes are changing
 	updateAuthenticationApiSettings := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewAuthnApiSettings()
-	err := addAuthenticationApiSettingsFields(ctx, createUpdateRequest, plan)
+	err := addAuthenticationApiSettingsFields(ctx, createUpdateRequest, plan.authenticationApiSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for the authentication API settings: "+err.Error())
 		return
@@ -209,8 +237,10 @@ func (r *authenticationApiSettingsResource) Update(ctx context.Context, req reso
 	}
 
 	// Read the response
-	var state authenticationApiSettingsModel
-	diags = readAuthenticationApiSettingsResponse(ctx, updateAuthenticationApiSettingsResponse, &state)
+	state := authenticationApiSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	diags = readAuthenticationApiSettingsResponse(ctx, updateAuthenticationApiSettingsResponse, &state.authenticationApiSettingsModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Update computed values
@@ -222,20 +252,28 @@ func (r *authenticationApiSettingsResource) Update(ctx context.Context, req reso
 // This config object is edit-only, so Terraform can't delete it.
 func (r *authenticationApiSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model authenticationApiSettingsModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the authentication API settings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_authentication_api_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			var model authenticationApiSettingsModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.AuthnApiSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.AuthenticationApiAPI.UpdateAuthenticationApiSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *authenticationApiSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState authenticationApiSettingsModel
+	var emptyState authenticationApiSettingsResourceModel
 	emptyState.DefaultApplicationRef = types.ObjectNull(resourcelink.AttrType())
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -3,6 +3,7 @@ package authenticationpolicies
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -19,6 +20,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/authenticationpolicytreenode"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -52,10 +54,11 @@ var (
 )
 
 type authenticationPoliciesModel struct {
-	AuthnSelectionTrees          types.List `tfsdk:"authn_selection_trees"`
-	DefaultAuthenticationSources types.List `tfsdk:"default_authentication_sources"`
-	FailIfNoSelection            types.Bool `tfsdk:"fail_if_no_selection"`
-	TrackedHttpParameters        types.Set  `tfsdk:"tracked_http_parameters"`
+	AuthnSelectionTrees          types.List   `tfsdk:"authn_selection_trees"`
+	DefaultAuthenticationSources types.List   `tfsdk:"default_authentication_sources"`
+	FailIfNoSelection            types.Bool   `tfsdk:"fail_if_no_selection"`
+	TrackedHttpParameters        types.Set    `tfsdk:"tracked_http_parameters"`
+	OnDestroy                    types.String `tfsdk:"on_destroy"`
 }
 
 // authenticationPoliciesResource is a helper function to simplify the provider implementation.
@@ -152,6 +155,7 @@ func (r *authenticationPoliciesResource) Schema(ctx context.Context, req resourc
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -286,7 +290,17 @@ func addOptionalAuthenticationPolicyFields(addRequest *client.AuthenticationPoli
 	return nil
 }
 
+func (r *authenticationPoliciesResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.GetDefaultAuthenticationPolicy(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Authentication Policies", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *authenticationPoliciesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan, state authenticationPoliciesModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -295,6 +309,12 @@ func (r *authenticationPoliciesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	newPolicy := client.NewAuthenticationPolicy()
 	err := addOptionalAuthenticationPolicyFields(newPolicy, plan)
 	if err != nil {
@@ -310,6 +330,7 @@ func (r *authenticationPoliciesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	state.OnDestroy = plan.OnDestroy
 	diags = readAuthenticationPoliciesResponse(ctx, policyResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -347,6 +368,7 @@ func (r *authenticationPoliciesResource) Read(ctx context.Context, req resource.
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan, state authenticationPoliciesModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -370,6 +392,7 @@ func (r *authenticationPoliciesResource) Update(ctx context.Context, req resourc
 	}
 
 	// Read the response
+	state.OnDestroy = plan.OnDestroy
 	readResponseDiags := readAuthenticationPoliciesResponse(ctx, updateResponse, &state)
 	resp.Diagnostics.Append(readResponseDiags...)
 
@@ -379,14 +402,18 @@ func (r *authenticationPoliciesResource) Update(ctx context.Context, req resourc
 }
 
 func (r *authenticationPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// This delete will remove all authentication policies.
-	authPoliciesClientData := client.NewAuthenticationPolicy()
-	authPoliciesApiUpdateRequest := r.apiClient.AuthenticationPoliciesAPI.UpdateDefaultAuthenticationPolicy(config.AuthContext(ctx, r.providerConfig))
-	authPoliciesApiUpdateRequest = authPoliciesApiUpdateRequest.Body(*authPoliciesClientData)
-	_, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.UpdateDefaultAuthenticationPolicyExecute(authPoliciesApiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the Authentication Policies", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will remove all authentication policies, leave them in place,
+	// or restore the policies saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_authentication_policies",
+		func(ctx context.Context) (*http.Response, error) {
+			authPoliciesClientData := client.NewAuthenticationPolicy()
+			_, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.UpdateDefaultAuthenticationPolicy(config.AuthContext(ctx, r.providerConfig)).Body(*authPoliciesClientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.AuthenticationPolicy) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.UpdateDefaultAuthenticationPolicy(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *authenticationPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -395,5 +422,9 @@ func (r *authenticationPoliciesResource) ImportState(ctx context.Context, req re
 	emptyState.AuthnSelectionTrees = types.ListNull(types.ObjectType{AttrTypes: authnSelectionTreesAttrTypes})
 	emptyState.DefaultAuthenticationSources = types.ListNull(types.ObjectType{AttrTypes: defaultAuthenticationSourcesAttrTypes})
 	emptyState.TrackedHttpParameters = types.SetNull(types.StringType)
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
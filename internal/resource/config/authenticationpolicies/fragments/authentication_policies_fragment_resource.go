@@ -134,6 +134,7 @@ func addOptionalAuthenticationPoliciesFragmentFields(addRequest *client.Authenti
 }
 
 func (r *authenticationPoliciesFragmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan authenticationPoliciesFragmentModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -196,6 +197,7 @@ func (r *authenticationPoliciesFragmentResource) Read(ctx context.Context, req r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationPoliciesFragmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan authenticationPoliciesFragmentModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
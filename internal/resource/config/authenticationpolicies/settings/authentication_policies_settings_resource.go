@@ -2,11 +2,15 @@ package authenticationpoliciessettings
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -29,6 +33,11 @@ type authenticationPoliciesSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type authenticationPoliciesSettingsResourceModel struct {
+	authenticationPoliciesSettingsModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *authenticationPoliciesSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -48,6 +57,7 @@ func (r *authenticationPoliciesSettingsResource) Schema(ctx context.Context, req
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -72,8 +82,18 @@ func (r *authenticationPoliciesSettingsResource) Configure(_ context.Context, re
 
 }
 
+func (r *authenticationPoliciesSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.GetAuthenticationPolicySettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original authentication policies settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *authenticationPoliciesSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan authenticationPoliciesSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan authenticationPoliciesSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -81,8 +101,14 @@ func (r *authenticationPoliciesSettingsResource) Create(ctx context.Context, req
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createAuthenticationPoliciesSettings := client.NewAuthenticationPoliciesSettings()
-	addOptionalAuthenticationPoliciesSettingsFields(createAuthenticationPoliciesSettings, plan)
+	addOptionalAuthenticationPoliciesSettingsFields(createAuthenticationPoliciesSettings, plan.authenticationPoliciesSettingsModel)
 
 	apiCreateAuthenticationPoliciesSettings := r.apiClient.AuthenticationPoliciesAPI.UpdateAuthenticationPolicySettings(config.AuthContext(ctx, r.providerConfig))
 	apiCreateAuthenticationPoliciesSettings = apiCreateAuthenticationPoliciesSettings.Body(*createAuthenticationPoliciesSettings)
@@ -93,15 +119,16 @@ func (r *authenticationPoliciesSettingsResource) Create(ctx context.Context, req
 	}
 
 	// Read the response into the state
-	var state authenticationPoliciesSettingsModel
-
-	readAuthenticationPoliciesSettingsResponse(authenticationPoliciesSettingsResponse, &state)
+	state := authenticationPoliciesSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readAuthenticationPoliciesSettingsResponse(authenticationPoliciesSettingsResponse, &state.authenticationPoliciesSettingsModel)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *authenticationPoliciesSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state authenticationPoliciesSettingsModel
+	var state authenticationPoliciesSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -121,7 +148,7 @@ func (r *authenticationPoliciesSettingsResource) Read(ctx context.Context, req r
 	}
 
 	// Read the response into the state
-	readAuthenticationPoliciesSettingsResponse(apiReadAuthenticationPoliciesSettings, &state)
+	readAuthenticationPoliciesSettingsResponse(apiReadAuthenticationPoliciesSettings, &state.authenticationPoliciesSettingsModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -130,8 +157,9 @@ func (r *authenticationPoliciesSettingsResource) Read(ctx context.Context, req r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationPoliciesSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
-	var plan authenticationPoliciesSettingsModel
+	var plan authenticationPoliciesSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -139,11 +167,12 @@ func (r *authenticationPoliciesSettingsResource) Update(ctx context.Context, req
 	}
 
 	// Get the current state to see how any attributes are changing
-	var state authenticationPoliciesSettingsModel
+	var state authenticationPoliciesSettingsResourceModel
 	req.State.Get(ctx, &state)
+	state.OnDestroy = plan.OnDestroy
 	updateAuthenticationPoliciesSettings := r.apiClient.AuthenticationPoliciesAPI.UpdateAuthenticationPolicySettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewAuthenticationPoliciesSettings()
-	addOptionalAuthenticationPoliciesSettingsFields(createUpdateRequest, plan)
+	addOptionalAuthenticationPoliciesSettingsFields(createUpdateRequest, plan.authenticationPoliciesSettingsModel)
 
 	updateAuthenticationPoliciesSettings = updateAuthenticationPoliciesSettings.Body(*createUpdateRequest)
 	updateAuthenticationPoliciesSettingsResponse, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.UpdateAuthenticationPolicySettingsExecute(updateAuthenticationPoliciesSettings)
@@ -153,7 +182,7 @@ func (r *authenticationPoliciesSettingsResource) Update(ctx context.Context, req
 	}
 
 	// Read the response
-	readAuthenticationPoliciesSettingsResponse(updateAuthenticationPoliciesSettingsResponse, &state)
+	readAuthenticationPoliciesSettingsResponse(updateAuthenticationPoliciesSettingsResponse, &state.authenticationPoliciesSettingsModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -162,10 +191,23 @@ func (r *authenticationPoliciesSettingsResource) Update(ctx context.Context, req
 
 // This config object is edit-only, so Terraform can't delete it.
 func (r *authenticationPoliciesSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_authentication_policies_settings",
+		nil,
+		func(ctx context.Context, original client.AuthenticationPoliciesSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.AuthenticationPoliciesAPI.UpdateAuthenticationPolicySettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *authenticationPoliciesSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState authenticationPoliciesSettingsModel
+	var emptyState authenticationPoliciesSettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
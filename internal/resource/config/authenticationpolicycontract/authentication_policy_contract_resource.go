@@ -3,6 +3,7 @@ package authenticationpolicycontract
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,8 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -29,6 +32,7 @@ var (
 	_ resource.Resource                = &authenticationPolicyContractResource{}
 	_ resource.ResourceWithConfigure   = &authenticationPolicyContractResource{}
 	_ resource.ResourceWithImportState = &authenticationPolicyContractResource{}
+	_ resource.ResourceWithMoveState   = &authenticationPolicyContractResource{}
 
 	coreAttributesDefaultObjAttrType = map[string]attr.Type{
 		"name": types.StringType,
@@ -164,6 +168,7 @@ func (r *authenticationPolicyContractResource) Configure(_ context.Context, req
 }
 
 func (r *authenticationPolicyContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan authenticationPolicyContractModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -227,6 +232,7 @@ func (r *authenticationPolicyContractResource) Read(ctx context.Context, req res
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationPolicyContractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan authenticationPolicyContractModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -278,6 +284,30 @@ func (r *authenticationPolicyContractResource) Delete(ctx context.Context, req r
 }
 
 func (r *authenticationPolicyContractResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("contract_id"), req, resp)
+	// Retrieve import ID, looking up the contract by name if requested, and save to contract_id attribute
+	contractId, ok := importid.Resolve(ctx, req.ID, "pingfederate_authentication_policy_contract", importid.ListPages(func(ctx context.Context, _, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.AuthenticationPolicyContractsAPI.GetAuthenticationPolicyContracts(config.AuthContext(ctx, r.providerConfig)).Filter(value).Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, contract := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: contract.GetId(), Name: contract.GetName()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("contract_id"), contractId)...)
+}
+
+func (r *authenticationPolicyContractResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_authentication_policy_contract"},
+			IdAttribute: "contract_id",
+		}),
+	}
 }
@@ -3,6 +3,7 @@ package authenticationselector
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
@@ -201,6 +203,7 @@ func readAuthenticationSelectorsResponse(ctx context.Context, r *client.Authenti
 }
 
 func (r *authenticationSelectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan authenticationSelectorResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -288,6 +291,7 @@ func (r *authenticationSelectorResource) Read(ctx context.Context, req resource.
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *authenticationSelectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan authenticationSelectorResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -357,7 +361,21 @@ func (r *authenticationSelectorResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *authenticationSelectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("selector_id"), req, resp)
+	// Retrieve import ID, looking up the selector by name if requested, and save to selector_id attribute
+	selectorId, ok := importid.Resolve(ctx, req.ID, "pingfederate_authentication_selector", importid.ListPages(func(ctx context.Context, _, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.AuthenticationSelectorsAPI.GetAuthenticationSelectors(config.AuthContext(ctx, r.providerConfig)).Filter(value).Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, selector := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: selector.GetId(), Name: selector.GetName()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("selector_id"), selectorId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
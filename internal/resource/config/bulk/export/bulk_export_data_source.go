@@ -0,0 +1,211 @@
+package bulkexport
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &bulkExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &bulkExportDataSource{}
+
+	operationsAttrTypes = map[string]attr.Type{
+		"resource_type":  types.StringType,
+		"sub_resource":   types.StringType,
+		"operation_type": types.StringType,
+		"item_count":     types.Int64Type,
+	}
+)
+
+// Value used in place of encrypted values when redact_encrypted_values is enabled
+const redactedValue = "REDACTED"
+
+func BulkExportDataSource() datasource.DataSource {
+	return &bulkExportDataSource{}
+}
+
+type bulkExportDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type bulkExportDataSourceModel struct {
+	IncludeExternalResources types.Bool   `tfsdk:"include_external_resources"`
+	RedactEncryptedValues    types.Bool   `tfsdk:"redact_encrypted_values"`
+	PfVersion                types.String `tfsdk:"pf_version"`
+	ExportedJson             types.String `tfsdk:"exported_json"`
+	OperationCounts          types.Map    `tfsdk:"operation_counts"`
+	Operations               types.List   `tfsdk:"operations"`
+}
+
+func (r *bulkExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_export"
+}
+
+func (r *bulkExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *bulkExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to export the PingFederate configuration as a bulk configuration JSON document.",
+		Attributes: map[string]schema.Attribute{
+			"include_external_resources": schema.BoolAttribute{
+				Description: "Include external resources like OAuth clients stored outside of PingFederate. The default value is `false`.",
+				Optional:    true,
+			},
+			"redact_encrypted_values": schema.BoolAttribute{
+				Description: "When set to `true`, the values of any `encrypted*` fields in the exported document (for example `encryptedValue` and `encryptedPassword`) are replaced with `REDACTED`. The redacted document cannot be imported without supplying the replaced values. The default value is `false`.",
+				Optional:    true,
+			},
+			"pf_version": schema.StringAttribute{
+				Description: "The version of PingFederate the configuration was exported from.",
+				Computed:    true,
+			},
+			"exported_json": schema.StringAttribute{
+				Description: "The exported bulk configuration JSON document. The document includes encrypted values unless `redact_encrypted_values` is `true`, so it is marked as sensitive.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"operation_counts": schema.MapAttribute{
+				Description: "The total number of configuration items in the export, keyed by operation type.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"operations": schema.ListNestedAttribute{
+				Description: "A summary of each operation in the export.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Description: "The identifier for the resource type the operation applies to.",
+							Computed:    true,
+						},
+						"sub_resource": schema.StringAttribute{
+							Description: "The subresource for the operation.",
+							Computed:    true,
+						},
+						"operation_type": schema.StringAttribute{
+							Description: "The type of operation to be performed.",
+							Computed:    true,
+						},
+						"item_count": schema.Int64Attribute{
+							Description: "The number of configuration items or item IDs included in the operation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Replace the values of any encrypted fields in the exported items
+func redactEncryptedValues(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			if strings.HasPrefix(key, "encrypted") {
+				if _, isString := fieldValue.(string); isString {
+					v[key] = redactedValue
+					continue
+				}
+			}
+			v[key] = redactEncryptedValues(fieldValue)
+		}
+	case []interface{}:
+		for i, element := range v {
+			v[i] = redactEncryptedValues(element)
+		}
+	}
+	return value
+}
+
+func (state *bulkExportDataSourceModel) readClientResponse(response *client.BulkConfig) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+
+	if state.RedactEncryptedValues.ValueBool() {
+		for _, operation := range response.Operations {
+			for _, item := range operation.Items {
+				redactEncryptedValues(item)
+			}
+		}
+	}
+
+	exportedJson, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		respDiags.AddError(providererror.InternalProviderError, "Failed to marshal the exported bulk configuration: "+err.Error())
+		return respDiags
+	}
+	state.ExportedJson = types.StringValue(string(exportedJson))
+	state.PfVersion = types.StringValue(response.Metadata.PfVersion)
+
+	operationCounts := map[string]attr.Value{}
+	countsByType := map[string]int64{}
+	var operationValues []attr.Value
+	for _, operation := range response.Operations {
+		itemCount := int64(len(operation.Items) + len(operation.ItemIds))
+		countsByType[operation.OperationType] += itemCount
+		operationValue, diags := types.ObjectValue(operationsAttrTypes, map[string]attr.Value{
+			"resource_type":  types.StringValue(operation.ResourceType),
+			"sub_resource":   types.StringPointerValue(operation.SubResource),
+			"operation_type": types.StringValue(operation.OperationType),
+			"item_count":     types.Int64Value(itemCount),
+		})
+		respDiags.Append(diags...)
+		operationValues = append(operationValues, operationValue)
+	}
+	for operationType, count := range countsByType {
+		operationCounts[operationType] = types.Int64Value(count)
+	}
+
+	state.OperationCounts, diags = types.MapValue(types.Int64Type, operationCounts)
+	respDiags.Append(diags...)
+	state.Operations, diags = types.ListValue(types.ObjectType{AttrTypes: operationsAttrTypes}, operationValues)
+	respDiags.Append(diags...)
+	return respDiags
+}
+
+func (r *bulkExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bulkExportDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	exportRequest := r.apiClient.BulkAPI.ExportConfiguration(config.AuthContext(ctx, r.providerConfig))
+	if internaltypes.IsDefined(data.IncludeExternalResources) {
+		exportRequest = exportRequest.IncludeExternalResources(data.IncludeExternalResources.ValueBool())
+	}
+	responseData, httpResp, err := exportRequest.Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while exporting the bulk configuration", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
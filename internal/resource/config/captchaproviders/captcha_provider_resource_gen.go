@@ -4,6 +4,7 @@ package captchaproviders
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -209,6 +211,7 @@ func (state *captchaProviderResourceModel) readClientResponse(response *client.C
 }
 
 func (r *captchaProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data captchaProviderResourceModel
 
 	// Read Terraform plan data into the model
@@ -269,6 +272,7 @@ func (r *captchaProviderResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *captchaProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data captchaProviderResourceModel
 
 	// Read Terraform plan data into the model
@@ -315,7 +319,21 @@ func (r *captchaProviderResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *captchaProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to provider_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("provider_id"), req, resp)
+	// Retrieve import ID, looking up the provider by name if requested, and save to provider_id attribute
+	providerId, ok := importid.Resolve(ctx, req.ID, "pingfederate_captcha_provider", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.CaptchaProvidersAPI.GetCaptchaProviders(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, captchaProvider := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: captchaProvider.GetId(), Name: captchaProvider.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_id"), providerId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -4,6 +4,7 @@ package captchaproviderssettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,8 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
-	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
@@ -47,6 +48,7 @@ func (r *captchaProviderSettingsResource) Configure(_ context.Context, req resou
 
 type captchaProviderSettingsResourceModel struct {
 	DefaultCaptchaProviderRef types.Object `tfsdk:"default_captcha_provider_ref"`
+	OnDestroy                 types.String `tfsdk:"on_destroy"`
 }
 
 func (r *captchaProviderSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -65,6 +67,7 @@ func (r *captchaProviderSettingsResource) Schema(ctx context.Context, req resour
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, false)
 }
 
 func (model *captchaProviderSettingsResourceModel) buildClientStruct() (*client.CaptchaProvidersSettings, diag.Diagnostics) {
@@ -111,7 +114,17 @@ func (r *captchaProviderSettingsResource) emptyModel() captchaProviderSettingsRe
 	return model
 }
 
+func (r *captchaProviderSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.CaptchaProvidersAPI.GetCaptchaProvidersSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original captchaProviderSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *captchaProviderSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data captchaProviderSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -121,6 +134,12 @@ func (r *captchaProviderSettingsResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +188,7 @@ func (r *captchaProviderSettingsResource) Read(ctx context.Context, req resource
 }
 
 func (r *captchaProviderSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data captchaProviderSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -198,11 +218,22 @@ func (r *captchaProviderSettingsResource) Update(ctx context.Context, req resour
 
 func (r *captchaProviderSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_captcha_provider_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_captcha_provider_settings",
+		nil,
+		func(ctx context.Context, original client.CaptchaProvidersSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.CaptchaProvidersAPI.UpdateCaptchaProvidersSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *captchaProviderSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
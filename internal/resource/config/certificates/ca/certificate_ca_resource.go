@@ -207,6 +207,7 @@ func readCertificateResponse(ctx context.Context, r *client.CertView, state *cer
 }
 
 func (r *certificateCAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan certificatesResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
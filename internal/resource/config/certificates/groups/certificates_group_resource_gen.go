@@ -234,6 +234,7 @@ func (state *certificatesGroupResourceModel) readClientResponse(response *client
 }
 
 func (r *certificatesGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data certificatesGroupResourceModel
 
 	// Read Terraform plan data into the model
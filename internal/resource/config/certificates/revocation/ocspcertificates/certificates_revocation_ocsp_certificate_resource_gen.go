@@ -221,6 +221,7 @@ func (state *certificatesRevocationOcspCertificateResourceModel) readClientRespo
 }
 
 func (r *certificatesRevocationOcspCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data certificatesRevocationOcspCertificateResourceModel
 
 	// Read Terraform plan data into the model
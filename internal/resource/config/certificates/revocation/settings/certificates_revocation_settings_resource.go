@@ -2,9 +2,11 @@ package certificatesrevocationsettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/utils"
 )
@@ -28,13 +30,17 @@ func (model *certificatesRevocationSettingsResourceModel) buildDefaultClientStru
 
 func (r *certificatesRevocationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model certificatesRevocationSettingsResourceModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.CertificatesRevocationAPI.UpdateRevocationSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.CertificatesRevocationAPI.UpdateRevocationSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the certificatesRevocationSettings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_certificates_revocation_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			var model certificatesRevocationSettingsResourceModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.CertificatesRevocationAPI.UpdateRevocationSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.CertificateRevocationSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.CertificatesRevocationAPI.UpdateRevocationSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
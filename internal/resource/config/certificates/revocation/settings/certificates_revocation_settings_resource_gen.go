@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -53,6 +54,7 @@ type certificatesRevocationSettingsResourceModel struct {
 	CrlSettings   types.Object `tfsdk:"crl_settings"`
 	OcspSettings  types.Object `tfsdk:"ocsp_settings"`
 	ProxySettings types.Object `tfsdk:"proxy_settings"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
 }
 
 func (r *certificatesRevocationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -182,6 +184,7 @@ func (r *certificatesRevocationSettingsResource) Schema(ctx context.Context, req
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *certificatesRevocationSettingsResourceModel) buildClientStruct() (*client.CertificateRevocationSettings, diag.Diagnostics) {
@@ -356,7 +359,17 @@ func (r *certificatesRevocationSettingsResource) emptyModel() certificatesRevoca
 	return model
 }
 
+func (r *certificatesRevocationSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.CertificatesRevocationAPI.GetRevocationSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original certificate revocation settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *certificatesRevocationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data certificatesRevocationSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -366,6 +379,12 @@ func (r *certificatesRevocationSettingsResource) Create(ctx context.Context, req
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -414,6 +433,7 @@ func (r *certificatesRevocationSettingsResource) Read(ctx context.Context, req r
 }
 
 func (r *certificatesRevocationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data certificatesRevocationSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -444,5 +464,9 @@ func (r *certificatesRevocationSettingsResource) Update(ctx context.Context, req
 func (r *certificatesRevocationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
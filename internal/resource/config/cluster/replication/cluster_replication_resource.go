@@ -0,0 +1,260 @@
+package clusterreplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource               = &clusterReplicationResource{}
+	_ resource.ResourceWithConfigure  = &clusterReplicationResource{}
+	_ resource.ResourceWithModifyPlan = &clusterReplicationResource{}
+)
+
+// Interval between cluster status checks while waiting for the nodes to sync
+const syncPollInterval = 5 * time.Second
+
+func ClusterReplicationResource() resource.Resource {
+	return &clusterReplicationResource{}
+}
+
+type clusterReplicationResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type clusterReplicationResourceModel struct {
+	ReplicationTriggerValues types.Map    `tfsdk:"replication_trigger_values"`
+	WaitForSync              types.Bool   `tfsdk:"wait_for_sync"`
+	SyncTimeoutSeconds       types.Int64  `tfsdk:"sync_timeout_seconds"`
+	ResultMessage            types.String `tfsdk:"result_message"`
+	LastReplicationTime      types.String `tfsdk:"last_replication_time"`
+}
+
+func (r *clusterReplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_replication"
+}
+
+func (r *clusterReplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *clusterReplicationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to replicate the configuration of the administrative console to all nodes in a PingFederate cluster. The PingFederate server must be running in clustered mode.",
+		Attributes: map[string]schema.Attribute{
+			"replication_trigger_values": schema.MapAttribute{
+				Description: "A meta-argument map of values that, if any values are changed, will force a new replication of the cluster configuration. Adding values to and removing values from the map will not trigger a replication. This parameter can be used to replicate after other resources change, for example by referencing their `id` values.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"wait_for_sync": schema.BoolAttribute{
+				Description: "Set to `true` to wait after replicating until every node in the cluster reports that it is in sync with the administrative console. The default value is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"sync_timeout_seconds": schema.Int64Attribute{
+				Description: "The maximum number of seconds to wait for the cluster nodes to sync when `wait_for_sync` is `true`. The default value is `300`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(300),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"result_message": schema.StringAttribute{
+				Description: "The message returned by PingFederate when the configuration was replicated.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_replication_time": schema.StringAttribute{
+				Description: "The time of the last cluster replication, as reported by the cluster status after replicating.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Replicate the configuration via RequiresReplace when the trigger values change
+func (r *clusterReplicationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	var planValues, stateValues map[string]attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("replication_trigger_values"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues = plan.Elements()
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("replication_trigger_values"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateValues = state.Elements()
+
+	for k, v := range planValues {
+		if stateValue, ok := stateValues[k]; ok && (v == types.StringUnknown() || !stateValue.Equal(v)) {
+			resp.RequiresReplace = path.Paths{path.Root("replication_trigger_values")}
+			break
+		}
+	}
+}
+
+// OutOfSyncNodes returns a description of any nodes that are not yet in sync, or an empty string if the cluster is in sync.
+// Returns an error if any node reports that replication has failed.
+func OutOfSyncNodes(status *client.ClusterStatus) (string, error) {
+	var pending []string
+	if status.ReplicationRequired != nil && *status.ReplicationRequired {
+		pending = append(pending, "replication is still required")
+	}
+	for _, node := range status.Nodes {
+		nodeDescription := fmt.Sprintf("node %d (%s)", node.GetIndex(), node.GetAddress())
+		if node.ReplicationStatus != nil {
+			switch *node.ReplicationStatus {
+			case "FAILED":
+				return "", fmt.Errorf("replication failed on %s", nodeDescription)
+			case "SUCCEEDED":
+			default:
+				pending = append(pending, fmt.Sprintf("%s has replication status %s", nodeDescription, *node.ReplicationStatus))
+			}
+		}
+		if node.AdminConsoleInfo != nil && node.AdminConsoleInfo.ConfigSyncStatus != nil {
+			switch *node.AdminConsoleInfo.ConfigSyncStatus {
+			case "FAILED":
+				return "", fmt.Errorf("configuration sync failed on %s", nodeDescription)
+			case "SUCCEEDED", "NONE":
+			default:
+				pending = append(pending, fmt.Sprintf("%s has config sync status %s", nodeDescription, *node.AdminConsoleInfo.ConfigSyncStatus))
+			}
+		}
+	}
+	return strings.Join(pending, ", "), nil
+}
+
+// Poll the cluster status until every node is in sync or the timeout expires
+func (r *clusterReplicationResource) waitForSync(ctx context.Context, timeout time.Duration, diags *diag.Diagnostics) *client.ClusterStatus {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, httpResp, err := r.apiClient.ClusterAPI.GetClusterStatus(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, diags, "An error occurred while reading the cluster status", err, httpResp)
+			return nil
+		}
+
+		pending, err := OutOfSyncNodes(status)
+		if err != nil {
+			diags.AddError("Cluster replication failed", err.Error())
+			return nil
+		}
+		if pending == "" {
+			return status
+		}
+
+		if time.Now().Add(syncPollInterval).After(deadline) {
+			diags.AddError("Timed out waiting for cluster replication",
+				fmt.Sprintf("The cluster nodes did not sync within %s: %s", timeout.String(), pending))
+			return nil
+		}
+
+		tflog.Debug(ctx, "Waiting for cluster nodes to sync: "+pending)
+		select {
+		case <-ctx.Done():
+			diags.AddError("Cluster replication was interrupted", ctx.Err().Error())
+			return nil
+		case <-time.After(syncPollInterval):
+		}
+	}
+}
+
+func (r *clusterReplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data clusterReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.apiClient.ClusterAPI.StartReplication(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while replicating the cluster configuration", err, httpResp)
+		return
+	}
+	data.ResultMessage = types.StringPointerValue(responseData.Message)
+	data.LastReplicationTime = types.StringNull()
+
+	if data.WaitForSync.ValueBool() {
+		status := r.waitForSync(ctx, time.Duration(data.SyncTimeoutSeconds.ValueInt64())*time.Second, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if status.LastReplicationTime != nil {
+			data.LastReplicationTime = types.StringValue(status.LastReplicationTime.Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *clusterReplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Replication is an action rather than a configuration object, so we'll just maintain whatever is in state
+	resp.State.Raw = req.State.Raw
+}
+
+func (r *clusterReplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This will only happen when changing the wait settings or adding or removing replication trigger values.
+	// Just copy the existing computed values and the planned values into state.
+	var plan, state clusterReplicationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ResultMessage = state.ResultMessage
+	plan.LastReplicationTime = state.LastReplicationTime
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// A replication can't be undone, so there is nothing to do on delete.
+func (r *clusterReplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -4,6 +4,7 @@ package clustersettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -45,8 +47,9 @@ func (r *clusterSettingsResource) Configure(_ context.Context, req resource.Conf
 }
 
 type clusterSettingsResourceModel struct {
-	ReplicateClientsOnSave     types.Bool `tfsdk:"replicate_clients_on_save"`
-	ReplicateConnectionsOnSave types.Bool `tfsdk:"replicate_connections_on_save"`
+	ReplicateClientsOnSave     types.Bool   `tfsdk:"replicate_clients_on_save"`
+	ReplicateConnectionsOnSave types.Bool   `tfsdk:"replicate_connections_on_save"`
+	OnDestroy                  types.String `tfsdk:"on_destroy"`
 }
 
 func (r *clusterSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -67,6 +70,7 @@ func (r *clusterSettingsResource) Schema(ctx context.Context, req resource.Schem
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *clusterSettingsResourceModel) buildClientStruct() (*client.ClusterSettings, diag.Diagnostics) {
@@ -93,7 +97,17 @@ func (state *clusterSettingsResourceModel) readClientResponse(response *client.C
 	return nil
 }
 
+func (r *clusterSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ClusterAPI.GetClusterSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original cluster settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *clusterSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data clusterSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -103,6 +117,12 @@ func (r *clusterSettingsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -151,6 +171,7 @@ func (r *clusterSettingsResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *clusterSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data clusterSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -179,21 +200,27 @@ func (r *clusterSettingsResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *clusterSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// This resource is singleton, so it can't be deleted from the service.
-	// Instead this delete method will attempt to set the resource to its default state on the service. If this isn't possible,
-	// this method can be replaced with a no-op with a diagnostic warning message about being unable to set to the default state.
-	// Update API call logic to reset to default
-	defaultClientData := r.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.ClusterAPI.UpdateClusterSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*defaultClientData)
-	_, httpResp, err := r.apiClient.ClusterAPI.UpdateClusterSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the clusterSettings", err, httpResp)
-	}
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_cluster_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			defaultClientData := r.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.ClusterAPI.UpdateClusterSettings(config.AuthContext(ctx, r.providerConfig)).Body(*defaultClientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.ClusterSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ClusterAPI.UpdateClusterSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *clusterSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState clusterSettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,129 @@
+package configarchiveexport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &configArchiveExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &configArchiveExportDataSource{}
+)
+
+func ConfigArchiveExportDataSource() datasource.DataSource {
+	return &configArchiveExportDataSource{}
+}
+
+type configArchiveExportDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type configArchiveExportDataSourceModel struct {
+	OutputPath   types.String `tfsdk:"output_path"`
+	OutputSha256 types.String `tfsdk:"output_sha256"`
+	OutputSize   types.Int64  `tfsdk:"output_size"`
+}
+
+func (r *configArchiveExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_archive_export"
+}
+
+func (r *configArchiveExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *configArchiveExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to export a PingFederate configuration archive to a local ZIP file.",
+		Attributes: map[string]schema.Attribute{
+			"output_path": schema.StringAttribute{
+				Description: "The local path the configuration archive ZIP file will be written to. Any existing file at this path will be overwritten.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"output_sha256": schema.StringAttribute{
+				Description: "The hex-encoded SHA-256 checksum of the exported configuration archive.",
+				Computed:    true,
+			},
+			"output_size": schema.Int64Attribute{
+				Description: "The size of the exported configuration archive, in bytes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Write the exported archive to the output path, returning the checksum and size of the written file
+func writeArchive(outputPath string, archive io.Reader) (string, int64, error) {
+	// #nosec G304
+	file, err := os.OpenFile(filepath.Clean(outputPath), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", 0, err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), archive)
+	if err != nil {
+		_ = file.Close()
+		return "", 0, err
+	}
+	// Closing flushes the file, so report any failure rather than returning the checksum of an incomplete archive
+	if err := file.Close(); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func (r *configArchiveExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data configArchiveExportDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	httpResp, err := r.apiClient.ConfigArchiveAPI.ExportConfigArchive(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while exporting the configuration archive", err, httpResp)
+		return
+	}
+	defer httpResp.Body.Close()
+
+	// Write the archive to the requested location
+	checksum, size, err := writeArchive(data.OutputPath.ValueString(), httpResp.Body)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("output_path"), providererror.InternalProviderError, "Failed to write the exported configuration archive: "+err.Error())
+		return
+	}
+	data.OutputSha256 = types.StringValue(checksum)
+	data.OutputSize = types.Int64Value(size)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,229 @@
+package configarchiveimport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource                   = &configArchiveImportResource{}
+	_ resource.ResourceWithConfigure      = &configArchiveImportResource{}
+	_ resource.ResourceWithModifyPlan     = &configArchiveImportResource{}
+	_ resource.ResourceWithValidateConfig = &configArchiveImportResource{}
+)
+
+func ConfigArchiveImportResource() resource.Resource {
+	return &configArchiveImportResource{}
+}
+
+type configArchiveImportResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type configArchiveImportResourceModel struct {
+	FilePath               types.String `tfsdk:"file_path"`
+	ConfirmOverwrite       types.Bool   `tfsdk:"confirm_overwrite"`
+	ForceImport            types.Bool   `tfsdk:"force_import"`
+	ForceUnsupportedImport types.Bool   `tfsdk:"force_unsupported_import"`
+	ReEncrypt              types.Bool   `tfsdk:"re_encrypt"`
+	ImportTriggerValues    types.Map    `tfsdk:"import_trigger_values"`
+	ResultMessage          types.String `tfsdk:"result_message"`
+}
+
+func (r *configArchiveImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_archive_import"
+}
+
+func (r *configArchiveImportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *configArchiveImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to import a configuration archive into PingFederate. Importing an archive replaces the entire configuration of the PingFederate server, including any configuration managed by other Terraform resources. This resource is intended for restoring archives into lower environments, and should be used with care.",
+		Attributes: map[string]schema.Attribute{
+			"file_path": schema.StringAttribute{
+				Description: "The local path of the configuration archive ZIP file to import. This field is immutable and will trigger a new import if changed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_overwrite": schema.BoolAttribute{
+				Description: "Must be set to `true` to acknowledge that importing the archive will overwrite the existing PingFederate configuration.",
+				Required:    true,
+			},
+			"force_import": schema.BoolAttribute{
+				Description: "Set to `true` to force the import when there are missing components or license inconsistencies. The necessary files can then be installed after the import. The default value is `false`. This field is immutable and will trigger a new import if changed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_unsupported_import": schema.BoolAttribute{
+				Description: "Set to `true` to force the import of an archive exported from an unsupported version of PingFederate. The default value is `false`. This field is immutable and will trigger a new import if changed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"re_encrypt": schema.BoolAttribute{
+				Description: "Set to `true` to re-encrypt the configuration archive data with the current deployment's encryption key. The default value is `false`. This field is immutable and will trigger a new import if changed.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"import_trigger_values": schema.MapAttribute{
+				Description: "A meta-argument map of values that, if any values are changed, will force a new import of the configuration archive. Adding values to and removing values from the map will not trigger an import. This parameter can be used to re-import the archive when its contents change, for example by including `filesha256(file_path)`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"result_message": schema.StringAttribute{
+				Description: "The message returned by PingFederate when the archive was imported.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *configArchiveImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var confirmOverwrite types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("confirm_overwrite"), &confirmOverwrite)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !confirmOverwrite.IsUnknown() && !confirmOverwrite.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm_overwrite"),
+			providererror.InvalidAttributeConfiguration,
+			"confirm_overwrite must be set to true to import a configuration archive, which will overwrite the existing PingFederate configuration")
+	}
+}
+
+// Import the archive again via RequiresReplace when the trigger values change
+func (r *configArchiveImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	var planValues, stateValues map[string]attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("import_trigger_values"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues = plan.Elements()
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("import_trigger_values"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateValues = state.Elements()
+
+	for k, v := range planValues {
+		if stateValue, ok := stateValues[k]; ok && (v == types.StringUnknown() || !stateValue.Equal(v)) {
+			resp.RequiresReplace = path.Paths{path.Root("import_trigger_values")}
+			break
+		}
+	}
+}
+
+func (r *configArchiveImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data configArchiveImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// #nosec G304
+	archiveFile, err := os.Open(filepath.Clean(data.FilePath.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), providererror.InvalidAttributeConfiguration, "Failed to open the configuration archive file: "+err.Error())
+		return
+	}
+	defer archiveFile.Close()
+
+	importRequest := r.apiClient.ConfigArchiveAPI.ImportConfigArchive(config.AuthContext(ctx, r.providerConfig))
+	importRequest = importRequest.File(archiveFile)
+	importRequest = importRequest.ForceImport(data.ForceImport.ValueBool())
+	importRequest = importRequest.ForceUnsupportedImport(data.ForceUnsupportedImport.ValueBool())
+	importRequest = importRequest.ReencryptData(data.ReEncrypt.ValueBool())
+	responseData, httpResp, err := importRequest.Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while importing the configuration archive", err, httpResp)
+		return
+	}
+
+	data.ResultMessage = types.StringPointerValue(responseData.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *configArchiveImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// PingFederate provides no read endpoint for this resource, so we'll just maintain whatever is in state
+	resp.State.Raw = req.State.Raw
+}
+
+func (r *configArchiveImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This will only happen when changing confirm_overwrite or adding or removing import trigger values.
+	// Just copy the existing result message and the planned values into state.
+	var plan, state configArchiveImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ResultMessage = state.ResultMessage
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *configArchiveImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// There is no way to undo an archive import
+	providererror.WarnConfigurationCannotBeReset("pingfederate_config_archive_import", &resp.Diagnostics)
+}
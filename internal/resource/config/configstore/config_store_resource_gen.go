@@ -165,6 +165,7 @@ func (state *configStoreResourceModel) readClientResponse(response *client.Confi
 }
 
 func (r *configStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data configStoreResourceModel
 
 	// Read Terraform plan data into the model
@@ -222,6 +223,7 @@ func (r *configStoreResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *configStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data configStoreResourceModel
 
 	// Read Terraform plan data into the model
@@ -161,6 +161,7 @@ func (r *configurationEncryptionKeysRotateResource) emptyModel() configurationEn
 }
 
 func (r *configurationEncryptionKeysRotateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var state configurationEncryptionKeysRotateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,555 @@
+package connectionmetadataconvert
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &connectionMetadataConvertDataSource{}
+	_ datasource.DataSourceWithConfigure = &connectionMetadataConvertDataSource{}
+
+	x509FileAttrTypes = map[string]attr.Type{
+		"id":              types.StringType,
+		"file_data":       types.StringType,
+		"crypto_provider": types.StringType,
+	}
+	certsAttrTypes = map[string]attr.Type{
+		"x509_file":                   types.ObjectType{AttrTypes: x509FileAttrTypes},
+		"active_verification_cert":    types.BoolType,
+		"primary_verification_cert":   types.BoolType,
+		"secondary_verification_cert": types.BoolType,
+		"encryption_cert":             types.BoolType,
+	}
+	credentialsAttrTypes = map[string]attr.Type{
+		"verification_subject_dn": types.StringType,
+		"verification_issuer_dn":  types.StringType,
+		"certs":                   types.ListType{ElemType: types.ObjectType{AttrTypes: certsAttrTypes}},
+	}
+	sloServiceEndpointsElemType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"binding":      types.StringType,
+		"url":          types.StringType,
+		"response_url": types.StringType,
+	}}
+	spSsoServiceEndpointsElemType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"binding":    types.StringType,
+		"url":        types.StringType,
+		"is_default": types.BoolType,
+		"index":      types.Int64Type,
+	}}
+	idpSsoServiceEndpointsElemType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"binding": types.StringType,
+		"url":     types.StringType,
+	}}
+	spBrowserSsoAttrTypes = map[string]attr.Type{
+		"incoming_bindings":     types.SetType{ElemType: types.StringType},
+		"sso_service_endpoints": types.SetType{ElemType: spSsoServiceEndpointsElemType},
+		"slo_service_endpoints": types.SetType{ElemType: sloServiceEndpointsElemType},
+	}
+	idpBrowserSsoAttrTypes = map[string]attr.Type{
+		"incoming_bindings":     types.SetType{ElemType: types.StringType},
+		"sso_service_endpoints": types.SetType{ElemType: idpSsoServiceEndpointsElemType},
+		"slo_service_endpoints": types.SetType{ElemType: sloServiceEndpointsElemType},
+	}
+)
+
+func ConnectionMetadataConvertDataSource() datasource.DataSource {
+	return &connectionMetadataConvertDataSource{}
+}
+
+type connectionMetadataConvertDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type connectionMetadataConvertDataSourceModel struct {
+	ConnectionType          types.String `tfsdk:"connection_type"`
+	ExpectedProtocol        types.String `tfsdk:"expected_protocol"`
+	ExpectedEntityId        types.String `tfsdk:"expected_entity_id"`
+	SamlMetadata            types.String `tfsdk:"saml_metadata"`
+	MetadataUrlRef          types.Object `tfsdk:"metadata_url_ref"`
+	VerificationCertificate types.String `tfsdk:"verification_certificate"`
+	SignatureStatus         types.String `tfsdk:"signature_status"`
+	CertTrustStatus         types.String `tfsdk:"cert_trust_status"`
+	CertSubjectDn           types.String `tfsdk:"cert_subject_dn"`
+	CertSerialNumber        types.String `tfsdk:"cert_serial_number"`
+	CertExpiration          types.String `tfsdk:"cert_expiration"`
+	EntityId                types.String `tfsdk:"entity_id"`
+	Name                    types.String `tfsdk:"name"`
+	BaseUrl                 types.String `tfsdk:"base_url"`
+	LoggingMode             types.String `tfsdk:"logging_mode"`
+	Credentials             types.Object `tfsdk:"credentials"`
+	SpBrowserSso            types.Object `tfsdk:"sp_browser_sso"`
+	IdpBrowserSso           types.Object `tfsdk:"idp_browser_sso"`
+	ConnectionJson          types.String `tfsdk:"connection_json"`
+}
+
+func (r *connectionMetadataConvertDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_metadata_convert"
+}
+
+func (r *connectionMetadataConvertDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *connectionMetadataConvertDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to convert a partner's SAML metadata into a connection that can be used with the `pingfederate_idp_sp_connection` and `pingfederate_sp_idp_connection` resources.",
+		Attributes: map[string]schema.Attribute{
+			"connection_type": schema.StringAttribute{
+				Description: "The type of connection to convert the metadata into. Use `SP` for metadata describing a service provider partner, for use with `pingfederate_idp_sp_connection`. Use `IDP` for metadata describing an identity provider partner, for use with `pingfederate_sp_idp_connection`. Options are `IDP` or `SP`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("IDP", "SP"),
+				},
+			},
+			"expected_protocol": schema.StringAttribute{
+				Description: "The expected SAML protocol of the metadata. Options are `SAML20`, `SAML11`, `SAML10`. The default value is `SAML20`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("SAML20", "SAML11", "SAML10"),
+				},
+			},
+			"expected_entity_id": schema.StringAttribute{
+				Description: "The expected entity ID of the connection. Required if the metadata contains more than one entity.",
+				Optional:    true,
+			},
+			"saml_metadata": schema.StringAttribute{
+				Description: "The SAML metadata XML. Exactly one of `saml_metadata` or `metadata_url_ref` must be specified.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("saml_metadata"), path.MatchRoot("metadata_url_ref")),
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"metadata_url_ref": schema.SingleNestedAttribute{
+				Description: "A reference to a `pingfederate_metadata_url` resource to retrieve the SAML metadata from. The metadata is retrieved by the provider, using the provider's TLS settings. The signature is verified with the metadata URL's `x509_file` certificate, and a verified signature is required when the metadata URL has `validate_signature` enabled. Exactly one of `saml_metadata` or `metadata_url_ref` must be specified.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the resource.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"verification_certificate": schema.StringAttribute{
+				Description: "The certificate used to verify the signature of the metadata, in PEM format or base64-encoded DER format. Defaults to the `x509_file` certificate of the metadata URL when `metadata_url_ref` is set. Otherwise, the signature is verified against the certificate embedded in the metadata, if any.",
+				Optional:    true,
+			},
+			"signature_status": schema.StringAttribute{
+				Description: "The status of the metadata signature. Options are `SIGNED`, `UNSIGNED`, `UNVERIFIED`, `VERIFIED`.",
+				Computed:    true,
+			},
+			"cert_trust_status": schema.StringAttribute{
+				Description: "The trust status of the certificate used to sign the metadata. Options are `NOT_TRUSTED`, `TRUSTED`.",
+				Computed:    true,
+			},
+			"cert_subject_dn": schema.StringAttribute{
+				Description: "The subject DN of the certificate used to sign the metadata.",
+				Computed:    true,
+			},
+			"cert_serial_number": schema.StringAttribute{
+				Description: "The serial number of the certificate used to sign the metadata.",
+				Computed:    true,
+			},
+			"cert_expiration": schema.StringAttribute{
+				Description: "The expiration date of the certificate used to sign the metadata.",
+				Computed:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "The partner's entity ID, for the connection `entity_id` attribute.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The connection name, for the connection `name` attribute.",
+				Computed:    true,
+			},
+			"base_url": schema.StringAttribute{
+				Description: "The fully-qualified hostname and port on which the partner's federation deployment runs, for the connection `base_url` attribute.",
+				Computed:    true,
+			},
+			"logging_mode": schema.StringAttribute{
+				Description: "The level of transaction logging, for the connection `logging_mode` attribute.",
+				Computed:    true,
+			},
+			"credentials": schema.SingleNestedAttribute{
+				Description: "The certificates and verification settings from the metadata, in the shape of the connection `credentials` attribute.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"verification_subject_dn": schema.StringAttribute{
+						Description: "The subject DN of a certificate to verify signatures with.",
+						Computed:    true,
+					},
+					"verification_issuer_dn": schema.StringAttribute{
+						Description: "The issuer DN of a certificate to verify signatures with.",
+						Computed:    true,
+					},
+					"certs": schema.ListNestedAttribute{
+						Description: "The certificates used for signature verification and XML encryption.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"x509_file": schema.SingleNestedAttribute{
+									Description: "The certificate file.",
+									Computed:    true,
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Description: "The persistent, unique ID for the certificate.",
+											Computed:    true,
+										},
+										"file_data": schema.StringAttribute{
+											Description: "The certificate data in PEM format.",
+											Computed:    true,
+										},
+										"crypto_provider": schema.StringAttribute{
+											Description: "Cryptographic Provider.",
+											Computed:    true,
+										},
+									},
+								},
+								"active_verification_cert": schema.BoolAttribute{
+									Description: "Indicates whether this is an active signature verification certificate.",
+									Computed:    true,
+								},
+								"primary_verification_cert": schema.BoolAttribute{
+									Description: "Indicates whether this is the primary signature verification certificate.",
+									Computed:    true,
+								},
+								"secondary_verification_cert": schema.BoolAttribute{
+									Description: "Indicates whether this is the secondary signature verification certificate.",
+									Computed:    true,
+								},
+								"encryption_cert": schema.BoolAttribute{
+									Description: "Indicates whether to use this cert to encrypt outgoing assertions.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"sp_browser_sso": schema.SingleNestedAttribute{
+				Description: "The browser SSO settings from the metadata when `connection_type` is `SP`, in the shape of the `pingfederate_idp_sp_connection` `sp_browser_sso` attributes of the same names.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"incoming_bindings": schema.SetAttribute{
+						Description: "The SAML bindings that are enabled for browser-based SSO.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"sso_service_endpoints": schema.SetNestedAttribute{
+						Description: "The SAML SP SSO service endpoints.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"binding": schema.StringAttribute{
+									Description: "The binding of this endpoint.",
+									Computed:    true,
+								},
+								"url": schema.StringAttribute{
+									Description: "The absolute or relative URL of the endpoint.",
+									Computed:    true,
+								},
+								"is_default": schema.BoolAttribute{
+									Description: "Whether or not this endpoint is used by default.",
+									Computed:    true,
+								},
+								"index": schema.Int64Attribute{
+									Description: "The priority of the endpoint.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"slo_service_endpoints": sloServiceEndpointsSchema(),
+				},
+			},
+			"idp_browser_sso": schema.SingleNestedAttribute{
+				Description: "The browser SSO settings from the metadata when `connection_type` is `IDP`, in the shape of the `pingfederate_sp_idp_connection` `idp_browser_sso` attributes of the same names.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"incoming_bindings": schema.SetAttribute{
+						Description: "The SAML bindings that are enabled for browser-based SSO.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"sso_service_endpoints": schema.SetNestedAttribute{
+						Description: "The IdP SSO endpoints that define where to send your authentication requests.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"binding": schema.StringAttribute{
+									Description: "The binding of this endpoint.",
+									Computed:    true,
+								},
+								"url": schema.StringAttribute{
+									Description: "The absolute or relative URL of the endpoint.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"slo_service_endpoints": sloServiceEndpointsSchema(),
+				},
+			},
+			"connection_json": schema.StringAttribute{
+				Description: "The complete converted connection returned by PingFederate, as a JSON document. This can be decoded with `jsondecode` to access fields not exposed as attributes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func sloServiceEndpointsSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Description: "The SAML SLO service endpoints.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"binding": schema.StringAttribute{
+					Description: "The binding of this endpoint.",
+					Computed:    true,
+				},
+				"url": schema.StringAttribute{
+					Description: "The absolute or relative URL of the endpoint.",
+					Computed:    true,
+				},
+				"response_url": schema.StringAttribute{
+					Description: "The absolute or relative URL to which logout responses are sent.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// Build the browser SSO values from the endpoints and bindings in the converted connection
+func browserSsoValue(attrTypes map[string]attr.Type, incomingBindings []string, ssoServiceEndpoints []map[string]attr.Value, sloServiceEndpoints []client.SloServiceEndpoint) (types.Object, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	incomingBindingsValue, diags := types.SetValueFrom(context.Background(), types.StringType, incomingBindings)
+	respDiags.Append(diags...)
+
+	ssoElemType := attrTypes["sso_service_endpoints"].(types.SetType).ElemType.(types.ObjectType)
+	ssoValues := []attr.Value{}
+	for _, endpoint := range ssoServiceEndpoints {
+		endpointValue, diags := types.ObjectValue(ssoElemType.AttrTypes, endpoint)
+		respDiags.Append(diags...)
+		ssoValues = append(ssoValues, endpointValue)
+	}
+	ssoServiceEndpointsValue, diags := types.SetValue(ssoElemType, ssoValues)
+	respDiags.Append(diags...)
+
+	sloValues := []attr.Value{}
+	for _, endpoint := range sloServiceEndpoints {
+		endpointValue, diags := types.ObjectValue(sloServiceEndpointsElemType.AttrTypes, map[string]attr.Value{
+			"binding":      types.StringPointerValue(endpoint.Binding),
+			"url":          types.StringValue(endpoint.Url),
+			"response_url": types.StringPointerValue(endpoint.ResponseUrl),
+		})
+		respDiags.Append(diags...)
+		sloValues = append(sloValues, endpointValue)
+	}
+	sloServiceEndpointsValue, diags := types.SetValue(sloServiceEndpointsElemType, sloValues)
+	respDiags.Append(diags...)
+
+	value, diags := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"incoming_bindings":     incomingBindingsValue,
+		"sso_service_endpoints": ssoServiceEndpointsValue,
+		"slo_service_endpoints": sloServiceEndpointsValue,
+	})
+	respDiags.Append(diags...)
+	return value, respDiags
+}
+
+// Read the browser SSO settings for the connection type from the complete converted connection
+func (state *connectionMetadataConvertDataSourceModel) readBrowserSso(connectionJson []byte) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	state.SpBrowserSso = types.ObjectNull(spBrowserSsoAttrTypes)
+	state.IdpBrowserSso = types.ObjectNull(idpBrowserSsoAttrTypes)
+	switch state.ConnectionType.ValueString() {
+	case "SP":
+		var connection client.SpConnection
+		if err := json.Unmarshal(connectionJson, &connection); err != nil {
+			respDiags.AddError(providererror.InternalProviderError, "Failed to read the converted connection from the response: "+err.Error())
+			return respDiags
+		}
+		if connection.SpBrowserSso == nil {
+			return respDiags
+		}
+		ssoServiceEndpoints := []map[string]attr.Value{}
+		for _, endpoint := range connection.SpBrowserSso.SsoServiceEndpoints {
+			// PF will return nil for false for the is_default boolean
+			ssoServiceEndpoints = append(ssoServiceEndpoints, map[string]attr.Value{
+				"binding":    types.StringPointerValue(endpoint.Binding),
+				"url":        types.StringValue(endpoint.Url),
+				"is_default": types.BoolValue(endpoint.GetIsDefault()),
+				"index":      types.Int64PointerValue(endpoint.Index),
+			})
+		}
+		state.SpBrowserSso, diags = browserSsoValue(spBrowserSsoAttrTypes, connection.SpBrowserSso.IncomingBindings, ssoServiceEndpoints, connection.SpBrowserSso.SloServiceEndpoints)
+		respDiags.Append(diags...)
+	case "IDP":
+		var connection client.IdpConnection
+		if err := json.Unmarshal(connectionJson, &connection); err != nil {
+			respDiags.AddError(providererror.InternalProviderError, "Failed to read the converted connection from the response: "+err.Error())
+			return respDiags
+		}
+		if connection.IdpBrowserSso == nil {
+			return respDiags
+		}
+		ssoServiceEndpoints := []map[string]attr.Value{}
+		for _, endpoint := range connection.IdpBrowserSso.SsoServiceEndpoints {
+			ssoServiceEndpoints = append(ssoServiceEndpoints, map[string]attr.Value{
+				"binding": types.StringPointerValue(endpoint.Binding),
+				"url":     types.StringValue(endpoint.Url),
+			})
+		}
+		state.IdpBrowserSso, diags = browserSsoValue(idpBrowserSsoAttrTypes, connection.IdpBrowserSso.IncomingBindings, ssoServiceEndpoints, connection.IdpBrowserSso.SloServiceEndpoints)
+		respDiags.Append(diags...)
+	}
+	return respDiags
+}
+
+func (state *connectionMetadataConvertDataSourceModel) readClientResponse(response *client.ConvertMetadataResponse, connectionJson []byte) diag.Diagnostics {
+	var respDiags diag.Diagnostics
+
+	state.SignatureStatus = types.StringPointerValue(response.SignatureStatus)
+	state.CertTrustStatus = types.StringPointerValue(response.CertTrustStatus)
+	state.CertSubjectDn = types.StringPointerValue(response.CertSubjectDn)
+	state.CertSerialNumber = types.StringPointerValue(response.CertSerialNumber)
+	state.CertExpiration = types.StringNull()
+	if response.CertExpiration != nil {
+		state.CertExpiration = types.StringValue(response.CertExpiration.Format(time.RFC3339))
+	}
+
+	state.EntityId = types.StringNull()
+	state.Name = types.StringNull()
+	state.BaseUrl = types.StringNull()
+	state.LoggingMode = types.StringNull()
+	state.Credentials = types.ObjectNull(credentialsAttrTypes)
+	state.SpBrowserSso = types.ObjectNull(spBrowserSsoAttrTypes)
+	state.IdpBrowserSso = types.ObjectNull(idpBrowserSsoAttrTypes)
+	state.ConnectionJson = types.StringNull()
+	if response.Connection == nil || len(connectionJson) == 0 {
+		return respDiags
+	}
+
+	connection := response.Connection
+	state.EntityId = types.StringValue(connection.EntityId)
+	state.Name = types.StringValue(connection.Name)
+	state.BaseUrl = types.StringPointerValue(connection.BaseUrl)
+	state.LoggingMode = types.StringPointerValue(connection.LoggingMode)
+
+	if connection.Credentials != nil {
+		var certValues []attr.Value
+		for _, cert := range connection.Credentials.Certs {
+			x509FileValue, diags := types.ObjectValue(x509FileAttrTypes, map[string]attr.Value{
+				"id":              types.StringPointerValue(cert.X509File.Id),
+				"file_data":       types.StringValue(cert.X509File.FileData),
+				"crypto_provider": types.StringPointerValue(cert.X509File.CryptoProvider),
+			})
+			respDiags.Append(diags...)
+			certValue, diags := types.ObjectValue(certsAttrTypes, map[string]attr.Value{
+				"x509_file":                   x509FileValue,
+				"active_verification_cert":    types.BoolPointerValue(cert.ActiveVerificationCert),
+				"primary_verification_cert":   types.BoolPointerValue(cert.PrimaryVerificationCert),
+				"secondary_verification_cert": types.BoolPointerValue(cert.SecondaryVerificationCert),
+				"encryption_cert":             types.BoolPointerValue(cert.EncryptionCert),
+			})
+			respDiags.Append(diags...)
+			certValues = append(certValues, certValue)
+		}
+		certsValue, diags := types.ListValue(types.ObjectType{AttrTypes: certsAttrTypes}, certValues)
+		respDiags.Append(diags...)
+		state.Credentials, diags = types.ObjectValue(credentialsAttrTypes, map[string]attr.Value{
+			"verification_subject_dn": types.StringPointerValue(connection.Credentials.VerificationSubjectDN),
+			"verification_issuer_dn":  types.StringPointerValue(connection.Credentials.VerificationIssuerDN),
+			"certs":                   certsValue,
+		})
+		respDiags.Append(diags...)
+	}
+
+	// The client model only includes the fields common to all connection types, so read the browser SSO
+	// settings from the complete connection
+	respDiags.Append(state.readBrowserSso(connectionJson)...)
+
+	var connectionValues map[string]interface{}
+	if err := json.Unmarshal(connectionJson, &connectionValues); err != nil {
+		respDiags.AddError(providererror.InternalProviderError, "Failed to read the converted connection from the response: "+err.Error())
+		return respDiags
+	}
+	formattedJson, err := json.MarshalIndent(connectionValues, "", "  ")
+	if err != nil {
+		respDiags.AddError(providererror.InternalProviderError, "Failed to marshal the converted connection: "+err.Error())
+		return respDiags
+	}
+	state.ConnectionJson = types.StringValue(string(formattedJson))
+
+	return respDiags
+}
+
+func (r *connectionMetadataConvertDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data connectionMetadataConvertDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document := &connectionmetadata.Document{
+		Xml: data.SamlMetadata.ValueString(),
+	}
+	if internaltypes.IsDefined(data.MetadataUrlRef) {
+		var diags diag.Diagnostics
+		metadataUrlId := data.MetadataUrlRef.Attributes()["id"].(types.String).ValueString()
+		document, diags = connectionmetadata.GetMetadataUrlDocument(ctx, r.apiClient, r.providerConfig, metadataUrlId, path.Root("metadata_url_ref"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if internaltypes.IsDefined(data.VerificationCertificate) {
+		document.VerificationCertificate = data.VerificationCertificate.ValueStringPointer()
+	}
+
+	expectedProtocol := "SAML20"
+	if internaltypes.IsDefined(data.ExpectedProtocol) {
+		expectedProtocol = data.ExpectedProtocol.ValueString()
+	}
+	responseData, connectionJson, diags := connectionmetadata.ConvertDocument(ctx, r.apiClient, r.providerConfig, document, data.ConnectionType.ValueString(),
+		expectedProtocol, data.ExpectedEntityId.ValueStringPointer(), path.Root("metadata_url_ref"))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData, connectionJson)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
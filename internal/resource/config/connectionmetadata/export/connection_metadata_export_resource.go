@@ -188,6 +188,7 @@ func (model *connectionMetadataResourceModel) buildClientStruct() *client.Export
 }
 
 func (r *connectionMetadataExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data connectionMetadataResourceModel
 
 	// Read Terraform config data into the model
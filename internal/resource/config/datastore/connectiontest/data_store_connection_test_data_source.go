@@ -0,0 +1,305 @@
+package datastoreconnectiontest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &dataStoreConnectionTestDataSource{}
+	_ datasource.DataSourceWithConfigure = &dataStoreConnectionTestDataSource{}
+
+	resultsAttrTypes = map[string]attr.Type{
+		"data_store_id":   types.StringType,
+		"data_store_type": types.StringType,
+		"action_id":       types.StringType,
+		"testable":        types.BoolType,
+		"success":         types.BoolType,
+		"latency_ms":      types.Int64Type,
+		"message":         types.StringType,
+		"error_detail":    types.StringType,
+	}
+)
+
+func DataStoreConnectionTestDataSource() datasource.DataSource {
+	return &dataStoreConnectionTestDataSource{}
+}
+
+type dataStoreConnectionTestDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type dataStoreConnectionTestDataSourceModel struct {
+	DataStoreIds types.Set    `tfsdk:"data_store_ids"`
+	ActionId     types.String `tfsdk:"action_id"`
+	AllSucceeded types.Bool   `tfsdk:"all_succeeded"`
+	Results      types.List   `tfsdk:"results"`
+}
+
+// The subset of the PingFederate error response used to build the error detail for a failed test
+type actionErrorResponse struct {
+	Message          string `json:"message"`
+	ValidationErrors []struct {
+		Message string `json:"message"`
+	} `json:"validationErrors"`
+}
+
+func (r *dataStoreConnectionTestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_store_connection_test"
+}
+
+func (r *dataStoreConnectionTestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *dataStoreConnectionTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to test the connectivity of PingFederate data stores by invoking their connection test actions. The tests run each time the data source is read, so it can be used in a `check` block to verify data stores are reachable after apply.",
+		Attributes: map[string]schema.Attribute{
+			"data_store_ids": schema.SetAttribute{
+				Description: "The IDs of the data stores to test. If not set, all data stores will be tested.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"action_id": schema.StringAttribute{
+				Description: "The ID of the data store action to invoke. If not set, the `testConnection` action of each data store will be invoked, and data stores without that action are reported as not testable.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"all_succeeded": schema.BoolAttribute{
+				Description: "Whether the connection test succeeded for every data store. Data stores that are not testable count as failures, so this is only true when every data store was tested successfully.",
+				Computed:    true,
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "The connection test result for each data store, sorted by data store ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"data_store_id": schema.StringAttribute{
+							Description: "The ID of the data store.",
+							Computed:    true,
+						},
+						"data_store_type": schema.StringAttribute{
+							Description: "The type of the data store, such as `LDAP`, `JDBC`, `CUSTOM` or `PING_ONE_LDAP_GATEWAY`.",
+							Computed:    true,
+						},
+						"action_id": schema.StringAttribute{
+							Description: "The ID of the action that was invoked. Null if no connection test action is available for the data store.",
+							Computed:    true,
+						},
+						"testable": schema.BoolAttribute{
+							Description: "Whether a connection test action is available for the data store. Data stores that are not testable cause `all_succeeded` to be false.",
+							Computed:    true,
+						},
+						"success": schema.BoolAttribute{
+							Description: "Whether the connection test succeeded. Null if the data store is not testable.",
+							Computed:    true,
+						},
+						"latency_ms": schema.Int64Attribute{
+							Description: "The time taken for PingFederate to complete the connection test, in milliseconds.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The message returned by PingFederate for a successful connection test, or the reason the data store is not testable.",
+							Computed:    true,
+						},
+						"error_detail": schema.StringAttribute{
+							Description: "The error detail returned by PingFederate when the connection test failed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Get the common data store fields from the aggregated response
+func baseDataStore(dataStore *client.DataStoreAggregation) client.DataStore {
+	switch {
+	case dataStore.LdapDataStore != nil:
+		return dataStore.LdapDataStore.DataStore
+	case dataStore.JdbcDataStore != nil:
+		return dataStore.JdbcDataStore.DataStore
+	case dataStore.PingOneLdapGatewayDataStore != nil:
+		return dataStore.PingOneLdapGatewayDataStore.DataStore
+	case dataStore.CustomDataStore != nil:
+		return dataStore.CustomDataStore.DataStore
+	}
+	return client.DataStore{}
+}
+
+// The ID of the action PingFederate provides to test the connection of a data store
+const testConnectionActionId = "testConnection"
+
+// Find the connection test action for a data store, or the requested action if an ID is given
+func findTestAction(actions *client.Actions, actionId string) *client.Action {
+	if actionId == "" {
+		actionId = testConnectionActionId
+	}
+	for _, action := range actions.Items {
+		if action.GetId() == actionId {
+			return &action
+		}
+	}
+	return nil
+}
+
+// Build a readable error detail from a failed action invocation
+func actionErrorDetail(err error, httpResp *http.Response) string {
+	var openApiErr *client.GenericOpenAPIError
+	if errors.As(err, &openApiErr) && len(openApiErr.Body()) > 0 {
+		var errorResponse actionErrorResponse
+		if json.Unmarshal(openApiErr.Body(), &errorResponse) == nil {
+			messages := []string{}
+			if errorResponse.Message != "" {
+				messages = append(messages, errorResponse.Message)
+			}
+			for _, validationError := range errorResponse.ValidationErrors {
+				messages = append(messages, validationError.Message)
+			}
+			if len(messages) > 0 {
+				return strings.Join(messages, "\n")
+			}
+		}
+		return string(openApiErr.Body())
+	}
+	if httpResp != nil {
+		return httpResp.Status + ": " + err.Error()
+	}
+	return err.Error()
+}
+
+// Test the connection for a data store. Returns the result, and whether the test failed or could not be run.
+func (r *dataStoreConnectionTestDataSource) testDataStore(ctx context.Context, dataStore client.DataStore, actionId string) (attr.Value, bool, error) {
+	result := map[string]attr.Value{
+		"data_store_id":   types.StringPointerValue(dataStore.Id),
+		"data_store_type": types.StringValue(dataStore.Type),
+		"action_id":       types.StringNull(),
+		"testable":        types.BoolValue(true),
+		"success":         types.BoolValue(false),
+		"latency_ms":      types.Int64Null(),
+		"message":         types.StringNull(),
+		"error_detail":    types.StringNull(),
+	}
+
+	actions, httpResp, err := r.apiClient.DataStoresAPI.GetDataStoresActions(config.AuthContext(ctx, r.providerConfig), dataStore.GetId()).Execute()
+	if err != nil {
+		return nil, false, errors.New(actionErrorDetail(err, httpResp))
+	}
+
+	action := findTestAction(actions, actionId)
+	if action == nil {
+		if actionId != "" {
+			// An action that was requested explicitly must exist
+			result["error_detail"] = types.StringValue("The action " + actionId + " is not available for this data store")
+		} else {
+			// A data store that can't be tested is reported as a failure, since its connection is not verified
+			result["testable"] = types.BoolValue(false)
+			result["success"] = types.BoolNull()
+			result["message"] = types.StringValue("No connection test action is available for this data store")
+		}
+		value, diags := types.ObjectValue(resultsAttrTypes, result)
+		if diags.HasError() {
+			return nil, false, errors.New("failed to build connection test result")
+		}
+		return value, true, nil
+	}
+	result["action_id"] = types.StringPointerValue(action.Id)
+
+	start := time.Now()
+	actionResult, httpResp, err := r.apiClient.DataStoresAPI.InvokeActionWithOptions(config.AuthContext(ctx, r.providerConfig), dataStore.GetId(), action.GetId()).
+		Body(client.ActionOptions{Parameters: []client.ActionParameter{}}).Execute()
+	result["latency_ms"] = types.Int64Value(time.Since(start).Milliseconds())
+	success := err == nil
+	if success {
+		result["message"] = types.StringPointerValue(actionResult.Message)
+	} else {
+		result["error_detail"] = types.StringValue(actionErrorDetail(err, httpResp))
+	}
+	result["success"] = types.BoolValue(success)
+
+	value, diags := types.ObjectValue(resultsAttrTypes, result)
+	if diags.HasError() {
+		return nil, false, errors.New("failed to build connection test result")
+	}
+	return value, !success, nil
+}
+
+func (r *dataStoreConnectionTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataStoreConnectionTestDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var dataStores []client.DataStore
+	if internaltypes.IsDefined(data.DataStoreIds) {
+		for _, dataStoreId := range internaltypes.SetTypeToStringSlice(data.DataStoreIds) {
+			dataStoreResponse, httpResp, err := r.apiClient.DataStoresAPI.GetDataStore(config.AuthContext(ctx, r.providerConfig), dataStoreId).Execute()
+			if err != nil {
+				config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the data store "+dataStoreId, err, httpResp)
+				return
+			}
+			dataStores = append(dataStores, baseDataStore(dataStoreResponse))
+		}
+	} else {
+		dataStoresResponse, httpResp, err := r.apiClient.DataStoresAPI.GetDataStores(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing the data stores", err, httpResp)
+			return
+		}
+		dataStores = dataStoresResponse.Items
+	}
+
+	sort.Slice(dataStores, func(i, j int) bool {
+		return dataStores[i].GetId() < dataStores[j].GetId()
+	})
+
+	allSucceeded := true
+	results := []attr.Value{}
+	for _, dataStore := range dataStores {
+		result, failed, err := r.testDataStore(ctx, dataStore, data.ActionId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("An error occurred while testing the connection for data store "+dataStore.GetId(), err.Error())
+			return
+		}
+		allSucceeded = allSucceeded && !failed
+		results = append(results, result)
+	}
+
+	data.AllSucceeded = types.BoolValue(allSucceeded)
+	var diags diag.Diagnostics
+	data.Results, diags = types.ListValue(types.ObjectType{AttrTypes: resultsAttrTypes}, results)
+	resp.Diagnostics.Append(diags...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
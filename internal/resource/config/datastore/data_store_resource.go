@@ -19,6 +19,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
@@ -31,6 +32,7 @@ var (
 	_ resource.Resource                = &dataStoreResource{}
 	_ resource.ResourceWithConfigure   = &dataStoreResource{}
 	_ resource.ResourceWithImportState = &dataStoreResource{}
+	_ resource.ResourceWithMoveState   = &dataStoreResource{}
 
 	customId = "data_store_id"
 )
@@ -408,6 +410,7 @@ func createDataStore(dataStore client.DataStoreAggregation, dsr *dataStoreResour
 }
 
 func (r *dataStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan dataStoreModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -489,6 +492,7 @@ func updateDataStore(dataStore client.DataStoreAggregation, dsr *dataStoreResour
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dataStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan dataStoreModel
 
@@ -535,3 +539,13 @@ func (r *dataStoreResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("data_store_id"), req, resp)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *dataStoreResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_custom_data_store", "pingfederate_jdbc_data_store", "pingfederate_ldap_data_store"},
+			IdAttribute: "data_store_id",
+		}),
+	}
+}
@@ -3,6 +3,7 @@ package extendedproperties
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -46,7 +48,8 @@ type extendedPropertiesResource struct {
 }
 
 type extendedPropertiesResourceModel struct {
-	Items types.Set `tfsdk:"items"`
+	Items     types.Set    `tfsdk:"items"`
+	OnDestroy types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -81,6 +84,7 @@ func (r *extendedPropertiesResource) Schema(ctx context.Context, req resource.Sc
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -125,7 +129,17 @@ func readExtendedPropertiesResponse(ctx context.Context, r *client.ExtendedPrope
 	return diags
 }
 
+func (r *extendedPropertiesResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ExtendedPropertiesAPI.GetExtendedProperties(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Extended Properties", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *extendedPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan extendedPropertiesResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -134,6 +148,12 @@ func (r *extendedPropertiesResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createExtendedProperties := client.NewExtendedProperties()
 	err := addExtendedPropertiesFields(ctx, createExtendedProperties, plan)
 	if err != nil {
@@ -150,8 +170,9 @@ func (r *extendedPropertiesResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Read the response into the state
-	var state extendedPropertiesResourceModel
-
+	state := extendedPropertiesResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readExtendedPropertiesResponse(ctx, extendedPropertiesResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -189,6 +210,7 @@ func (r *extendedPropertiesResource) Read(ctx context.Context, req resource.Read
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *extendedPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan extendedPropertiesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -213,7 +235,9 @@ func (r *extendedPropertiesResource) Update(ctx context.Context, req resource.Up
 	}
 
 	// Read the response
-	var state extendedPropertiesResourceModel
+	state := extendedPropertiesResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readExtendedPropertiesResponse(ctx, updateExtendedPropertiesResponse, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -224,11 +248,24 @@ func (r *extendedPropertiesResource) Update(ctx context.Context, req resource.Up
 
 // This config object is edit-only, so Terraform can't delete it.
 func (r *extendedPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_extended_properties",
+		nil,
+		func(ctx context.Context, original client.ExtendedProperties) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ExtendedPropertiesAPI.UpdateExtendedProperties(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *extendedPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState extendedPropertiesResourceModel
 	emptyState.Items = types.SetNull(extendedPropertyAttrType)
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
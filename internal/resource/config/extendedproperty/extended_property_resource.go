@@ -0,0 +1,256 @@
+package extendedproperty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/readmodifywrite"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &extendedPropertyResource{}
+	_ resource.ResourceWithConfigure   = &extendedPropertyResource{}
+	_ resource.ResourceWithImportState = &extendedPropertyResource{}
+)
+
+// ExtendedPropertyResource is a helper function to simplify the provider implementation.
+func ExtendedPropertyResource() resource.Resource {
+	return &extendedPropertyResource{}
+}
+
+// extendedPropertyResource is the resource implementation.
+type extendedPropertyResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type extendedPropertyModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	MultiValued types.Bool   `tfsdk:"multi_valued"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *extendedPropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a single Extended Property definition. Other Extended Properties are left unchanged, so this resource can be used by multiple configurations at once. This resource should not be used together with the `pingfederate_extended_properties` resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The property name. This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The property description.",
+				Optional:    true,
+			},
+			"multi_valued": schema.BoolAttribute{
+				Description: "Indicates whether the property should allow multiple values. Default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *extendedPropertyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extended_property"
+}
+
+func (r *extendedPropertyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *extendedPropertyResource) operations() readmodifywrite.Operations[client.ExtendedProperty] {
+	return readmodifywrite.Operations[client.ExtendedProperty]{
+		Key: "extended_properties",
+		Get: func(ctx context.Context) ([]client.ExtendedProperty, *http.Response, error) {
+			response, httpResp, err := r.apiClient.ExtendedPropertiesAPI.GetExtendedProperties(config.AuthContext(ctx, r.providerConfig)).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return response.Items, httpResp, nil
+		},
+		Put: func(ctx context.Context, items []client.ExtendedProperty) (*http.Response, error) {
+			body := client.NewExtendedProperties()
+			body.Items = items
+			_, httpResp, err := r.apiClient.ExtendedPropertiesAPI.UpdateExtendedProperties(config.AuthContext(ctx, r.providerConfig)).Body(*body).Execute()
+			return httpResp, err
+		},
+	}
+}
+
+func (model *extendedPropertyModel) buildClientStruct() client.ExtendedProperty {
+	return client.ExtendedProperty{
+		Name:        model.Name.ValueStringPointer(),
+		Description: model.Description.ValueStringPointer(),
+		MultiValued: model.MultiValued.ValueBoolPointer(),
+	}
+}
+
+func (state *extendedPropertyModel) readClientResponse(response client.ExtendedProperty) {
+	state.Id = types.StringPointerValue(response.Name)
+	state.Name = types.StringPointerValue(response.Name)
+	state.Description = types.StringPointerValue(response.Description)
+	state.MultiValued = types.BoolValue(response.GetMultiValued())
+}
+
+func indexOf(items []client.ExtendedProperty, name string) int {
+	return slices.IndexFunc(items, func(item client.ExtendedProperty) bool {
+		return item.GetName() == name
+	})
+}
+
+// Add or replace this property in the list, and return the resulting property from PingFederate
+func (r *extendedPropertyResource) upsert(ctx context.Context, plan *extendedPropertyModel, allowExisting bool) (*client.ExtendedProperty, *http.Response, error) {
+	desired := plan.buildClientStruct()
+	name := plan.Name.ValueString()
+	items, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []client.ExtendedProperty) ([]client.ExtendedProperty, error) {
+			i := indexOf(items, name)
+			if i < 0 {
+				return append(items, desired), nil
+			}
+			if !allowExisting {
+				return nil, fmt.Errorf("extended property %q already exists. Import it to manage it with this resource", name)
+			}
+			items[i] = desired
+			return items, nil
+		},
+		func(items []client.ExtendedProperty) bool {
+			i := indexOf(items, name)
+			return i >= 0 &&
+				items[i].GetDescription() == desired.GetDescription() &&
+				items[i].GetMultiValued() == desired.GetMultiValued()
+		})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &items[indexOf(items, name)], httpResp, nil
+}
+
+func (r *extendedPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan extendedPropertyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.upsert(ctx, &plan, false)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while adding the extended property", err, httpResp)
+		return
+	}
+
+	plan.readClientResponse(*responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *extendedPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state extendedPropertyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, httpResp, err := r.operations().Get(ctx)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the extended properties", err, httpResp)
+		return
+	}
+
+	i := indexOf(items, state.Name.ValueString())
+	if i < 0 {
+		config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Extended Property", nil)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.readClientResponse(items[i])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *extendedPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan extendedPropertyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.upsert(ctx, &plan, true)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the extended property", err, httpResp)
+		return
+	}
+
+	plan.readClientResponse(*responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes only this extended property, leaving any others in place.
+func (r *extendedPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state extendedPropertyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	_, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []client.ExtendedProperty) ([]client.ExtendedProperty, error) {
+			return slices.DeleteFunc(items, func(item client.ExtendedProperty) bool {
+				return item.GetName() == name
+			}), nil
+		},
+		func(items []client.ExtendedProperty) bool {
+			return indexOf(items, name) < 0
+		})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while removing the extended property", err, httpResp)
+	}
+}
+
+func (r *extendedPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to name attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
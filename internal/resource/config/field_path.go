@@ -0,0 +1,192 @@
+package config
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type schemaTypeContextKey struct{}
+
+// Any schema that can report its framework type, such as the Schema field of tfsdk.Plan
+type schemaWithType interface {
+	Type() attr.Type
+}
+
+// Get a context that carries the type of the given schema. HTTP errors reported with this context
+// will attach PingFederate validation errors to the matching Terraform attribute.
+func WithSchema(ctx context.Context, schema schemaWithType) context.Context {
+	return context.WithValue(ctx, schemaTypeContextKey{}, schema.Type())
+}
+
+// A single step of a PingFederate field path. Either a field name, or a bracketed list index or map key.
+type FieldPathStep struct {
+	Name      string
+	Index     int
+	IsIndex   bool
+	IsBracket bool
+}
+
+// Split a PingFederate field path such as "attributeContract.coreAttributes[2].name" into steps
+func ParseFieldPath(fieldPath string) []FieldPathStep {
+	var steps []FieldPathStep
+	var current strings.Builder
+	flushName := func() {
+		if current.Len() > 0 {
+			steps = append(steps, FieldPathStep{Name: current.String()})
+			current.Reset()
+		}
+	}
+	for i := 0; i < len(fieldPath); i++ {
+		switch fieldPath[i] {
+		case '.':
+			flushName()
+		case '[':
+			flushName()
+			end := strings.IndexByte(fieldPath[i:], ']')
+			if end < 0 {
+				// Malformed path, treat the rest as a name
+				current.WriteString(fieldPath[i:])
+				i = len(fieldPath)
+				continue
+			}
+			key := fieldPath[i+1 : i+end]
+			step := FieldPathStep{IsBracket: true}
+			if index, err := strconv.Atoi(key); err == nil {
+				step.Index = index
+				step.IsIndex = true
+			} else {
+				step.Name = strings.Trim(key, `'"`)
+			}
+			steps = append(steps, step)
+			i += end
+		default:
+			current.WriteByte(fieldPath[i])
+		}
+	}
+	flushName()
+	return steps
+}
+
+// PingFederate field names that the provider names differently than the default conversion in toTerraformIdentifier,
+// mapped to the Terraform attribute name. The renamed name is only used when the object has no attribute with the
+// converted name. Any other renamed attribute can't be matched, so errors on it are reported on the nearest ancestor.
+var fieldNameRenames = map[string]string{
+	"allowUnidentifiedClientROCreds": "allow_unidentified_client_ro_creds",
+	"clientCertChainSSLHeaderName":   "client_cert_chain_ssl_header_name",
+	"clientCertSSLHeaderName":        "client_cert_ssl_header_name",
+	"confirmSlo":                     "confirm_sp_slo",
+	"enableSaml10":                   "enable_saml_1_0",
+	"enableSaml11":                   "enable_saml_1_1",
+	"followLDAPReferrals":            "follow_ldap_referrals",
+	"includeSHashInIdToken":          "include_s_hash_in_id_token",
+	"oAuthAssertionProfiles":         "oauth_assertion_profiles",
+	"saml1xIssuerId":                 "saml_1x_issuer_id",
+	"saml1xSourceId":                 "saml_1x_source_id",
+	"saml20Profile":                  "saml_2_0_profile",
+	"saml2EntityId":                  "saml_2_entity_id",
+	"sha1Fingerprint":                "sha1fingerprint",
+	"sha256Fingerprint":              "sha256fingerprint",
+	"sloSubjectNameIDEncrypted":      "slo_subject_name_id_encrypted",
+	"sloSuccessUrl":                  "sp_slo_success_url",
+	"ssoSuccessUrl":                  "sp_sso_success_url",
+	"x509File":                       "x509file",
+}
+
+// Find the attribute of an object type matching a PingFederate field name. If the object has no attribute with
+// the name, check for a single nested object that does. This covers the polymorphic PingFederate types that the
+// provider wraps in an attribute per type, such as the policy actions in authentication policies.
+func FindObjectAttribute(attrTypes map[string]attr.Type, tfName string) ([]string, attr.Type, bool) {
+	if attrType, ok := attrTypes[tfName]; ok {
+		return []string{tfName}, attrType, true
+	}
+	var found []string
+	var foundType attr.Type
+	matches := 0
+	for wrapperName, wrapperType := range attrTypes {
+		wrapperObject, ok := wrapperType.(attr.TypeWithAttributeTypes)
+		if !ok {
+			continue
+		}
+		if attrType, ok := wrapperObject.AttributeTypes()[tfName]; ok {
+			found = []string{wrapperName, tfName}
+			foundType = attrType
+			matches++
+		}
+	}
+	return found, foundType, matches == 1
+}
+
+// Translate a PingFederate field path to a Terraform attribute path, based on the schema type.
+// The translation stops at the deepest attribute that can be matched, for example at a set attribute, since set
+// elements cannot be addressed by index. Returns false if not even the root attribute can be matched.
+func ToTerraformPath(schemaType attr.Type, fieldPath string, customId *string) (path.Path, bool) {
+	result := path.Empty()
+	current := schemaType
+	for i, step := range ParseFieldPath(fieldPath) {
+		switch currentType := current.(type) {
+		case basetypes.SetTypable:
+			// Set elements are identified by their value, so point at the set itself
+			return result, len(result.Steps()) > 0
+		case basetypes.ListTypable:
+			if !step.IsIndex {
+				return result, len(result.Steps()) > 0
+			}
+			result = result.AtListIndex(step.Index)
+			current = currentType.(attr.TypeWithElementType).ElementType()
+		case basetypes.MapTypable:
+			if step.IsIndex {
+				result = result.AtMapKey(strconv.Itoa(step.Index))
+			} else {
+				// Map keys are not renamed
+				result = result.AtMapKey(step.Name)
+			}
+			current = currentType.(attr.TypeWithElementType).ElementType()
+		case attr.TypeWithAttributeTypes:
+			if step.IsBracket {
+				return result, len(result.Steps()) > 0
+			}
+			tfName := toTerraformIdentifier(step.Name)
+			if i == 0 && customId != nil && step.Name == "id" {
+				tfName = *customId
+			}
+			attrNames, attrType, ok := FindObjectAttribute(currentType.AttributeTypes(), tfName)
+			if renamed, hasRename := fieldNameRenames[step.Name]; !ok && hasRename {
+				attrNames, attrType, ok = FindObjectAttribute(currentType.AttributeTypes(), renamed)
+			}
+			if !ok {
+				return result, len(result.Steps()) > 0
+			}
+			for _, attrName := range attrNames {
+				result = result.AtName(attrName)
+			}
+			current = attrType
+		default:
+			// Primitive values have no further steps
+			return result, len(result.Steps()) > 0
+		}
+	}
+	return result, len(result.Steps()) > 0
+}
+
+// Get the Terraform attribute path for a PingFederate field path. If the context doesn't carry a schema, only the
+// root attribute is used, since the types of any nested attributes are unknown.
+func terraformPathForFieldPath(ctx context.Context, fieldPath string, customId *string) (path.Path, bool) {
+	schemaType, ok := ctx.Value(schemaTypeContextKey{}).(attr.Type)
+	if ok && schemaType != nil {
+		return ToTerraformPath(schemaType, fieldPath, customId)
+	}
+
+	steps := ParseFieldPath(fieldPath)
+	if len(steps) == 0 || steps[0].IsBracket {
+		return path.Empty(), false
+	}
+	if customId != nil && steps[0].Name == "id" {
+		return path.Root(*customId), true
+	}
+	return path.Root(toTerraformIdentifier(steps[0].Name)), true
+}
@@ -4,6 +4,7 @@ package identitystoreprovisioners
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -339,6 +341,7 @@ func (state *identityStoreProvisionerResourceModel) readClientResponse(response
 }
 
 func (r *identityStoreProvisionerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data identityStoreProvisionerResourceModel
 
 	// Read Terraform plan data into the model
@@ -399,6 +402,7 @@ func (r *identityStoreProvisionerResource) Read(ctx context.Context, req resourc
 }
 
 func (r *identityStoreProvisionerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data identityStoreProvisionerResourceModel
 
 	// Read Terraform plan data into the model
@@ -444,7 +448,21 @@ func (r *identityStoreProvisionerResource) Delete(ctx context.Context, req resou
 }
 
 func (r *identityStoreProvisionerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to provisioner_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("provisioner_id"), req, resp)
+	// Retrieve import ID, looking up the provisioner by name if requested, and save to provisioner_id attribute
+	provisionerId, ok := importid.Resolve(ctx, req.ID, "pingfederate_identity_store_provisioner", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.IdentityStoreProvisionersAPI.GetIdentityStoreProvisioners(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, provisioner := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: provisioner.GetId(), Name: provisioner.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provisioner_id"), provisionerId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -3,6 +3,7 @@ package idpadapter
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,8 +21,10 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributecontractfulfillment"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributesources"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/sourcetypeidkey"
@@ -36,6 +39,7 @@ var (
 	_ resource.Resource                = &idpAdapterResource{}
 	_ resource.ResourceWithConfigure   = &idpAdapterResource{}
 	_ resource.ResourceWithImportState = &idpAdapterResource{}
+	_ resource.ResourceWithMoveState   = &idpAdapterResource{}
 
 	customId = "adapter_id"
 )
@@ -332,6 +336,7 @@ func (r *idpAdapterResource) ModifyPlan(ctx context.Context, req resource.Modify
 }
 
 func (r *idpAdapterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan idpAdapterModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -412,6 +417,7 @@ func (r *idpAdapterResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *idpAdapterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan idpAdapterModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -477,7 +483,31 @@ func (r *idpAdapterResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *idpAdapterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to adapter_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("adapter_id"), req, resp)
+	// Retrieve import ID, looking up the adapter by name if requested, and save to adapter_id attribute
+	adapterId, ok := importid.Resolve(ctx, req.ID, "pingfederate_idp_adapter", importid.ListPages(func(ctx context.Context, _, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.IdpAdaptersAPI.GetIdpAdapters(config.AuthContext(ctx, r.providerConfig)).Filter(value).Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, adapter := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: adapter.GetId(), Name: adapter.GetName()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adapter_id"), adapterId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *idpAdapterResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_idp_adapter"},
+			IdAttribute: "adapter_id",
+		}),
+	}
+}
@@ -0,0 +1,30 @@
+package idpspconnectioncredentialcert
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+)
+
+func IdpSpConnectionCredentialCertResource() resource.Resource {
+	return connectioncert.NewCredentialCertResource(connectioncert.CredentialCertResourceOptions{
+		TypeName:                   "idp_sp_connection_credential_cert",
+		ConnectionType:             "SP",
+		ConnectionName:             "IdP SP Connection",
+		ConnectionResourceTypeName: "pingfederate_idp_sp_connection",
+		ConnectionIdDescription:    "The ID of the SP connection.",
+		EncryptionCertDescription:  "Indicates whether to use this certificate to encrypt outgoing assertions.",
+		GetCerts: func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.ConnectionCerts, *http.Response, error) {
+			return apiClient.IdpSpConnectionsAPI.GetSpConnectionCerts(ctx, connectionId).Execute()
+		},
+		AddCert: func(ctx context.Context, apiClient *client.APIClient, connectionId string, cert client.ConnectionCert) (*client.ConnectionCert, *http.Response, error) {
+			return apiClient.IdpSpConnectionsAPI.AddSpConnectionCert(ctx, connectionId).Body(cert).Execute()
+		},
+		UpdateCerts: func(ctx context.Context, apiClient *client.APIClient, connectionId string, certs client.ConnectionCerts) (*client.ConnectionCerts, *http.Response, error) {
+			return apiClient.IdpSpConnectionsAPI.UpdateSpConnectionCerts(ctx, connectionId).Body(certs).Execute()
+		},
+	})
+}
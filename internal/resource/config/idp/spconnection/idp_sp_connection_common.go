@@ -32,6 +32,12 @@ type idpSpConnectionModel struct {
 	ConnectionTargetType                   types.String `tfsdk:"connection_target_type"`
 }
 
+// The resource can also populate the connection from the partner's SAML metadata
+type idpSpConnectionResourceModel struct {
+	idpSpConnectionModel
+	Metadata types.Object `tfsdk:"metadata"`
+}
+
 var (
 	attributeQueryAttrTypes = map[string]attr.Type{
 		"attributes":                     types.SetType{ElemType: types.StringType},
@@ -0,0 +1,194 @@
+package idpspconnection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	spBrowserSsoMetadataAttrs   = []string{"incoming_bindings", "slo_service_endpoints", "sso_service_endpoints"}
+	ssoServiceEndpointsElemType = spBrowserSSOAttrTypes["sso_service_endpoints"].(types.SetType).ElemType.(types.ObjectType)
+)
+
+// Populate the values that can be read from the partner's SAML metadata, for any of them that are not set in the
+// configuration. When onlyUnknown is true, only values that are still unknown in the plan are populated.
+// Returns true if the plan was modified.
+func (r *idpSpConnectionResource) populateFromMetadata(ctx context.Context, plan, config, state *idpSpConnectionResourceModel, onlyUnknown bool) (bool, diag.Diagnostics) {
+	var respDiags, diags diag.Diagnostics
+
+	populate := func(configValue, planValue attr.Value) bool {
+		return configValue != nil && configValue.IsNull() && (!onlyUnknown || planValue.IsUnknown())
+	}
+
+	populateEntityId := populate(config.EntityId, plan.EntityId)
+	populateCerts := false
+	var planCredentialsAttrs map[string]attr.Value
+	if internaltypes.IsDefined(plan.Credentials) && internaltypes.IsDefined(config.Credentials) {
+		planCredentialsAttrs = plan.Credentials.Attributes()
+		populateCerts = populate(config.Credentials.Attributes()["certs"], planCredentialsAttrs["certs"])
+	}
+	populateSpBrowserSsoAttrs := []string{}
+	var planSpBrowserSsoAttrs map[string]attr.Value
+	if internaltypes.IsDefined(plan.SpBrowserSso) && internaltypes.IsDefined(config.SpBrowserSso) {
+		planSpBrowserSsoAttrs = plan.SpBrowserSso.Attributes()
+		for _, name := range spBrowserSsoMetadataAttrs {
+			// slo_service_endpoints has a default, so it only needs to be populated when metadata is set
+			if name == "slo_service_endpoints" && plan.Metadata.IsNull() {
+				continue
+			}
+			if populate(config.SpBrowserSso.Attributes()[name], planSpBrowserSsoAttrs[name]) {
+				populateSpBrowserSsoAttrs = append(populateSpBrowserSsoAttrs, name)
+			}
+		}
+	}
+	if !populateEntityId && !populateCerts && len(populateSpBrowserSsoAttrs) == 0 {
+		return false, respDiags
+	}
+
+	// When metadata is not set, these values are null as usual. When the metadata can't be retrieved yet, they are
+	// unknown until it can be.
+	entityId := types.StringNull()
+	certs := types.ListNull(connectioncert.ObjType())
+	spBrowserSsoValues := map[string]attr.Value{
+		"incoming_bindings":     types.SetNull(types.StringType),
+		"sso_service_endpoints": types.SetNull(ssoServiceEndpointsElemType),
+	}
+	// Inline metadata is only converted when it changes. Otherwise the values read from it previously are kept.
+	// Metadata from a URL is retrieved and converted every time, so that changes at the URL are detected.
+	metadataUnchanged := state != nil && internaltypes.IsDefined(state.Metadata) && plan.Metadata.Equal(state.Metadata) &&
+		!connectionmetadata.IsFromUrl(plan.Metadata) &&
+		(!populateCerts || internaltypes.IsDefined(state.Credentials)) &&
+		(len(populateSpBrowserSsoAttrs) == 0 || internaltypes.IsDefined(state.SpBrowserSso))
+	metadataUnknown := plan.Metadata.IsUnknown()
+	if metadataUnchanged {
+		entityId = state.EntityId
+		if internaltypes.IsDefined(state.Credentials) {
+			certs = state.Credentials.Attributes()["certs"].(types.List)
+		}
+		if internaltypes.IsDefined(state.SpBrowserSso) {
+			stateSpBrowserSsoAttrs := state.SpBrowserSso.Attributes()
+			for _, name := range spBrowserSsoMetadataAttrs {
+				spBrowserSsoValues[name] = stateSpBrowserSsoAttrs[name]
+			}
+		}
+	} else if internaltypes.IsDefined(plan.Metadata) {
+		expectedProtocol := types.StringNull()
+		if internaltypes.IsDefined(plan.SpBrowserSso) {
+			expectedProtocol = plan.SpBrowserSso.Attributes()["protocol"].(types.String)
+		}
+		convertedJson, diags := connectionmetadata.Convert(ctx, r.apiClient, r.providerConfig, plan.Metadata, "SP", expectedProtocol, config.EntityId)
+		respDiags.Append(diags...)
+		if respDiags.HasError() {
+			return false, respDiags
+		}
+		if convertedJson == nil {
+			metadataUnknown = true
+		} else {
+			var converted client.SpConnection
+			err := json.Unmarshal(convertedJson, &converted)
+			if err != nil {
+				respDiags.AddError(providererror.InternalProviderError, "Failed to read the connection converted from metadata: "+err.Error())
+				return false, respDiags
+			}
+			entityId = types.StringValue(converted.EntityId)
+
+			var priorCerts attr.Value
+			if state != nil && internaltypes.IsDefined(state.Credentials) {
+				priorCerts = state.Credentials.Attributes()["certs"]
+			}
+			var convertedCerts []client.ConnectionCert
+			if converted.Credentials != nil {
+				convertedCerts = converted.Credentials.Certs
+			}
+			certs, diags = connectionmetadata.CertsPlanValue(convertedCerts, priorCerts)
+			respDiags.Append(diags...)
+
+			spBrowserSsoValues, diags = spBrowserSsoMetadataValues(converted.SpBrowserSso)
+			respDiags.Append(diags...)
+		}
+	}
+	if metadataUnknown {
+		entityId = types.StringUnknown()
+		certs = types.ListUnknown(connectioncert.ObjType())
+		spBrowserSsoValues = map[string]attr.Value{
+			"incoming_bindings":     types.SetUnknown(types.StringType),
+			"slo_service_endpoints": types.SetUnknown(sloServiceEndpointsElemType),
+			"sso_service_endpoints": types.SetUnknown(ssoServiceEndpointsElemType),
+		}
+	}
+
+	if populateEntityId {
+		plan.EntityId = entityId
+	}
+	if populateCerts {
+		planCredentialsAttrs["certs"] = certs
+		plan.Credentials, diags = types.ObjectValue(credentialsAttrTypes, planCredentialsAttrs)
+		respDiags.Append(diags...)
+	}
+	if len(populateSpBrowserSsoAttrs) > 0 {
+		for _, name := range populateSpBrowserSsoAttrs {
+			planSpBrowserSsoAttrs[name] = spBrowserSsoValues[name]
+		}
+		plan.SpBrowserSso, diags = types.ObjectValue(plan.SpBrowserSso.AttributeTypes(ctx), planSpBrowserSsoAttrs)
+		respDiags.Append(diags...)
+	}
+	return true, respDiags
+}
+
+// Build the sp_browser_sso values that are read from metadata
+func spBrowserSsoMetadataValues(spBrowserSso *client.SpBrowserSso) (map[string]attr.Value, diag.Diagnostics) {
+	var respDiags diag.Diagnostics
+	if spBrowserSso == nil {
+		spBrowserSso = &client.SpBrowserSso{}
+	}
+
+	incomingBindings := types.SetNull(types.StringType)
+	if len(spBrowserSso.IncomingBindings) > 0 {
+		var diags diag.Diagnostics
+		incomingBindings, diags = types.SetValueFrom(context.Background(), types.StringType, spBrowserSso.IncomingBindings)
+		respDiags.Append(diags...)
+	}
+
+	sloServiceEndpointsValues := []attr.Value{}
+	for _, endpoint := range spBrowserSso.SloServiceEndpoints {
+		endpointValue, diags := types.ObjectValue(sloServiceEndpointsElemType.AttrTypes, map[string]attr.Value{
+			"binding":      types.StringPointerValue(endpoint.Binding),
+			"response_url": types.StringPointerValue(endpoint.ResponseUrl),
+			"url":          types.StringValue(endpoint.Url),
+		})
+		respDiags.Append(diags...)
+		sloServiceEndpointsValues = append(sloServiceEndpointsValues, endpointValue)
+	}
+	sloServiceEndpoints, diags := types.SetValue(sloServiceEndpointsElemType, sloServiceEndpointsValues)
+	respDiags.Append(diags...)
+
+	ssoServiceEndpointsValues := []attr.Value{}
+	for _, endpoint := range spBrowserSso.SsoServiceEndpoints {
+		// PF will return nil for false for the is_default boolean
+		endpointValue, diags := types.ObjectValue(ssoServiceEndpointsElemType.AttrTypes, map[string]attr.Value{
+			"binding":    types.StringPointerValue(endpoint.Binding),
+			"index":      types.Int64PointerValue(endpoint.Index),
+			"is_default": types.BoolValue(endpoint.GetIsDefault()),
+			"url":        types.StringValue(endpoint.Url),
+		})
+		respDiags.Append(diags...)
+		ssoServiceEndpointsValues = append(ssoServiceEndpointsValues, endpointValue)
+	}
+	ssoServiceEndpoints, diags := types.SetValue(ssoServiceEndpointsElemType, ssoServiceEndpointsValues)
+	respDiags.Append(diags...)
+
+	return map[string]attr.Value{
+		"incoming_bindings":     incomingBindings,
+		"slo_service_endpoints": sloServiceEndpoints,
+		"sso_service_endpoints": ssoServiceEndpoints,
+	}, respDiags
+}
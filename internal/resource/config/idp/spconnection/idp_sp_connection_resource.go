@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"slices"
 	"time"
 
@@ -30,9 +31,12 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributecontractfulfillment"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributesources"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/sourcetypeidkey"
@@ -48,6 +52,7 @@ var (
 	_ resource.Resource                = &idpSpConnectionResource{}
 	_ resource.ResourceWithConfigure   = &idpSpConnectionResource{}
 	_ resource.ResourceWithImportState = &idpSpConnectionResource{}
+	_ resource.ResourceWithMoveState   = &idpSpConnectionResource{}
 
 	customId = "connection_id"
 )
@@ -752,7 +757,7 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							stringvalidator.LengthAtLeast(1),
 						},
 					},
-					"certs": connectioncert.ToSchema("The certificates used for signature verification and XML encryption."),
+					"certs": connectioncert.ToSchemaOptionalComputed("The certificates used for signature verification and XML encryption. Populated from `metadata` if not set. Certificates managed by `pingfederate_idp_sp_connection_credential_cert` resources are ignored."),
 					"block_encryption_algorithm": schema.StringAttribute{
 						Optional:            true,
 						Description:         "The algorithm used to encrypt assertions sent to this partner. Options are `AES_128`, `AES_256`, `AES_128_GCM`, `AES_192_GCM`, `AES_256_GCM`, `Triple_DES`.",
@@ -807,8 +812,8 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							},
 						},
 						Optional:            true,
-						Description:         "Settings related to signing messages sent to this partner.",
-						MarkdownDescription: "Settings related to signing messages sent to this partner.",
+						Description:         "Settings related to signing messages sent to this partner. If not set, signing settings managed outside of this resource, such as by the `pingfederate_idp_sp_connection_signing_settings` resource, are left in place.",
+						MarkdownDescription: "Settings related to signing messages sent to this partner. If not set, signing settings managed outside of this resource, such as by the `pingfederate_idp_sp_connection_signing_settings` resource, are left in place.",
 					},
 					"decryption_key_pair_ref": schema.SingleNestedAttribute{
 						Attributes:          resourcelink.ToSchema(),
@@ -960,8 +965,9 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"entity_id": schema.StringAttribute{
-				Required:    true,
-				Description: "The partner's entity ID (connection ID) or issuer value (for OIDC Connections).",
+				Optional:    true,
+				Computed:    true,
+				Description: "The partner's entity ID (connection ID) or issuer value (for OIDC Connections). Required unless `metadata` is set.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -1012,6 +1018,7 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 					),
 				},
 			},
+			"metadata": connectionmetadata.ToSchema("The partner's SAML metadata. When set, `entity_id`, `credentials.certs`, and the `sso_service_endpoints`, `slo_service_endpoints` and `incoming_bindings` of `sp_browser_sso` are populated from the metadata, unless they are set in the configuration. Other settings, such as attribute contracts, must still be configured."),
 			"metadata_reload_settings": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"enable_auto_metadata_update": schema.BoolAttribute{
@@ -1572,7 +1579,8 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 					"incoming_bindings": schema.SetAttribute{
 						ElementType: types.StringType,
 						Optional:    true,
-						Description: "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections.",
+						Computed:    true,
+						Description: "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections. Populated from `metadata` if not set.",
 						Validators: []validator.Set{
 							setvalidator.SizeAtLeast(1),
 						},
@@ -1638,7 +1646,7 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 						Optional:    true,
 						Computed:    true,
 						Default:     setdefault.StaticValue(types.SetValueMust(sloServiceEndpointsElemType, nil)),
-						Description: "A list of possible endpoints to send SLO requests and responses.",
+						Description: "A list of possible endpoints to send SLO requests and responses. Populated from `metadata` if not set.",
 					},
 					"sp_saml_identity_mapping": schema.StringAttribute{
 						Optional:    true,
@@ -1694,8 +1702,9 @@ func (r *idpSpConnectionResource) Schema(ctx context.Context, req resource.Schem
 								},
 							},
 						},
-						Required:    true,
-						Description: "A list of possible endpoints to send assertions to.",
+						Optional:    true,
+						Computed:    true,
+						Description: "A list of possible endpoints to send assertions to. Required unless `metadata` is set, in which case it is populated from the metadata if not set.",
 					},
 					"url_whitelist_entries": schema.SetNestedAttribute{
 						NestedObject: schema.NestedAttributeObject{
@@ -1885,13 +1894,29 @@ func (r *idpSpConnectionResource) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *idpSpConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config *idpSpConnectionModel
+	var config *idpSpConnectionResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if config == nil {
 		return
 	}
 
+	// Values that can be populated from metadata are required when metadata is not set
+	if config.Metadata.IsNull() {
+		if config.EntityId.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entity_id"),
+				providererror.InvalidAttributeConfiguration,
+				"The 'entity_id' attribute must be set when 'metadata' is not set.")
+		}
+		if internaltypes.IsDefined(config.SpBrowserSso) && config.SpBrowserSso.Attributes()["sso_service_endpoints"].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sp_browser_sso").AtName("sso_service_endpoints"),
+				providererror.InvalidAttributeConfiguration,
+				"The 'sso_service_endpoints' attribute must be set when 'metadata' is not set.")
+		}
+	}
+
 	virtualIds := config.VirtualEntityIds.Elements()
 	if internaltypes.IsDefined(config.DefaultVirtualEntityId) {
 		defaultId := config.DefaultVirtualEntityId.ValueString()
@@ -1945,15 +1970,26 @@ func (r *idpSpConnectionResource) ValidateConfig(ctx context.Context, req resour
 }
 
 func (r *idpSpConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	var plan, state *idpSpConnectionModel
+	var plan, state, config *idpSpConnectionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	var respDiags diag.Diagnostics
 
 	if plan == nil {
 		return
 	}
 
+	// Populate values from the partner's metadata
+	planModifiedFromMetadata, respDiags := r.populateFromMetadata(ctx, plan, config, state, false)
+	resp.Diagnostics.Append(respDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planModifiedFromMetadata {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+
 	planSpBrowserSsoAttributes := plan.SpBrowserSso.Attributes()
 	if internaltypes.IsDefined(plan.SpBrowserSso) {
 		// Get the protocol
@@ -2499,6 +2535,10 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 		}
 		credentialsCertsValue, objDiags := types.ListValue(connectioncert.ObjType(), credentialsCertsValues)
 		diags.Append(objDiags...)
+		// Leave certs unset when all of the certificates are managed outside of this resource
+		if len(credentialsCertsValues) == 0 && state.Credentials.Attributes()["certs"] != nil && state.Credentials.Attributes()["certs"].IsNull() {
+			credentialsCertsValue = types.ListNull(connectioncert.ObjType())
+		}
 		var credentialsDecryptionKeyPairRefValue types.Object
 		if response.Credentials.DecryptionKeyPairRef == nil {
 			credentialsDecryptionKeyPairRefValue = types.ObjectNull(resourcelink.AttrType())
@@ -3605,16 +3645,25 @@ func (state *idpSpConnectionModel) readClientResponse(response *client.SpConnect
 }
 
 func (r *idpSpConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan idpSpConnectionModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan, configModel idpSpConnectionResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate any values from metadata that could not be determined when planning
+	_, diags = r.populateFromMetadata(ctx, &plan, &configModel, nil, true)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	createIdpSpconnection := client.NewSpConnection(plan.EntityId.ValueString(), plan.Name.ValueString())
-	err := addOptionalIdpSpconnectionFields(ctx, createIdpSpconnection, plan)
+	err := addOptionalIdpSpconnectionFields(ctx, createIdpSpconnection, plan.idpSpConnectionModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for IdP SP Connection: "+err.Error())
 		return
@@ -3639,7 +3688,7 @@ func (r *idpSpConnectionResource) Read(ctx context.Context, req resource.ReadReq
 	isImportRead, diags := importprivatestate.IsImportRead(ctx, req, resp)
 	resp.Diagnostics.Append(diags...)
 
-	var state idpSpConnectionModel
+	var state idpSpConnectionResourceModel
 
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -3659,6 +3708,14 @@ func (r *idpSpConnectionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Ignore any certificates and signing settings that are managed outside of this resource
+	if !isImportRead && apiReadIdpSpconnection.Credentials != nil && internaltypes.IsDefined(state.Credentials) {
+		apiReadIdpSpconnection.Credentials.Certs, _ = connectioncert.SplitCerts(apiReadIdpSpconnection.Credentials.Certs, state.Credentials.Attributes()["certs"])
+		if state.Credentials.Attributes()["signing_settings"].IsNull() {
+			apiReadIdpSpconnection.Credentials.SigningSettings = nil
+		}
+	}
+
 	// Read the response into the state
 	diags = state.readClientResponse(apiReadIdpSpconnection, isImportRead)
 	resp.Diagnostics.Append(diags...)
@@ -3670,27 +3727,63 @@ func (r *idpSpConnectionResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *idpSpConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan idpSpConnectionModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan, state, configModel idpSpConnectionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate any values from metadata that could not be determined when planning
+	_, diags = r.populateFromMetadata(ctx, &plan, &configModel, &state, true)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	updateIdpSpconnection := r.apiClient.IdpSpConnectionsAPI.UpdateSpConnection(config.AuthContext(ctx, r.providerConfig), plan.ConnectionId.ValueString())
 	createUpdateRequest := client.NewSpConnection(plan.EntityId.ValueString(), plan.Name.ValueString())
-	err := addOptionalIdpSpconnectionFields(ctx, createUpdateRequest, plan)
+	err := addOptionalIdpSpconnectionFields(ctx, createUpdateRequest, plan.idpSpConnectionModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for the IdP SP Connection: "+err.Error())
 		return
 	}
 
+	// Retain any certificates and signing settings that are managed outside of this resource. The credentials are
+	// locked so that they can't be changed by the credential cert and signing settings resources in the meantime.
+	defer connectioncert.LockCredentials("SP", plan.ConnectionId.ValueString())()
+	var unmanagedCerts []client.ConnectionCert
+	retainSigningSettings := createUpdateRequest.Credentials != nil && createUpdateRequest.Credentials.SigningSettings == nil
+	if createUpdateRequest.Credentials != nil && internaltypes.IsDefined(state.Credentials) {
+		currentConnection, httpResp, err := r.apiClient.IdpSpConnectionsAPI.GetSpConnection(config.AuthContext(ctx, r.providerConfig), plan.ConnectionId.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while getting the IdP SP Connection credentials", err, httpResp, &customId)
+			return
+		}
+		if currentConnection.Credentials != nil {
+			_, unmanagedCerts = connectioncert.SplitCerts(currentConnection.Credentials.Certs, state.Credentials.Attributes()["certs"])
+			createUpdateRequest.Credentials.Certs = append(createUpdateRequest.Credentials.Certs, unmanagedCerts...)
+			if retainSigningSettings {
+				createUpdateRequest.Credentials.SigningSettings = currentConnection.Credentials.SigningSettings
+			}
+		}
+	}
+
 	updateIdpSpconnection = updateIdpSpconnection.Body(*createUpdateRequest)
 	updateIdpSpconnectionResponse, httpResp, err := r.apiClient.IdpSpConnectionsAPI.UpdateSpConnectionExecute(updateIdpSpconnection)
 	if err != nil {
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while updating the IdP SP Connection", err, httpResp, &customId)
 		return
 	}
+	if updateIdpSpconnectionResponse.Credentials != nil {
+		updateIdpSpconnectionResponse.Credentials.Certs = connectioncert.RemoveCerts(updateIdpSpconnectionResponse.Credentials.Certs, unmanagedCerts)
+		if retainSigningSettings {
+			updateIdpSpconnectionResponse.Credentials.SigningSettings = nil
+		}
+	}
 
 	// Read the response
 	diags = plan.readClientResponse(updateIdpSpconnectionResponse, false)
@@ -3703,7 +3796,7 @@ func (r *idpSpConnectionResource) Update(ctx context.Context, req resource.Updat
 
 func (r *idpSpConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state idpSpConnectionModel
+	var state idpSpConnectionResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -3716,7 +3809,37 @@ func (r *idpSpConnectionResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *idpSpConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to connection_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	// Retrieve import ID, looking up the connection by name or entity ID if requested, and save to connection_id attribute
+	connectionId, ok := importid.Resolve(ctx, req.ID, "pingfederate_idp_sp_connection", importid.ListPages(func(ctx context.Context, prefix, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		listRequest := r.apiClient.IdpSpConnectionsAPI.GetSpConnections(config.AuthContext(ctx, r.providerConfig))
+		if prefix == importid.EntityIdPrefix {
+			listRequest = listRequest.EntityId(value)
+		} else {
+			listRequest = listRequest.Filter(value)
+		}
+		response, httpResp, err := listRequest.Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, connection := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: connection.GetId(), Name: connection.GetName(), EntityId: connection.GetEntityId()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.EntityIdPrefix, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), connectionId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *idpSpConnectionResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_idp_sp_connection"},
+			IdAttribute: "connection_id",
+		}),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributecontractfulfillment"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributesources"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
@@ -1136,7 +1137,10 @@ func (r *idpSpConnectionResource) UpgradeState(ctx context.Context) map[int64]re
 				priorStateData.Credentials, diags = priorStateData.schemaUpgradeCredentialsV0toV1(ctx)
 				resp.Diagnostics.Append(diags...)
 
-				resp.Diagnostics.Append(resp.State.Set(ctx, priorStateData)...)
+				resp.Diagnostics.Append(resp.State.Set(ctx, idpSpConnectionResourceModel{
+					idpSpConnectionModel: priorStateData,
+					Metadata:             types.ObjectNull(connectionmetadata.AttrTypes()),
+				})...)
 			},
 		},
 	}
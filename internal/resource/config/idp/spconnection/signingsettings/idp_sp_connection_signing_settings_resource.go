@@ -0,0 +1,26 @@
+package idpspconnectionsigningsettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionsigningsettings"
+)
+
+func IdpSpConnectionSigningSettingsResource() resource.Resource {
+	return connectionsigningsettings.NewSigningSettingsResource(connectionsigningsettings.SigningSettingsResourceOptions{
+		TypeName:                   "idp_sp_connection_signing_settings",
+		ConnectionType:             "SP",
+		ConnectionName:             "IdP SP Connection",
+		ConnectionResourceTypeName: "pingfederate_idp_sp_connection",
+		ConnectionIdDescription:    "The ID of the SP connection.",
+		GetSigningSettings: func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.SigningSettings, *http.Response, error) {
+			return apiClient.IdpSpConnectionsAPI.GetSpSigningSettings(ctx, connectionId).Execute()
+		},
+		UpdateSigningSettings: func(ctx context.Context, apiClient *client.APIClient, connectionId string, signingSettings client.SigningSettings) (*client.SigningSettings, *http.Response, error) {
+			return apiClient.IdpSpConnectionsAPI.UpdateSpSigningSettings(ctx, connectionId).Body(signingSettings).Execute()
+		},
+	})
+}
@@ -127,6 +127,7 @@ func (state *idpStsRequestParametersContractResourceModel) readClientResponse(re
 }
 
 func (r *idpStsRequestParametersContractResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpStsRequestParametersContractResourceModel
 
 	// Read Terraform plan data into the model
@@ -184,6 +185,7 @@ func (r *idpStsRequestParametersContractResource) Read(ctx context.Context, req
 }
 
 func (r *idpStsRequestParametersContractResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpStsRequestParametersContractResourceModel
 
 	// Read Terraform plan data into the model
@@ -4,6 +4,7 @@ package idptokenprocessors
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -342,6 +344,7 @@ func (state *idpTokenProcessorResourceModel) readClientResponse(response *client
 }
 
 func (r *idpTokenProcessorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpTokenProcessorResourceModel
 
 	// Read Terraform plan data into the model
@@ -402,6 +405,7 @@ func (r *idpTokenProcessorResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *idpTokenProcessorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpTokenProcessorResourceModel
 
 	// Read Terraform plan data into the model
@@ -447,7 +451,21 @@ func (r *idpTokenProcessorResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *idpTokenProcessorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to processor_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("processor_id"), req, resp)
+	// Retrieve import ID, looking up the processor by name if requested, and save to processor_id attribute
+	processorId, ok := importid.Resolve(ctx, req.ID, "pingfederate_idp_token_processor", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.IdpTokenProcessorsAPI.GetTokenProcessors(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, processor := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: processor.GetId(), Name: processor.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("processor_id"), processorId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
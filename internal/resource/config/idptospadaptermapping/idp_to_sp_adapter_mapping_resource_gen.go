@@ -211,6 +211,7 @@ func (state *idpToSpAdapterMappingResourceModel) readClientResponse(response *cl
 }
 
 func (r *idpToSpAdapterMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpToSpAdapterMappingResourceModel
 
 	// Read Terraform plan data into the model
@@ -271,6 +272,7 @@ func (r *idpToSpAdapterMappingResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *idpToSpAdapterMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data idpToSpAdapterMappingResourceModel
 
 	// Read Terraform plan data into the model
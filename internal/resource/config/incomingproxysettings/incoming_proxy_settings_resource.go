@@ -2,8 +2,10 @@ package incomingproxysettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
@@ -44,6 +47,7 @@ type incomingProxySettingsResourceModel struct {
 	ClientCertChainSSLHeaderName   types.String `tfsdk:"client_cert_chain_ssl_header_name"`
 	ProxyTerminatesHttpsConns      types.Bool   `tfsdk:"proxy_terminates_https_conns"`
 	EnableClientCertHeaderAuth     types.Bool   `tfsdk:"enable_client_cert_header_auth"`
+	OnDestroy                      types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -119,6 +123,7 @@ func (r *incomingProxySettingsResource) Schema(ctx context.Context, req resource
 		},
 	}
 
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -218,7 +223,17 @@ func readIncomingProxySettingsResponse(ctx context.Context, r *client.IncomingPr
 	state.EnableClientCertHeaderAuth = types.BoolPointerValue(r.EnableClientCertHeaderAuth)
 }
 
+func (r *incomingProxySettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.IncomingProxySettingsAPI.GetIncomingProxySettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Incoming Proxy Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *incomingProxySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan incomingProxySettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -227,6 +242,12 @@ func (r *incomingProxySettingsResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createIncomingProxySettings := client.NewIncomingProxySettings()
 	addOptionalIncomingProxySettingsFields(ctx, createIncomingProxySettings, plan)
 
@@ -239,8 +260,9 @@ func (r *incomingProxySettingsResource) Create(ctx context.Context, req resource
 	}
 
 	// Read the response into the state
-	var state incomingProxySettingsResourceModel
-
+	state := incomingProxySettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	readIncomingProxySettingsResponse(ctx, incomingProxySettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -277,6 +299,7 @@ func (r *incomingProxySettingsResource) Read(ctx context.Context, req resource.R
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *incomingProxySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan incomingProxySettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -297,7 +320,9 @@ func (r *incomingProxySettingsResource) Update(ctx context.Context, req resource
 	}
 
 	// Read the response
-	var state incomingProxySettingsResourceModel
+	state := incomingProxySettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	readIncomingProxySettingsResponse(ctx, updateIncomingProxySettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -308,10 +333,23 @@ func (r *incomingProxySettingsResource) Update(ctx context.Context, req resource
 
 // This config object is edit-only, so Terraform can't delete it.
 func (r *incomingProxySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_incoming_proxy_settings",
+		nil,
+		func(ctx context.Context, original client.IncomingProxySettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.IncomingProxySettingsAPI.UpdateIncomingProxySettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *incomingProxySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState incomingProxySettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -295,6 +295,7 @@ func addOptionalKerberosRealmsFields(ctx context.Context, addRequest *client.Ker
 }
 
 func (r *kerberosRealmsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan kerberosRealmsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -357,6 +358,7 @@ func (r *kerberosRealmsResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *kerberosRealmsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan kerberosRealmsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -4,14 +4,17 @@ package kerberosrealmssettings
 
 import (
 	"context"
+	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -46,11 +49,12 @@ func (r *kerberosRealmSettingsResource) Configure(_ context.Context, req resourc
 }
 
 type kerberosRealmSettingsResourceModel struct {
-	DebugLogOutput            types.Bool  `tfsdk:"debug_log_output"`
-	ForceTcp                  types.Bool  `tfsdk:"force_tcp"`
-	KdcRetries                types.Int64 `tfsdk:"kdc_retries"`
-	KdcTimeout                types.Int64 `tfsdk:"kdc_timeout"`
-	KeySetRetentionPeriodMins types.Int64 `tfsdk:"key_set_retention_period_mins"`
+	DebugLogOutput            types.Bool   `tfsdk:"debug_log_output"`
+	ForceTcp                  types.Bool   `tfsdk:"force_tcp"`
+	KdcRetries                types.Int64  `tfsdk:"kdc_retries"`
+	KdcTimeout                types.Int64  `tfsdk:"kdc_timeout"`
+	KeySetRetentionPeriodMins types.Int64  `tfsdk:"key_set_retention_period_mins"`
+	OnDestroy                 types.String `tfsdk:"on_destroy"`
 }
 
 func (r *kerberosRealmSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -85,6 +89,7 @@ func (r *kerberosRealmSettingsResource) Schema(ctx context.Context, req resource
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, false)
 }
 
 func (model *kerberosRealmSettingsResourceModel) buildClientStruct() *client.KerberosRealmsSettings {
@@ -102,7 +107,17 @@ func (model *kerberosRealmSettingsResourceModel) buildClientStruct() *client.Ker
 	return result
 }
 
+func (r *kerberosRealmSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.KerberosRealmsAPI.GetKerberosRealmSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original kerberosRealmSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *kerberosRealmSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data kerberosRealmSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -112,6 +127,12 @@ func (r *kerberosRealmSettingsResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData := data.buildClientStruct()
 	apiUpdateRequest := r.apiClient.KerberosRealmsAPI.UpdateKerberosRealmSettings(config.AuthContext(ctx, r.providerConfig))
@@ -165,6 +186,7 @@ func (r *kerberosRealmSettingsResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *kerberosRealmSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data kerberosRealmSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -196,10 +218,22 @@ func (r *kerberosRealmSettingsResource) Update(ctx context.Context, req resource
 
 func (r *kerberosRealmSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_kerberos_realm_settings",
+		nil,
+		func(ctx context.Context, original client.KerberosRealmsSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.KerberosRealmsAPI.UpdateKerberosRealmSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *kerberosRealmSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState kerberosRealmSettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
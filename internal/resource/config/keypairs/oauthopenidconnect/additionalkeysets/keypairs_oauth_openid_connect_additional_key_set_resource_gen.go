@@ -679,6 +679,7 @@ func (state *keypairsOauthOpenidConnectAdditionalKeySetResourceModel) readClient
 }
 
 func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsOauthOpenidConnectAdditionalKeySetResourceModel
 
 	// Read Terraform plan data into the model
@@ -747,6 +748,7 @@ func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) Read(ctx context.Co
 }
 
 func (r *keypairsOauthOpenidConnectAdditionalKeySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsOauthOpenidConnectAdditionalKeySetResourceModel
 
 	// Read Terraform plan data into the model
@@ -3,12 +3,14 @@ package keypairsoauthopenidconnect
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -245,14 +247,18 @@ func (m *keypairsOauthOpenidConnectResourceModel) setNullObjectValues() {
 
 func (r *keypairsOauthOpenidConnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this resource will reset to the PingFederate default value.
-	defaultClientStruct := &client.OAuthOidcKeysSettings{
-		StaticJwksEnabled: false,
-	}
-	apiUpdateRequest := r.apiClient.KeyPairsOauthOpenIdConnectAPI.UpdateOAuthOidcKeysSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*defaultClientStruct)
-	_, httpResp, err := r.apiClient.KeyPairsOauthOpenIdConnectAPI.UpdateOAuthOidcKeysSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the keypairsOauthOpenidConnect", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_keypairs_oauth_openid_connect",
+		func(ctx context.Context) (*http.Response, error) {
+			defaultClientStruct := &client.OAuthOidcKeysSettings{
+				StaticJwksEnabled: false,
+			}
+			_, httpResp, err := r.apiClient.KeyPairsOauthOpenIdConnectAPI.UpdateOAuthOidcKeysSettings(config.AuthContext(ctx, r.providerConfig)).Body(*defaultClientStruct).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.OAuthOidcKeysSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.KeyPairsOauthOpenIdConnectAPI.UpdateOAuthOidcKeysSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
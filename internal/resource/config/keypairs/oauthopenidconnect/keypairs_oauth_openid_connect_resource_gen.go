@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -92,6 +93,7 @@ type keypairsOauthOpenidConnectResourceModel struct {
 	RsaPreviousKeyId                  types.String `tfsdk:"rsa_previous_key_id"`
 	RsaPublishX5cParameter            types.Bool   `tfsdk:"rsa_publish_x5c_parameter"`
 	StaticJwksEnabled                 types.Bool   `tfsdk:"static_jwks_enabled"`
+	OnDestroy                         types.String `tfsdk:"on_destroy"`
 }
 
 func (r *keypairsOauthOpenidConnectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -456,6 +458,7 @@ func (r *keypairsOauthOpenidConnectResource) Schema(ctx context.Context, req res
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (r *keypairsOauthOpenidConnectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -1101,7 +1104,17 @@ func (state *keypairsOauthOpenidConnectResourceModel) readClientResponse(respons
 	return respDiags
 }
 
+func (r *keypairsOauthOpenidConnectResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.KeyPairsOauthOpenIdConnectAPI.GetOauthOidcKeysSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original keypairsOauthOpenidConnect", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *keypairsOauthOpenidConnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsOauthOpenidConnectResourceModel
 
 	// Read Terraform plan data into the model
@@ -1111,6 +1124,12 @@ func (r *keypairsOauthOpenidConnectResource) Create(ctx context.Context, req res
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1201)
 	if err != nil {
@@ -1170,6 +1189,7 @@ func (r *keypairsOauthOpenidConnectResource) Read(ctx context.Context, req resou
 }
 
 func (r *keypairsOauthOpenidConnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsOauthOpenidConnectResourceModel
 
 	// Read Terraform plan data into the model
@@ -1206,5 +1226,9 @@ func (r *keypairsOauthOpenidConnectResource) ImportState(ctx context.Context, re
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := keypairsOauthOpenidConnectResourceModel{}
 	emptyState.setNullObjectValues()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,101 @@
+package keypairssigningpkcs12
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &keypairsSigningKeyPkcs12EphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &keypairsSigningKeyPkcs12EphemeralResource{}
+
+	customId = "key_id"
+)
+
+func KeypairsSigningKeyPkcs12EphemeralResource() ephemeral.EphemeralResource {
+	return &keypairsSigningKeyPkcs12EphemeralResource{}
+}
+
+type keypairsSigningKeyPkcs12EphemeralResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type keypairsSigningKeyPkcs12EphemeralResourceModel struct {
+	KeyId          types.String `tfsdk:"key_id"`
+	Password       types.String `tfsdk:"password"`
+	ExportedPkcs12 types.String `tfsdk:"exported_pkcs12"`
+}
+
+func (r *keypairsSigningKeyPkcs12EphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_keypairs_signing_key_pkcs12"
+}
+
+func (r *keypairsSigningKeyPkcs12EphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *keypairsSigningKeyPkcs12EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral resource to export a signing key pair, including its private key, in PKCS12 format. The exported key pair is never stored in Terraform state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"key_id": schema.StringAttribute{
+				Description: "The ID of the key pair to export.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "The password used to protect the exported PKCS12 file.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"exported_pkcs12": schema.StringAttribute{
+				Description: "The exported PKCS12 file, base64-encoded.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *keypairsSigningKeyPkcs12EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data keypairsSigningKeyPkcs12EphemeralResourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exportRequest := r.apiClient.KeyPairsSigningAPI.ExportPKCS12File(config.AuthContext(ctx, r.providerConfig), data.KeyId.ValueString())
+	exportRequest = exportRequest.Body(*client.NewKeyPairExportSettings(data.Password.ValueString()))
+	responseData, httpResp, err := exportRequest.Execute()
+	if err != nil {
+		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while exporting the signing key pair", err, httpResp, &customId)
+		return
+	}
+
+	data.ExportedPkcs12 = types.StringValue(base64.StdEncoding.EncodeToString([]byte(responseData)))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -184,6 +184,7 @@ func (state *keypairsSigningKeyRotationSettingsResourceModel) readClientResponse
 
 // This resource has no specific create endpoint, only a PUT
 func (r *keypairsSigningKeyRotationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSigningKeyRotationSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -241,6 +242,7 @@ func (r *keypairsSigningKeyRotationSettingsResource) Read(ctx context.Context, r
 }
 
 func (r *keypairsSigningKeyRotationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSigningKeyRotationSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -120,6 +120,7 @@ func (r *keypairsSslClientCsrExportResource) ModifyPlan(ctx context.Context, req
 }
 
 func (r *keypairsSslClientCsrExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslClientCsrExportResourceModel
 
 	// Read Terraform config data into the model
@@ -205,6 +205,7 @@ func (state *keypairsSslClientCsrResourceModel) readClientResponse(response *cli
 }
 
 func (r *keypairsSslClientCsrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslClientCsrResourceModel
 
 	// Read Terraform plan data into the model
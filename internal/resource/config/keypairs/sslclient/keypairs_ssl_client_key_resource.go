@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -29,6 +30,7 @@ import (
 var (
 	_ resource.Resource              = &keypairsSslClientKeyResource{}
 	_ resource.ResourceWithConfigure = &keypairsSslClientKeyResource{}
+	_ resource.ResourceWithMoveState = &keypairsSslClientKeyResource{}
 
 	customId    = "key_id"
 	createMutex sync.Mutex
@@ -525,6 +527,7 @@ func (r *keypairsSslClientKeyResource) Configure(_ context.Context, req resource
 }
 
 func (r *keypairsSslClientKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslClientKeyResourceModel
 
 	// Read Terraform plan data into the model
@@ -622,3 +625,14 @@ func (r *keypairsSslClientKeyResource) Delete(ctx context.Context, req resource.
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while deleting the ssl client key", err, httpResp, &customId)
 	}
 }
+
+func (r *keypairsSslClientKeyResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:        []string{"pingfederate_keypair_ssl_client"},
+			IdAttribute:      "key_id",
+			CopiedAttributes: movestate.KeyPairCopiedAttributes,
+		}),
+	}
+}
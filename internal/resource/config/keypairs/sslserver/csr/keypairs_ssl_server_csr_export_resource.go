@@ -120,6 +120,7 @@ func (r *keypairsSslServerCsrExportResource) ModifyPlan(ctx context.Context, req
 }
 
 func (r *keypairsSslServerCsrExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslServerCsrExportResourceModel
 
 	// Read Terraform config data into the model
@@ -205,6 +205,7 @@ func (state *keypairsSslServerCsrResourceModel) readClientResponse(response *cli
 }
 
 func (r *keypairsSslServerCsrResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslServerCsrResourceModel
 
 	// Read Terraform plan data into the model
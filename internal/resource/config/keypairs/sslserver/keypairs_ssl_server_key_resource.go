@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -30,6 +31,7 @@ import (
 var (
 	_ resource.Resource              = &keypairsSslServerKeyResource{}
 	_ resource.ResourceWithConfigure = &keypairsSslServerKeyResource{}
+	_ resource.ResourceWithMoveState = &keypairsSslServerKeyResource{}
 
 	customId    = "key_id"
 	createMutex sync.Mutex
@@ -588,6 +590,7 @@ func (r *keypairsSslServerKeyResource) Configure(_ context.Context, req resource
 }
 
 func (r *keypairsSslServerKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslServerKeyResourceModel
 
 	// Read Terraform plan data into the model
@@ -685,3 +688,14 @@ func (r *keypairsSslServerKeyResource) Delete(ctx context.Context, req resource.
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while deleting the ssl server key", err, httpResp, &customId)
 	}
 }
+
+func (r *keypairsSslServerKeyResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:        []string{"pingfederate_keypair_ssl_server"},
+			IdAttribute:      "key_id",
+			CopiedAttributes: movestate.KeyPairCopiedAttributes,
+		}),
+	}
+}
@@ -4,6 +4,7 @@ package keypairssslserversettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -13,8 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
-	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
@@ -52,6 +53,7 @@ type keypairsSslServerSettingsResourceModel struct {
 	ActiveRuntimeServerCerts types.Set    `tfsdk:"active_runtime_server_certs"`
 	AdminConsoleCertRef      types.Object `tfsdk:"admin_console_cert_ref"`
 	RuntimeServerCertRef     types.Object `tfsdk:"runtime_server_cert_ref"`
+	OnDestroy                types.String `tfsdk:"on_destroy"`
 }
 
 func (r *keypairsSslServerSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -110,6 +112,7 @@ func (r *keypairsSslServerSettingsResource) Schema(ctx context.Context, req reso
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, false)
 }
 
 func (model *keypairsSslServerSettingsResourceModel) buildClientStruct() *client.SslServerSettings {
@@ -206,7 +209,17 @@ func (state *keypairsSslServerSettingsResourceModel) readClientResponse(response
 	return respDiags
 }
 
+func (r *keypairsSslServerSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.KeyPairsSslServerAPI.GetSslServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original keypairsSslServerSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *keypairsSslServerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslServerSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -216,6 +229,12 @@ func (r *keypairsSslServerSettingsResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData := data.buildClientStruct()
 	apiUpdateRequest := r.apiClient.KeyPairsSslServerAPI.UpdateSslServerSettings(config.AuthContext(ctx, r.providerConfig))
@@ -263,6 +282,7 @@ func (r *keypairsSslServerSettingsResource) Read(ctx context.Context, req resour
 }
 
 func (r *keypairsSslServerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data keypairsSslServerSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -291,12 +311,23 @@ func (r *keypairsSslServerSettingsResource) Update(ctx context.Context, req reso
 
 func (r *keypairsSslServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_keypairs_ssl_server_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_keypairs_ssl_server_settings",
+		nil,
+		func(ctx context.Context, original client.SslServerSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.KeyPairsSslServerAPI.UpdateSslServerSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *keypairsSslServerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState keypairsSslServerSettingsResourceModel
 	emptyState.setNullObjectValues()
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
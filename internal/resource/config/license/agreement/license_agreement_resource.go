@@ -84,6 +84,7 @@ func (r *licenseAgreementResource) Configure(_ context.Context, req resource.Con
 }
 
 func (r *licenseAgreementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan licenseAgreementModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -145,6 +146,7 @@ func (r *licenseAgreementResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *licenseAgreementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan licenseAgreementModel
 	diags := req.Plan.Get(ctx, &plan)
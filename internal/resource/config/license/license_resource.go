@@ -241,6 +241,7 @@ func readLicenseResponse(ctx context.Context, r *client.LicenseView, state *lice
 }
 
 func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan licenseResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -297,6 +298,7 @@ func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan licenseResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -1173,6 +1173,7 @@ func readLocalIdentityProfileResponse(ctx context.Context, r *client.LocalIdenti
 }
 
 func (r *localIdentityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan localIdentityProfileModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -1238,6 +1239,7 @@ func (r *localIdentityProfileResource) Read(ctx context.Context, req resource.Re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *localIdentityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan localIdentityProfileModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -305,6 +305,7 @@ func (state *metadataUrlResourceModel) readClientResponse(response *client.Metad
 }
 
 func (r *metadataUrlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data metadataUrlResourceModel
 
 	// Read Terraform plan data into the model
@@ -362,6 +363,7 @@ func (r *metadataUrlResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *metadataUrlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data metadataUrlResourceModel
 
 	// Read Terraform plan data into the model
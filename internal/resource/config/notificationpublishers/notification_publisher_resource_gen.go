@@ -4,6 +4,7 @@ package notificationpublishers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -18,6 +19,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/api"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -207,6 +209,7 @@ func (state *notificationPublisherResourceModel) readClientResponse(response *cl
 }
 
 func (r *notificationPublisherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data notificationPublisherResourceModel
 
 	// Read Terraform plan data into the model
@@ -267,6 +270,7 @@ func (r *notificationPublisherResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *notificationPublisherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data notificationPublisherResourceModel
 
 	// Read Terraform plan data into the model
@@ -313,7 +317,21 @@ func (r *notificationPublisherResource) Delete(ctx context.Context, req resource
 }
 
 func (r *notificationPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to publisher_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("publisher_id"), req, resp)
+	// Retrieve import ID, looking up the publisher by name if requested, and save to publisher_id attribute
+	publisherId, ok := importid.Resolve(ctx, req.ID, "pingfederate_notification_publisher", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.NotificationPublishersAPI.GetNotificationPublishers(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, publisher := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: publisher.GetId(), Name: publisher.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("publisher_id"), publisherId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
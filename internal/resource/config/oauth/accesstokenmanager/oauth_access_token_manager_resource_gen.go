@@ -4,6 +4,7 @@ package oauthaccesstokenmanager
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -25,7 +26,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
@@ -37,6 +40,7 @@ var (
 	_ resource.Resource                = &oauthAccessTokenManagerResource{}
 	_ resource.ResourceWithConfigure   = &oauthAccessTokenManagerResource{}
 	_ resource.ResourceWithImportState = &oauthAccessTokenManagerResource{}
+	_ resource.ResourceWithMoveState   = &oauthAccessTokenManagerResource{}
 )
 
 func OauthAccessTokenManagerResource() resource.Resource {
@@ -716,6 +720,7 @@ func (state *oauthAccessTokenManagerResourceModel) readClientResponse(response *
 }
 
 func (r *oauthAccessTokenManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAccessTokenManagerResourceModel
 
 	// Read Terraform plan data into the model
@@ -776,6 +781,7 @@ func (r *oauthAccessTokenManagerResource) Read(ctx context.Context, req resource
 }
 
 func (r *oauthAccessTokenManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAccessTokenManagerResourceModel
 
 	// Read Terraform plan data into the model
@@ -821,7 +827,31 @@ func (r *oauthAccessTokenManagerResource) Delete(ctx context.Context, req resour
 }
 
 func (r *oauthAccessTokenManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to manager_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("manager_id"), req, resp)
+	// Retrieve import ID, looking up the access token manager by name if requested, and save to manager_id attribute
+	managerId, ok := importid.Resolve(ctx, req.ID, "pingfederate_oauth_access_token_manager", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.OauthAccessTokenManagersAPI.GetTokenManagers(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, accessTokenManager := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: accessTokenManager.GetId(), Name: accessTokenManager.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), managerId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *oauthAccessTokenManagerResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_oauth_access_token_manager"},
+			IdAttribute: "manager_id",
+		}),
+	}
+}
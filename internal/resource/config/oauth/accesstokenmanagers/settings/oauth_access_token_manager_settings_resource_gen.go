@@ -4,6 +4,7 @@ package oauthaccesstokenmanagerssettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -13,8 +14,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
-	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
 
@@ -49,6 +50,7 @@ func (r *oauthAccessTokenManagerSettingsResource) Configure(_ context.Context, r
 
 type oauthAccessTokenManagerSettingsResourceModel struct {
 	DefaultAccessTokenManagerRef types.Object `tfsdk:"default_access_token_manager_ref"`
+	OnDestroy                    types.String `tfsdk:"on_destroy"`
 }
 
 func (r *oauthAccessTokenManagerSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -70,6 +72,7 @@ func (r *oauthAccessTokenManagerSettingsResource) Schema(ctx context.Context, re
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, false)
 }
 
 func (model *oauthAccessTokenManagerSettingsResourceModel) buildClientStruct() *client.AccessTokenManagementSettings {
@@ -105,7 +108,17 @@ func (state *oauthAccessTokenManagerSettingsResourceModel) readClientResponse(re
 	return respDiags
 }
 
+func (r *oauthAccessTokenManagerSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthAccessTokenManagersAPI.GetOauthAccessTokenManagersSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original oauthAccessTokenManagerSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *oauthAccessTokenManagerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAccessTokenManagerSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -115,6 +128,12 @@ func (r *oauthAccessTokenManagerSettingsResource) Create(ctx context.Context, re
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData := data.buildClientStruct()
 	apiUpdateRequest := r.apiClient.OauthAccessTokenManagersAPI.UpdateOauthAccessTokenManagersSettings(config.AuthContext(ctx, r.providerConfig))
@@ -162,6 +181,7 @@ func (r *oauthAccessTokenManagerSettingsResource) Read(ctx context.Context, req
 }
 
 func (r *oauthAccessTokenManagerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAccessTokenManagerSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -190,12 +210,23 @@ func (r *oauthAccessTokenManagerSettingsResource) Update(ctx context.Context, re
 
 func (r *oauthAccessTokenManagerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_oauth_access_token_manager_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_oauth_access_token_manager_settings",
+		nil,
+		func(ctx context.Context, original client.AccessTokenManagementSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.OauthAccessTokenManagersAPI.UpdateOauthAccessTokenManagersSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *oauthAccessTokenManagerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState oauthAccessTokenManagerSettingsResourceModel
 	emptyState.setNullObjectValues()
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -214,6 +214,7 @@ func (r *oauthAccessTokenMappingResource) ValidateConfig(ctx context.Context, re
 }
 
 func (r *oauthAccessTokenMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthAccessTokenMappingResourceModel
 	var err error
 
@@ -305,6 +306,7 @@ func (r *oauthAccessTokenMappingResource) Read(ctx context.Context, req resource
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthAccessTokenMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthAccessTokenMappingResourceModel
 	var err error
 	diags := req.Plan.Get(ctx, &plan)
@@ -161,6 +161,7 @@ func (state *oauthAuthenticationPolicyContractMappingResourceModel) readClientRe
 }
 
 func (r *oauthAuthenticationPolicyContractMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAuthenticationPolicyContractMappingResourceModel
 
 	// Read Terraform plan data into the model
@@ -221,6 +222,7 @@ func (r *oauthAuthenticationPolicyContractMappingResource) Read(ctx context.Cont
 }
 
 func (r *oauthAuthenticationPolicyContractMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthAuthenticationPolicyContractMappingResourceModel
 
 	// Read Terraform plan data into the model
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/scopeentry"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -83,11 +86,23 @@ type oauthServerSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type oauthServerSettingsResourceModel struct {
+	oauthServerSettingsModel
+	ManageScopes types.Bool   `tfsdk:"manage_scopes"`
+	OnDestroy    types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
 		Description: "Manages the OAuth authorization server settings.",
 		Attributes: map[string]schema.Attribute{
+			"manage_scopes": schema.BoolAttribute{
+				Description: "Whether this resource manages the `scopes`, `scope_groups`, `exclusive_scopes` and `exclusive_scope_groups` of the authorization server. Set to `false` when scopes and scope groups are managed with the `pingfederate_oauth_scope`, `pingfederate_oauth_exclusive_scope`, `pingfederate_oauth_scope_group` and `pingfederate_oauth_exclusive_scope_group` resources. When `false`, the existing scopes and scope groups are left unchanged on the server and are not tracked in this resource's state. The default value is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			"default_scope_description": schema.StringAttribute{
 				Description: "The default scope description.",
 				Optional:    true,
@@ -95,7 +110,7 @@ func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.S
 				Default:     stringdefault.StaticString(""),
 			},
 			"scopes": schema.SetNestedAttribute{
-				Description: "The list of common scopes.",
+				Description: "The list of common scopes. Must not be set when `manage_scopes` is `false`.",
 				Computed:    true,
 				Optional:    true,
 				Default:     setdefault.StaticValue(scopesDefault),
@@ -126,7 +141,7 @@ func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"scope_groups": schema.SetNestedAttribute{
-				Description: "The list of common scope groups.",
+				Description: "The list of common scope groups. Must not be set when `manage_scopes` is `false`.",
 				Computed:    true,
 				Optional:    true,
 				Default:     setdefault.StaticValue(scopeGroupsDefault),
@@ -156,7 +171,7 @@ func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"exclusive_scopes": schema.SetNestedAttribute{
-				Description: "The list of exclusive scopes.",
+				Description: "The list of exclusive scopes. Must not be set when `manage_scopes` is `false`.",
 				Computed:    true,
 				Optional:    true,
 				Default:     setdefault.StaticValue(scopesDefault),
@@ -187,7 +202,7 @@ func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"exclusive_scope_groups": schema.SetNestedAttribute{
-				Description: "The list of exclusive scope groups.",
+				Description: "The list of exclusive scope groups. Must not be set when `manage_scopes` is `false`.",
 				Computed:    true,
 				Optional:    true,
 				Default:     setdefault.StaticValue(scopeGroupsDefault),
@@ -572,13 +587,32 @@ func (r *oauthServerSettingsResource) Schema(ctx context.Context, req resource.S
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
 func (r *oauthServerSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var model oauthServerSettingsModel
+	var model oauthServerSettingsResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 
+	// Scopes can't be set when they are managed outside of this resource
+	if model.ManageScopes.Equal(types.BoolValue(false)) {
+		scopeAttributes := map[string]types.Set{
+			"scopes":                 model.Scopes,
+			"scope_groups":           model.ScopeGroups,
+			"exclusive_scopes":       model.ExclusiveScopes,
+			"exclusive_scope_groups": model.ExclusiveScopeGroups,
+		}
+		for attrName, attrValue := range scopeAttributes {
+			if !attrValue.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attrName),
+					providererror.InvalidAttributeConfiguration,
+					fmt.Sprintf("%s cannot be set when manage_scopes is false", attrName))
+			}
+		}
+	}
+
 	// Scope list for comparing values in matchNameBtwnScopes variable
 	scopeNames := []string{}
 	// Test scope names for dynamic true, string must be prepended with *
@@ -663,7 +697,7 @@ func (r *oauthServerSettingsResource) ModifyPlan(ctx context.Context, req resour
 		return
 	}
 	pfVersionAtLeast122 := compare >= 0
-	var plan *oauthServerSettingsModel
+	var plan *oauthServerSettingsResourceModel
 	req.Plan.Get(ctx, &plan)
 	if plan == nil {
 		return
@@ -906,8 +940,18 @@ func (r *oauthServerSettingsResource) Configure(_ context.Context, req resource.
 
 }
 
+func (r *oauthServerSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.GetAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original OAuth Auth Server Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *oauthServerSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan oauthServerSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan oauthServerSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -915,12 +959,25 @@ func (r *oauthServerSettingsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createOauthServerSettings := client.NewAuthorizationServerSettings(plan.AuthorizationCodeTimeout.ValueInt64(), plan.AuthorizationCodeEntropy.ValueInt64(), plan.RefreshTokenLength.ValueInt64(), plan.RefreshRollingInterval.ValueInt64())
-	err := addOptionalOauthServerSettingsFields(ctx, createOauthServerSettings, plan)
+	err := addOptionalOauthServerSettingsFields(ctx, createOauthServerSettings, plan.oauthServerSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for OAuth Auth Server Settings: "+err.Error())
 		return
 	}
+	if !plan.ManageScopes.ValueBool() {
+		httpResp, err := r.addUnmanagedScopes(ctx, createOauthServerSettings)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the existing scopes for the OAuth Auth Server Settings", err, httpResp)
+			return
+		}
+	}
 
 	apiCreateOauthServerSettings := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig))
 	apiCreateOauthServerSettings = apiCreateOauthServerSettings.Body(*createOauthServerSettings)
@@ -931,15 +988,18 @@ func (r *oauthServerSettingsResource) Create(ctx context.Context, req resource.C
 	}
 
 	// Read the response into the state
-	var state oauthServerSettingsModel
-	diags = readOauthServerSettingsResponse(ctx, oauthServerSettingsResponse, &state)
+	state := oauthServerSettingsResourceModel{
+		ManageScopes: plan.ManageScopes,
+		OnDestroy:    plan.OnDestroy,
+	}
+	diags = state.readClientResponse(ctx, oauthServerSettingsResponse)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *oauthServerSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state oauthServerSettingsModel
+	var state oauthServerSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -959,7 +1019,7 @@ func (r *oauthServerSettingsResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Read the response into the state
-	diags = readOauthServerSettingsResponse(ctx, apiReadOauthServerSettings, &state)
+	diags = state.readClientResponse(ctx, apiReadOauthServerSettings)
 	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
@@ -969,8 +1029,9 @@ func (r *oauthServerSettingsResource) Read(ctx context.Context, req resource.Rea
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthServerSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan oauthServerSettingsModel
+	var plan oauthServerSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -980,11 +1041,18 @@ func (r *oauthServerSettingsResource) Update(ctx context.Context, req resource.U
 	// Get the current state to see how any attributes are changing
 	updateOauthServerSettings := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewAuthorizationServerSettings(plan.AuthorizationCodeTimeout.ValueInt64(), plan.AuthorizationCodeEntropy.ValueInt64(), plan.RefreshTokenLength.ValueInt64(), plan.RefreshRollingInterval.ValueInt64())
-	err := addOptionalOauthServerSettingsFields(ctx, createUpdateRequest, plan)
+	err := addOptionalOauthServerSettingsFields(ctx, createUpdateRequest, plan.oauthServerSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for OAuth Auth Server Settings: "+err.Error())
 		return
 	}
+	if !plan.ManageScopes.ValueBool() {
+		httpResp, err := r.addUnmanagedScopes(ctx, createUpdateRequest)
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the existing scopes for the OAuth Auth Server Settings", err, httpResp)
+			return
+		}
+	}
 
 	updateOauthServerSettings = updateOauthServerSettings.Body(*createUpdateRequest)
 	updateOauthServerSettingsResponse, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettingsExecute(updateOauthServerSettings)
@@ -994,8 +1062,11 @@ func (r *oauthServerSettingsResource) Update(ctx context.Context, req resource.U
 	}
 
 	// Read the response
-	var state oauthServerSettingsModel
-	diags = readOauthServerSettingsResponse(ctx, updateOauthServerSettingsResponse, &state)
+	state := oauthServerSettingsResourceModel{
+		ManageScopes: plan.ManageScopes,
+		OnDestroy:    plan.OnDestroy,
+	}
+	diags = state.readClientResponse(ctx, updateOauthServerSettingsResponse)
 	resp.Diagnostics.Append(diags...)
 
 	// Update computed values
@@ -1005,11 +1076,32 @@ func (r *oauthServerSettingsResource) Update(ctx context.Context, req resource.U
 
 // This config object is edit-only, so Terraform can't delete it.
 func (r *oauthServerSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	var state oauthServerSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ondestroy.Delete(ctx, req, resp, "pingfederate_oauth_server_settings",
+		nil,
+		func(ctx context.Context, original client.AuthorizationServerSettings) (*http.Response, error) {
+			if !state.ManageScopes.ValueBool() {
+				// Leave the scopes managed elsewhere in place
+				httpResp, err := r.addUnmanagedScopes(ctx, &original)
+				if err != nil {
+					return httpResp, err
+				}
+			}
+			_, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.UpdateAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *oauthServerSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState oauthServerSettingsModel
+	var emptyState oauthServerSettingsResourceModel
 	emptyState.Scopes = types.SetNull(types.ObjectType{AttrTypes: scopeentry.AttrTypes()})
 	emptyState.ScopeGroups = types.SetNull(types.ObjectType{AttrTypes: scopeGroupsAttrTypes})
 	emptyState.ExclusiveScopes = types.SetNull(types.ObjectType{AttrTypes: scopeentry.AttrTypes()})
@@ -1018,5 +1110,39 @@ func (r *oauthServerSettingsResource) ImportState(ctx context.Context, req resou
 	emptyState.AllowedOrigins = types.SetNull(types.StringType)
 	emptyState.PersistentGrantContract = types.ObjectNull(persistentGrantObjContractTypes)
 	emptyState.AdminWebServicePcvRef = types.ObjectNull(resourcelink.AttrType())
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+}
+
+// When scopes aren't managed by this resource, send the scopes and scope groups currently on the server
+// so that the update leaves them unchanged.
+func (r *oauthServerSettingsResource) addUnmanagedScopes(ctx context.Context, request *client.AuthorizationServerSettings) (*http.Response, error) {
+	current, httpResp, err := r.apiClient.OauthAuthServerSettingsAPI.GetAuthorizationServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		return httpResp, err
+	}
+	request.Scopes = current.Scopes
+	request.ScopeGroups = current.ScopeGroups
+	request.ExclusiveScopes = current.ExclusiveScopes
+	request.ExclusiveScopeGroups = current.ExclusiveScopeGroups
+	return httpResp, nil
+}
+
+func (state *oauthServerSettingsResourceModel) readClientResponse(ctx context.Context, response *client.AuthorizationServerSettings) diag.Diagnostics {
+	diags := readOauthServerSettingsResponse(ctx, response, &state.oauthServerSettingsModel)
+	// Scopes are managed by default, including when importing
+	if state.ManageScopes.IsNull() || state.ManageScopes.IsUnknown() {
+		state.ManageScopes = types.BoolValue(true)
+	}
+	if !state.ManageScopes.ValueBool() {
+		// Scopes managed elsewhere aren't tracked here, so just keep the empty defaults in state
+		state.Scopes = scopesDefault
+		state.ScopeGroups = scopeGroupsDefault
+		state.ExclusiveScopes = scopesDefault
+		state.ExclusiveScopeGroups = scopeGroupsDefault
+	}
+	return diags
 }
@@ -0,0 +1,252 @@
+package oauthauthserversettingsscopegroups
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &oauthScopeGroupResource{}
+	_ resource.ResourceWithConfigure   = &oauthScopeGroupResource{}
+	_ resource.ResourceWithImportState = &oauthScopeGroupResource{}
+)
+
+// OauthScopeGroupResource is a helper function to simplify the provider implementation.
+func OauthScopeGroupResource() resource.Resource {
+	return &oauthScopeGroupResource{}
+}
+
+// OauthExclusiveScopeGroupResource is a helper function to simplify the provider implementation.
+func OauthExclusiveScopeGroupResource() resource.Resource {
+	return &oauthScopeGroupResource{
+		exclusive: true,
+	}
+}
+
+// oauthScopeGroupResource is the resource implementation for both common and exclusive scope groups,
+// which differ only in the endpoints used.
+type oauthScopeGroupResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	exclusive      bool
+}
+
+type oauthScopeGroupModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+}
+
+func (r *oauthScopeGroupResource) scopeGroupType() string {
+	if r.exclusive {
+		return "OAuth Exclusive Scope Group"
+	}
+	return "OAuth Scope Group"
+}
+
+// GetSchema defines the schema for the resource.
+func (r *oauthScopeGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Resource to create and manage a common OAuth scope group."
+	scopesDescription := "The set of common scopes for this scope group."
+	if r.exclusive {
+		description = "Resource to create and manage an exclusive OAuth scope group."
+		scopesDescription = "The set of exclusive scopes for this scope group."
+	}
+	description += " Scope groups managed with this resource should not also be defined on the `pingfederate_oauth_server_settings` resource; set `manage_scopes` to `false` on that resource when using this one."
+
+	schema := schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the scope group. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required:    true,
+				Description: "The description of the scope group.",
+			},
+			"scopes": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: scopesDescription,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *oauthScopeGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.exclusive {
+		resp.TypeName = req.ProviderTypeName + "_oauth_exclusive_scope_group"
+	} else {
+		resp.TypeName = req.ProviderTypeName + "_oauth_scope_group"
+	}
+}
+
+func (r *oauthScopeGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (model *oauthScopeGroupModel) buildClientStruct(ctx context.Context) *client.ScopeGroupEntry {
+	var scopes []string
+	model.Scopes.ElementsAs(ctx, &scopes, false)
+	return client.NewScopeGroupEntry(model.Name.ValueString(), model.Description.ValueString(), scopes)
+}
+
+func (state *oauthScopeGroupModel) readClientResponse(response *client.ScopeGroupEntry) {
+	state.Id = types.StringValue(response.Name)
+	state.Name = types.StringValue(response.Name)
+	state.Description = types.StringValue(response.Description)
+	state.Scopes = internaltypes.GetStringSet(response.Scopes)
+}
+
+func (r *oauthScopeGroupResource) addScopeGroup(ctx context.Context, scopeGroup *client.ScopeGroupEntry) (*client.ScopeGroupEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.AddExclusiveScopeGroup(config.AuthContext(ctx, r.providerConfig)).Body(*scopeGroup).Execute()
+	}
+	return api.AddCommonScopeGroup(config.AuthContext(ctx, r.providerConfig)).Body(*scopeGroup).Execute()
+}
+
+func (r *oauthScopeGroupResource) getScopeGroup(ctx context.Context, name string) (*client.ScopeGroupEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.GetExclusiveScopeGroup(config.AuthContext(ctx, r.providerConfig), name).Execute()
+	}
+	return api.GetCommonScopeGroup(config.AuthContext(ctx, r.providerConfig), name).Execute()
+}
+
+func (r *oauthScopeGroupResource) updateScopeGroup(ctx context.Context, scopeGroup *client.ScopeGroupEntry) (*client.ScopeGroupEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.UpdateExclusiveScopeGroups(config.AuthContext(ctx, r.providerConfig), scopeGroup.Name).Body(*scopeGroup).Execute()
+	}
+	return api.UpdateCommonScopeGroup(config.AuthContext(ctx, r.providerConfig), scopeGroup.Name).Body(*scopeGroup).Execute()
+}
+
+func (r *oauthScopeGroupResource) removeScopeGroup(ctx context.Context, name string) (*http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.RemoveExclusiveScopeGroup(config.AuthContext(ctx, r.providerConfig), name).Execute()
+	}
+	return api.RemoveCommonScopeGroup(config.AuthContext(ctx, r.providerConfig), name).Execute()
+}
+
+func (r *oauthScopeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan oauthScopeGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.addScopeGroup(ctx, plan.buildClientStruct(ctx))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the "+r.scopeGroupType(), err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	plan.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *oauthScopeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state oauthScopeGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.getScopeGroup(ctx, state.Name.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, r.scopeGroupType(), httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the "+r.scopeGroupType(), err, httpResp)
+		}
+		return
+	}
+
+	// Read the response into the state
+	state.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *oauthScopeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan oauthScopeGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.updateScopeGroup(ctx, plan.buildClientStruct(ctx))
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the "+r.scopeGroupType(), err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	plan.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oauthScopeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state oauthScopeGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.removeScopeGroup(ctx, state.Name.ValueString())
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the "+r.scopeGroupType(), err, httpResp)
+	}
+}
+
+func (r *oauthScopeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to name attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,276 @@
+package oauthauthserversettingsscopes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &oauthScopeResource{}
+	_ resource.ResourceWithConfigure      = &oauthScopeResource{}
+	_ resource.ResourceWithImportState    = &oauthScopeResource{}
+	_ resource.ResourceWithValidateConfig = &oauthScopeResource{}
+)
+
+// OauthScopeResource is a helper function to simplify the provider implementation.
+func OauthScopeResource() resource.Resource {
+	return &oauthScopeResource{}
+}
+
+// OauthExclusiveScopeResource is a helper function to simplify the provider implementation.
+func OauthExclusiveScopeResource() resource.Resource {
+	return &oauthScopeResource{
+		exclusive: true,
+	}
+}
+
+// oauthScopeResource is the resource implementation for both common and exclusive scopes,
+// which differ only in the endpoints used and the naming rules for dynamic scopes.
+type oauthScopeResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+	exclusive      bool
+}
+
+type oauthScopeModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Dynamic     types.Bool   `tfsdk:"dynamic"`
+}
+
+func (r *oauthScopeResource) scopeType() string {
+	if r.exclusive {
+		return "OAuth Exclusive Scope"
+	}
+	return "OAuth Scope"
+}
+
+// GetSchema defines the schema for the resource.
+func (r *oauthScopeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	description := "Resource to create and manage a common OAuth scope. Common scopes may be requested by any OAuth client."
+	dynamicDescription := "True if the scope is dynamic. A dynamic scope name must include a single `*`. The default is `false`."
+	if r.exclusive {
+		description = "Resource to create and manage an exclusive OAuth scope. Exclusive scopes may only be requested by OAuth clients that are explicitly granted them."
+		dynamicDescription = "True if the scope is dynamic. A dynamic scope name must be prefixed with a `*`. The default is `false`."
+	}
+	description += " Scopes managed with this resource should not also be defined on the `pingfederate_oauth_server_settings` resource; set `manage_scopes` to `false` on that resource when using this one."
+
+	schema := schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the scope. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Required:    true,
+				Description: "The description of the scope that appears when the user is prompted for authorization.",
+			},
+			"dynamic": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: dynamicDescription,
+			},
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+func (r *oauthScopeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model oauthScopeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || !model.Dynamic.ValueBool() || model.Name.IsUnknown() || model.Name.IsNull() {
+		return
+	}
+
+	name := model.Name.ValueString()
+	if r.exclusive && strings.Index(name, "*") != 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			providererror.InvalidAttributeConfiguration,
+			fmt.Sprintf("Scope name \"%s\" must be prefixed with a \"*\" when dynamic is set to true.", name))
+	} else if !r.exclusive && strings.Count(name, "*") != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			providererror.InvalidAttributeConfiguration,
+			fmt.Sprintf("Scope name \"%s\" must be include a single \"*\" when dynamic is set to true.", name))
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *oauthScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	if r.exclusive {
+		resp.TypeName = req.ProviderTypeName + "_oauth_exclusive_scope"
+	} else {
+		resp.TypeName = req.ProviderTypeName + "_oauth_scope"
+	}
+}
+
+func (r *oauthScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (model *oauthScopeModel) buildClientStruct() *client.ScopeEntry {
+	result := client.NewScopeEntry(model.Name.ValueString(), model.Description.ValueString())
+	result.Dynamic = model.Dynamic.ValueBoolPointer()
+	return result
+}
+
+func (state *oauthScopeModel) readClientResponse(response *client.ScopeEntry) {
+	state.Id = types.StringValue(response.Name)
+	state.Name = types.StringValue(response.Name)
+	state.Description = types.StringValue(response.Description)
+	// PingFederate omits dynamic from the response when it is false
+	state.Dynamic = types.BoolValue(response.GetDynamic())
+}
+
+func (r *oauthScopeResource) addScope(ctx context.Context, scope *client.ScopeEntry) (*client.ScopeEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.AddExclusiveScope(config.AuthContext(ctx, r.providerConfig)).Body(*scope).Execute()
+	}
+	return api.AddCommonScope(config.AuthContext(ctx, r.providerConfig)).Body(*scope).Execute()
+}
+
+func (r *oauthScopeResource) getScope(ctx context.Context, name string) (*client.ScopeEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.GetExclusiveScope(config.AuthContext(ctx, r.providerConfig), name).Execute()
+	}
+	return api.GetCommonScope(config.AuthContext(ctx, r.providerConfig), name).Execute()
+}
+
+func (r *oauthScopeResource) updateScope(ctx context.Context, scope *client.ScopeEntry) (*client.ScopeEntry, *http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.UpdateExclusiveScope(config.AuthContext(ctx, r.providerConfig), scope.Name).Body(*scope).Execute()
+	}
+	return api.UpdateCommonScope(config.AuthContext(ctx, r.providerConfig), scope.Name).Body(*scope).Execute()
+}
+
+func (r *oauthScopeResource) removeScope(ctx context.Context, name string) (*http.Response, error) {
+	api := r.apiClient.OauthAuthServerSettingsAPI
+	if r.exclusive {
+		return api.RemoveExclusiveScope(config.AuthContext(ctx, r.providerConfig), name).Execute()
+	}
+	return api.RemoveCommonScope(config.AuthContext(ctx, r.providerConfig), name).Execute()
+}
+
+func (r *oauthScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan oauthScopeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.addScope(ctx, plan.buildClientStruct())
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the "+r.scopeType(), err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	plan.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *oauthScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state oauthScopeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.getScope(ctx, state.Name.ValueString())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, r.scopeType(), httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the "+r.scopeType(), err, httpResp)
+		}
+		return
+	}
+
+	// Read the response into the state
+	state.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *oauthScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan oauthScopeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.updateScope(ctx, plan.buildClientStruct())
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the "+r.scopeType(), err, httpResp)
+		return
+	}
+
+	// Read the response into the state
+	plan.readClientResponse(responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oauthScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state oauthScopeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.removeScope(ctx, state.Name.ValueString())
+	if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while deleting the "+r.scopeType(), err, httpResp)
+	}
+}
+
+func (r *oauthScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to name attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -481,6 +481,7 @@ func (state *oauthCibaServerPolicyRequestPolicyResourceModel) readClientResponse
 }
 
 func (r *oauthCibaServerPolicyRequestPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthCibaServerPolicyRequestPolicyResourceModel
 
 	// Read Terraform plan data into the model
@@ -538,6 +539,7 @@ func (r *oauthCibaServerPolicyRequestPolicyResource) Read(ctx context.Context, r
 }
 
 func (r *oauthCibaServerPolicyRequestPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthCibaServerPolicyRequestPolicyResourceModel
 
 	// Read Terraform plan data into the model
@@ -2,12 +2,14 @@ package oauthcibaserverpolicysettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -34,6 +36,7 @@ type oauthCibaServerPolicySettingsResource struct {
 
 type oauthCibaServerPolicySettingsResourceModel struct {
 	DefaultRequestPolicyRef types.Object `tfsdk:"default_request_policy_ref"`
+	OnDestroy               types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -49,6 +52,7 @@ func (r *oauthCibaServerPolicySettingsResource) Schema(ctx context.Context, req
 			),
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -76,7 +80,17 @@ func readOauthCibaServerPolicySettingsResponse(ctx context.Context, r *client.Ci
 	return diags
 }
 
+func (r *oauthCibaServerPolicySettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthCibaServerPolicyAPI.GetCibaServerPolicySettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original OAuth CIBA Server Policy Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *oauthCibaServerPolicySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var err error
 	var plan oauthCibaServerPolicySettingsResourceModel
 
@@ -86,6 +100,12 @@ func (r *oauthCibaServerPolicySettingsResource) Create(ctx context.Context, req
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createOauthCibaServerPolicySettings := client.NewCibaServerPolicySettings()
 	createOauthCibaServerPolicySettings.DefaultRequestPolicyRef, err = resourcelink.ClientStruct(plan.DefaultRequestPolicyRef)
 	if err != nil {
@@ -102,8 +122,9 @@ func (r *oauthCibaServerPolicySettingsResource) Create(ctx context.Context, req
 	}
 
 	// Read the response into the state
-	var state oauthCibaServerPolicySettingsResourceModel
-
+	state := oauthCibaServerPolicySettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOauthCibaServerPolicySettingsResponse(ctx, oauthCibaServerPolicySettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -140,6 +161,7 @@ func (r *oauthCibaServerPolicySettingsResource) Read(ctx context.Context, req re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthCibaServerPolicySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var err error
 	var plan oauthCibaServerPolicySettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -164,7 +186,9 @@ func (r *oauthCibaServerPolicySettingsResource) Update(ctx context.Context, req
 	}
 
 	// Read the response
-	var state oauthCibaServerPolicySettingsResourceModel
+	state := oauthCibaServerPolicySettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOauthCibaServerPolicySettingsResponse(ctx, updateOauthCibaServerPolicySettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -176,12 +200,23 @@ func (r *oauthCibaServerPolicySettingsResource) Update(ctx context.Context, req
 // This config object is edit-only, so Terraform can't delete it.
 func (r *oauthCibaServerPolicySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_oauth_ciba_server_policy_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_oauth_ciba_server_policy_settings",
+		nil,
+		func(ctx context.Context, original client.CibaServerPolicySettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.OauthCibaServerPolicyAPI.UpdateCibaServerPolicySettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *oauthCibaServerPolicySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState oauthCibaServerPolicySettingsResourceModel
 	emptyState.DefaultRequestPolicyRef = types.ObjectNull(resourcelink.AttrType())
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -30,7 +31,9 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
@@ -44,6 +47,7 @@ var (
 	_ resource.Resource                = &oauthClientResource{}
 	_ resource.ResourceWithConfigure   = &oauthClientResource{}
 	_ resource.ResourceWithImportState = &oauthClientResource{}
+	_ resource.ResourceWithMoveState   = &oauthClientResource{}
 )
 
 var (
@@ -1714,6 +1718,7 @@ func addOptionalOauthClientFields(ctx context.Context, addRequest *client.Client
 }
 
 func (r *oauthClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthClientModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -1780,6 +1785,7 @@ func (r *oauthClientResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan oauthClientModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -1828,7 +1834,31 @@ func (r *oauthClientResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *oauthClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("client_id"), req, resp)
+	// Retrieve import ID, looking up the client by name if requested, and save to client_id attribute
+	clientId, ok := importid.Resolve(ctx, req.ID, "pingfederate_oauth_client", importid.ListPages(func(ctx context.Context, _, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.OauthClientsAPI.GetOauthClients(config.AuthContext(ctx, r.providerConfig)).Filter(value).Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, oauthClient := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: oauthClient.ClientId, Name: oauthClient.Name})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_id"), clientId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *oauthClientResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_oauth_client"},
+			IdAttribute: "client_id",
+		}),
+	}
+}
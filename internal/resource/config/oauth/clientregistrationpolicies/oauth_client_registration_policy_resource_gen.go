@@ -4,6 +4,7 @@ package oauthclientregistrationpolicies
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -213,6 +215,7 @@ func (state *oauthClientRegistrationPolicyResourceModel) readClientResponse(resp
 }
 
 func (r *oauthClientRegistrationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthClientRegistrationPolicyResourceModel
 
 	// Read Terraform plan data into the model
@@ -273,6 +276,7 @@ func (r *oauthClientRegistrationPolicyResource) Read(ctx context.Context, req re
 }
 
 func (r *oauthClientRegistrationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthClientRegistrationPolicyResourceModel
 
 	// Read Terraform plan data into the model
@@ -318,7 +322,21 @@ func (r *oauthClientRegistrationPolicyResource) Delete(ctx context.Context, req
 }
 
 func (r *oauthClientRegistrationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to policy_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("policy_id"), req, resp)
+	// Retrieve import ID, looking up the policy by name if requested, and save to policy_id attribute
+	policyId, ok := importid.Resolve(ctx, req.ID, "pingfederate_oauth_client_registration_policy", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.OauthClientRegistrationPoliciesAPI.GetDynamicClientRegistrationPolicies(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, policy := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: policy.GetId(), Name: policy.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), policyId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
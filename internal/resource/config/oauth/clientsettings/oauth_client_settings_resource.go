@@ -3,12 +3,14 @@ package oauthclientsettings
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -207,12 +209,16 @@ func (r *oauthClientSettingsResource) buildDefaultClientStruct() *client.ClientS
 
 func (r *oauthClientSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this resource will reset the PF config back to its default value.
-	clientData := r.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.OauthClientSettingsAPI.UpdateOauthClientSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.OauthClientSettingsAPI.UpdateOauthClientSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the oauthClientSettings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_oauth_client_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			clientData := r.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.OauthClientSettingsAPI.UpdateOauthClientSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.ClientSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.OauthClientSettingsAPI.UpdateOauthClientSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
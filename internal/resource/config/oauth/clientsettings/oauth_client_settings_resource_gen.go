@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -55,6 +56,7 @@ func (r *oauthClientSettingsResource) Configure(_ context.Context, req resource.
 
 type oauthClientSettingsResourceModel struct {
 	DynamicClientRegistration types.Object `tfsdk:"dynamic_client_registration"`
+	OnDestroy                 types.String `tfsdk:"on_destroy"`
 }
 
 func (r *oauthClientSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -509,6 +511,7 @@ func (r *oauthClientSettingsResource) Schema(ctx context.Context, req resource.S
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (r *oauthClientSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -1013,7 +1016,17 @@ func (r *oauthClientSettingsResource) emptyModel() oauthClientSettingsResourceMo
 	return model
 }
 
+func (r *oauthClientSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthClientSettingsAPI.GetOauthClientSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original OAuth client settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *oauthClientSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthClientSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -1023,6 +1036,12 @@ func (r *oauthClientSettingsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// This resource depends on the values in the /extendedProperties endpoint, so pass those in to build the client struct
 	apiReadExtendedProperties, httpResp, err := r.apiClient.ExtendedPropertiesAPI.GetExtendedProperties(config.AuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
@@ -1078,6 +1097,7 @@ func (r *oauthClientSettingsResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *oauthClientSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthClientSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -1115,5 +1135,9 @@ func (r *oauthClientSettingsResource) Update(ctx context.Context, req resource.U
 func (r *oauthClientSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -163,6 +163,7 @@ func (state *oauthIdpAdapterMappingResourceModel) readClientResponse(response *c
 }
 
 func (r *oauthIdpAdapterMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthIdpAdapterMappingResourceModel
 
 	// Read Terraform plan data into the model
@@ -223,6 +224,7 @@ func (r *oauthIdpAdapterMappingResource) Read(ctx context.Context, req resource.
 }
 
 func (r *oauthIdpAdapterMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data oauthIdpAdapterMappingResourceModel
 
 	// Read Terraform plan data into the model
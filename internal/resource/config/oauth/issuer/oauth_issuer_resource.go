@@ -123,6 +123,7 @@ func (r *oauthIssuerResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *oauthIssuerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthIssuerModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -184,6 +185,7 @@ func (r *oauthIssuerResource) Read(ctx context.Context, req resource.ReadRequest
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthIssuerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan oauthIssuerModel
 	diags := req.Plan.Get(ctx, &plan)
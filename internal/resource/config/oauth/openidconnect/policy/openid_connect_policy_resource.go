@@ -383,6 +383,7 @@ func (model *oauthOpenIdConnectPolicyModel) buildClientStruct() (*client.OpenIdC
 }
 
 func (r *openidConnectPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthOpenIdConnectPolicyModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -442,6 +443,7 @@ func (r *openidConnectPolicyResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *openidConnectPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan oauthOpenIdConnectPolicyModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -2,12 +2,14 @@ package oauthopenidconnectsettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -34,6 +36,7 @@ type openidConnectSettingsResource struct {
 
 type openidConnectSettingsResourceModel struct {
 	DefaultPolicyRef types.Object `tfsdk:"default_policy_ref"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -48,6 +51,7 @@ func (r *openidConnectSettingsResource) Schema(ctx context.Context, req resource
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -99,7 +103,17 @@ func readOpenidConnectSettingsResponse(ctx context.Context, r *client.OpenIdConn
 	return diags
 }
 
+func (r *openidConnectSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthOpenIdConnectAPI.GetOIDCSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original OpenID Connect Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *openidConnectSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan openidConnectSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -108,6 +122,12 @@ func (r *openidConnectSettingsResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// This resource depends on the values in the /session/settings endpoint, so pass those in to build the client struct
 	apiReadSessionSettings, httpResp, err := r.apiClient.SessionAPI.GetSessionSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
 	if err != nil {
@@ -130,8 +150,9 @@ func (r *openidConnectSettingsResource) Create(ctx context.Context, req resource
 	}
 
 	// Read the response into the state
-	var state openidConnectSettingsResourceModel
-
+	state := openidConnectSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOpenidConnectSettingsResponse(ctx, openidConnectSettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -168,6 +189,7 @@ func (r *openidConnectSettingsResource) Read(ctx context.Context, req resource.R
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *openidConnectSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan openidConnectSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -199,7 +221,9 @@ func (r *openidConnectSettingsResource) Update(ctx context.Context, req resource
 	}
 
 	// Read the response
-	var state openidConnectSettingsResourceModel
+	state := openidConnectSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOpenidConnectSettingsResponse(ctx, updateOpenidConnectSettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -211,12 +235,23 @@ func (r *openidConnectSettingsResource) Update(ctx context.Context, req resource
 // This config object is edit-only, so Terraform can't delete it.
 func (r *openidConnectSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_openid_connect_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_openid_connect_settings",
+		nil,
+		func(ctx context.Context, original client.OpenIdConnectSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.OauthOpenIdConnectAPI.UpdateOIDCSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *openidConnectSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState openidConnectSettingsResourceModel
 	emptyState.DefaultPolicyRef = types.ObjectNull(resourcelink.AttrType())
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -2,12 +2,14 @@ package oauthtokenexchangegeneratorsettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -34,6 +36,7 @@ type oauthTokenExchangeGeneratorSettingsResource struct {
 
 type oauthTokenExchangeGeneratorSettingsResourceModel struct {
 	DefaultGeneratorGroupRef types.Object `tfsdk:"default_generator_group_ref"`
+	OnDestroy                types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -50,6 +53,7 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Schema(ctx context.Context
 		},
 	}
 
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -78,7 +82,17 @@ func readOauthTokenExchangeGeneratorSettingsResponse(ctx context.Context, r *cli
 	return diags
 }
 
+func (r *oauthTokenExchangeGeneratorSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.OauthTokenExchangeGeneratorAPI.GetOauthTokenExchangeSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original OAuth Token Exchange Generator Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *oauthTokenExchangeGeneratorSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var err error
 	var plan oauthTokenExchangeGeneratorSettingsResourceModel
 
@@ -88,6 +102,12 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Create(ctx context.Context
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createOauthTokenExchangeGeneratorSettings := client.NewTokenExchangeGeneratorSettings()
 	createOauthTokenExchangeGeneratorSettings.DefaultGeneratorGroupRef, err = resourcelink.ClientStruct(plan.DefaultGeneratorGroupRef)
 	if err != nil {
@@ -104,8 +124,9 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Create(ctx context.Context
 	}
 
 	// Read the response into the state
-	var state oauthTokenExchangeGeneratorSettingsResourceModel
-
+	state := oauthTokenExchangeGeneratorSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOauthTokenExchangeGeneratorSettingsResponse(ctx, oauthTokenExchangeGeneratorSettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -142,6 +163,7 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Read(ctx context.Context,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthTokenExchangeGeneratorSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var err error
 	var plan oauthTokenExchangeGeneratorSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -166,7 +188,9 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Update(ctx context.Context
 	}
 
 	// Read the response
-	var state oauthTokenExchangeGeneratorSettingsResourceModel
+	state := oauthTokenExchangeGeneratorSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readOauthTokenExchangeGeneratorSettingsResponse(ctx, updateOauthTokenExchangeGeneratorSettingsResponse, &state)
 	resp.Diagnostics.Append(diags...)
 
@@ -178,12 +202,23 @@ func (r *oauthTokenExchangeGeneratorSettingsResource) Update(ctx context.Context
 // This config object is edit-only, so Terraform can't delete it.
 func (r *oauthTokenExchangeGeneratorSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	providererror.WarnConfigurationCannotBeReset("pingfederate_oauth_token_exchange_generator_settings", &resp.Diagnostics)
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_oauth_token_exchange_generator_settings",
+		nil,
+		func(ctx context.Context, original client.TokenExchangeGeneratorSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.OauthTokenExchangeGeneratorAPI.UpdateOauthTokenExchangeSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *oauthTokenExchangeGeneratorSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState oauthTokenExchangeGeneratorSettingsResourceModel
 	emptyState.DefaultGeneratorGroupRef = types.ObjectNull(resourcelink.AttrType())
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
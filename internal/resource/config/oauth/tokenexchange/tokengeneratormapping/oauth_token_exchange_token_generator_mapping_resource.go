@@ -158,6 +158,7 @@ func readOauthTokenExchangeTokenGeneratorMappingResourceResponse(ctx context.Con
 }
 
 func (r *oauthTokenExchangeTokenGeneratorMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan oauthTokenExchangeTokenGeneratorMappingResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -226,6 +227,7 @@ func (r *oauthTokenExchangeTokenGeneratorMappingResource) Read(ctx context.Conte
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *oauthTokenExchangeTokenGeneratorMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan oauthTokenExchangeTokenGeneratorMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
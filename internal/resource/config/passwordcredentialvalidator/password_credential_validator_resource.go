@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -18,6 +19,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
@@ -340,6 +342,7 @@ func addOptionalPasswordCredentialValidatorFields(ctx context.Context, addReques
 }
 
 func (r *passwordCredentialValidatorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan passwordCredentialValidatorModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -419,6 +422,7 @@ func (r *passwordCredentialValidatorResource) Read(ctx context.Context, req reso
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *passwordCredentialValidatorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan passwordCredentialValidatorModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -479,7 +483,21 @@ func (r *passwordCredentialValidatorResource) Delete(ctx context.Context, req re
 }
 
 func (r *passwordCredentialValidatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("validator_id"), req, resp)
+	// Retrieve import ID, looking up the validator by name if requested, and save to validator_id attribute
+	validatorId, ok := importid.Resolve(ctx, req.ID, "pingfederate_password_credential_validator", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.PasswordCredentialValidatorsAPI.GetPasswordCredentialValidators(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, validator := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: validator.GetId(), Name: validator.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("validator_id"), validatorId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
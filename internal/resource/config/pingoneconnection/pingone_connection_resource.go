@@ -203,6 +203,7 @@ func readPingOneConnectionResponse(ctx context.Context, r *client.PingOneConnect
 }
 
 func (r *pingoneConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan pingOneConnectionResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -265,6 +266,7 @@ func (r *pingoneConnectionResource) Read(ctx context.Context, req resource.ReadR
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *pingoneConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan pingOneConnectionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
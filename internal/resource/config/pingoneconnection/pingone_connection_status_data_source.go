@@ -0,0 +1,238 @@
+package pingoneconnection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &pingoneConnectionStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &pingoneConnectionStatusDataSource{}
+
+	environmentsAttrTypes = map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+	usagesAttrTypes = map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"type":          types.StringType,
+		"category_id":   types.StringType,
+		"category_name": types.StringType,
+	}
+)
+
+const (
+	// Number of environments requested per page
+	environmentsPageSize = 100
+	// Upper bound on the number of pages of environments requested, in case the server doesn't honor paging
+	environmentsMaxPages = 100
+)
+
+func PingoneConnectionStatusDataSource() datasource.DataSource {
+	return &pingoneConnectionStatusDataSource{}
+}
+
+type pingoneConnectionStatusDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type pingoneConnectionStatusDataSourceModel struct {
+	ConnectionId     types.String `tfsdk:"connection_id"`
+	CredentialStatus types.String `tfsdk:"credential_status"`
+	Environments     types.List   `tfsdk:"environments"`
+	Usages           types.List   `tfsdk:"usages"`
+}
+
+func (r *pingoneConnectionStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pingone_connection_status"
+}
+
+func (r *pingoneConnectionStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *pingoneConnectionStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to retrieve the credential status of a PingOne connection, the PingOne environments its credential can access, and the PingFederate configuration that uses the connection.",
+		Attributes: map[string]schema.Attribute{
+			"connection_id": schema.StringAttribute{
+				Description: "The ID of the PingOne connection.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"credential_status": schema.StringAttribute{
+				Description: "The status of the PingOne connection credential, as reported by PingFederate. PingFederate does not report the expiry of the credential.",
+				Computed:    true,
+			},
+			"environments": schema.ListNestedAttribute{
+				Description: "The PingOne environments that the connection credential has access to.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the environment.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the environment.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the environment.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"usages": schema.ListNestedAttribute{
+				Description: "The PingFederate configuration objects, such as adapters, data stores and provisioners, that reference the PingOne connection.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the referencing object.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the referencing object.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the referencing object.",
+							Computed:    true,
+						},
+						"category_id": schema.StringAttribute{
+							Description: "The ID of the category of the referencing object.",
+							Computed:    true,
+						},
+						"category_name": schema.StringAttribute{
+							Description: "The name of the category of the referencing object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (state *pingoneConnectionStatusDataSourceModel) readClientResponse(credentialStatus *client.PingOneCredentialStatus, environments []client.PingOneEnvironment, usages *client.ResourceUsages) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	// credential_status
+	state.CredentialStatus = types.StringPointerValue(credentialStatus.PingOneCredentialStatus)
+	// environments
+	environmentValues := []attr.Value{}
+	for _, environment := range environments {
+		environmentValue, diags := types.ObjectValue(environmentsAttrTypes, map[string]attr.Value{
+			"id":   types.StringPointerValue(environment.Id),
+			"name": types.StringPointerValue(environment.Name),
+			"type": types.StringPointerValue(environment.Type),
+		})
+		respDiags.Append(diags...)
+		environmentValues = append(environmentValues, environmentValue)
+	}
+	state.Environments, diags = types.ListValue(types.ObjectType{AttrTypes: environmentsAttrTypes}, environmentValues)
+	respDiags.Append(diags...)
+	// usages
+	categoryNames := map[string]*string{}
+	for _, category := range usages.Categories {
+		if category.Id != nil {
+			categoryNames[*category.Id] = category.Name
+		}
+	}
+	usageValues := []attr.Value{}
+	for _, usage := range usages.Items {
+		categoryName := types.StringNull()
+		if usage.CategoryId != nil {
+			categoryName = types.StringPointerValue(categoryNames[*usage.CategoryId])
+		}
+		usageValue, diags := types.ObjectValue(usagesAttrTypes, map[string]attr.Value{
+			"id":            types.StringPointerValue(usage.Id),
+			"name":          types.StringPointerValue(usage.Name),
+			"type":          types.StringPointerValue(usage.Type),
+			"category_id":   types.StringPointerValue(usage.CategoryId),
+			"category_name": categoryName,
+		})
+		respDiags.Append(diags...)
+		usageValues = append(usageValues, usageValue)
+	}
+	state.Usages, diags = types.ListValue(types.ObjectType{AttrTypes: usagesAttrTypes}, usageValues)
+	respDiags.Append(diags...)
+	return respDiags
+}
+
+func (r *pingoneConnectionStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data pingoneConnectionStatusDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	authCtx := config.AuthContext(ctx, r.providerConfig)
+	connectionId := data.ConnectionId.ValueString()
+	credentialStatus, httpResp, err := r.apiClient.PingOneConnectionsAPI.GetCredentialStatus(authCtx, connectionId).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the PingOne connection credential status", err, httpResp)
+		return
+	}
+
+	var environments []client.PingOneEnvironment
+	seenEnvironments := map[string]bool{}
+	for page := int64(1); page <= environmentsMaxPages; page++ {
+		environmentsPage, httpResp, err := r.apiClient.PingOneConnectionsAPI.GetPingOneConnectionEnvironments(authCtx, connectionId).Page(page).NumberPerPage(environmentsPageSize).Execute()
+		if err != nil {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the PingOne connection environments", err, httpResp)
+			return
+		}
+		newEnvironments := 0
+		for _, environment := range environmentsPage.Items {
+			if environment.Id != nil && seenEnvironments[*environment.Id] {
+				continue
+			}
+			if environment.Id != nil {
+				seenEnvironments[*environment.Id] = true
+			}
+			environments = append(environments, environment)
+			newEnvironments++
+		}
+		if len(environmentsPage.Items) < environmentsPageSize || newEnvironments == 0 {
+			break
+		}
+	}
+
+	usages, httpResp, err := r.apiClient.PingOneConnectionsAPI.GetPingOneConnectionUsages(authCtx, connectionId).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the PingOne connection usages", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(credentialStatus, environments, usages)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+package pluginaction
+
+import (
+	"context"
+	"net/http"
+
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+)
+
+// Plugin types that expose actions through the admin API
+const (
+	pluginTypeDataStore             = "DATA_STORE"
+	pluginTypeIdpAdapter            = "IDP_ADAPTER"
+	pluginTypeNotificationPublisher = "NOTIFICATION_PUBLISHER"
+	pluginTypeOobAuthPlugin         = "OOB_AUTH_PLUGIN"
+	pluginTypeSecretManager         = "SECRET_MANAGER"
+	pluginTypeSpAdapter             = "SP_ADAPTER"
+)
+
+var pluginTypes = []string{
+	pluginTypeDataStore,
+	pluginTypeIdpAdapter,
+	pluginTypeNotificationPublisher,
+	pluginTypeOobAuthPlugin,
+	pluginTypeSecretManager,
+	pluginTypeSpAdapter,
+}
+
+const pluginTypeDescription = "The type of plugin. Options are `DATA_STORE`, `IDP_ADAPTER`, `NOTIFICATION_PUBLISHER`, `OOB_AUTH_PLUGIN`, `SECRET_MANAGER`, `SP_ADAPTER`."
+
+func getActions(ctx context.Context, apiClient *client.APIClient, pluginType, pluginId string) (*client.Actions, *http.Response, error) {
+	switch pluginType {
+	case pluginTypeDataStore:
+		return apiClient.DataStoresAPI.GetDataStoresActions(ctx, pluginId).Execute()
+	case pluginTypeIdpAdapter:
+		return apiClient.IdpAdaptersAPI.GetIdpAdaptersActions(ctx, pluginId).Execute()
+	case pluginTypeNotificationPublisher:
+		return apiClient.NotificationPublishersAPI.GetNotificationPublisherActions(ctx, pluginId).Execute()
+	case pluginTypeOobAuthPlugin:
+		return apiClient.OauthOutOfBandAuthPluginsAPI.GetOOBActions(ctx, pluginId).Execute()
+	case pluginTypeSecretManager:
+		return apiClient.SecretManagersAPI.GetSecretManagersActions(ctx, pluginId).Execute()
+	default:
+		return apiClient.SpAdaptersAPI.GetSpAdaptersActions(ctx, pluginId).Execute()
+	}
+}
+
+func getAction(ctx context.Context, apiClient *client.APIClient, pluginType, pluginId, actionId string) (*client.Action, *http.Response, error) {
+	switch pluginType {
+	case pluginTypeDataStore:
+		return apiClient.DataStoresAPI.GetDataStoresActionById(ctx, pluginId, actionId).Execute()
+	case pluginTypeIdpAdapter:
+		return apiClient.IdpAdaptersAPI.GetIdpAdaptersActionById(ctx, pluginId, actionId).Execute()
+	case pluginTypeNotificationPublisher:
+		return apiClient.NotificationPublishersAPI.GetNotificationPublishersAction(ctx, pluginId, actionId).Execute()
+	case pluginTypeOobAuthPlugin:
+		return apiClient.OauthOutOfBandAuthPluginsAPI.GetOOBAction(ctx, pluginId, actionId).Execute()
+	case pluginTypeSecretManager:
+		return apiClient.SecretManagersAPI.GetSecretManagersAction(ctx, pluginId, actionId).Execute()
+	default:
+		return apiClient.SpAdaptersAPI.GetSpAdaptersActionById(ctx, pluginId, actionId).Execute()
+	}
+}
+
+func invokeAction(ctx context.Context, apiClient *client.APIClient, pluginType, pluginId, actionId string, options client.ActionOptions) (*client.ActionResult, *http.Response, error) {
+	switch pluginType {
+	case pluginTypeDataStore:
+		return apiClient.DataStoresAPI.InvokeActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	case pluginTypeIdpAdapter:
+		return apiClient.IdpAdaptersAPI.InvokeIdpAdaptersActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	case pluginTypeNotificationPublisher:
+		return apiClient.NotificationPublishersAPI.InvokeNotificationPublishersActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	case pluginTypeOobAuthPlugin:
+		return apiClient.OauthOutOfBandAuthPluginsAPI.InvokeOOBActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	case pluginTypeSecretManager:
+		return apiClient.SecretManagersAPI.InvokeSecretManagersActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	default:
+		return apiClient.SpAdaptersAPI.InvokeSpAdapterActionWithOptions(ctx, pluginId, actionId).Body(options).Execute()
+	}
+}
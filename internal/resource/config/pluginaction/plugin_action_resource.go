@@ -0,0 +1,223 @@
+package pluginaction
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource               = &pluginActionResource{}
+	_ resource.ResourceWithConfigure  = &pluginActionResource{}
+	_ resource.ResourceWithModifyPlan = &pluginActionResource{}
+)
+
+func PluginActionResource() resource.Resource {
+	return &pluginActionResource{}
+}
+
+type pluginActionResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type pluginActionResourceModel struct {
+	PluginType          types.String `tfsdk:"plugin_type"`
+	PluginId            types.String `tfsdk:"plugin_id"`
+	ActionId            types.String `tfsdk:"action_id"`
+	Parameters          types.Map    `tfsdk:"parameters"`
+	ActionTriggerValues types.Map    `tfsdk:"action_trigger_values"`
+	ResultMessage       types.String `tfsdk:"result_message"`
+}
+
+func (r *pluginActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_action"
+}
+
+func (r *pluginActionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *pluginActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to invoke an action on a PingFederate plugin instance, such as clearing an adapter's cache. The action is invoked when the resource is created and whenever the trigger values change. Actions that produce a file download are not supported. Password credential validators and outbound provisioners do not expose actions through the administrative API.",
+		Attributes: map[string]schema.Attribute{
+			"plugin_type": schema.StringAttribute{
+				Description: pluginTypeDescription + " This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(pluginTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plugin_id": schema.StringAttribute{
+				Description: "The ID of the plugin instance. This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action_id": schema.StringAttribute{
+				Description: "The ID of the action to invoke. The available actions for a plugin instance can be listed with the `pingfederate_plugin_actions` data source. This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				Description: "The parameters to pass to the action, keyed by parameter name. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"action_trigger_values": schema.MapAttribute{
+				Description: "A meta-argument map of values that, if any values are changed, will force the action to be invoked again. Adding values to and removing values from the map will not invoke the action.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"result_message": schema.StringAttribute{
+				Description: "The message returned by PingFederate when the action was invoked.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Invoke the action again via RequiresReplace when the trigger values change
+func (r *pluginActionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destruction plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.Map
+	var planValues, stateValues map[string]attr.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("action_trigger_values"), &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValues = plan.Elements()
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("action_trigger_values"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateValues = state.Elements()
+
+	for k, v := range planValues {
+		if stateValue, ok := stateValues[k]; ok && (v == types.StringUnknown() || !stateValue.Equal(v)) {
+			resp.RequiresReplace = path.Paths{path.Root("action_trigger_values")}
+			break
+		}
+	}
+}
+
+func (r *pluginActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data pluginActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pluginType := data.PluginType.ValueString()
+	pluginId := data.PluginId.ValueString()
+	actionId := data.ActionId.ValueString()
+
+	// Confirm the action exists and does not produce a download before invoking it
+	action, httpResp, err := getAction(config.AuthContext(ctx, r.providerConfig), r.apiClient, pluginType, pluginId, actionId)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the plugin action", err, httpResp)
+		return
+	}
+	if action.GetDownload() {
+		resp.Diagnostics.AddAttributeError(path.Root("action_id"), providererror.InvalidAttributeConfiguration,
+			"The action '"+actionId+"' produces a file download, which is not supported by this resource")
+		return
+	}
+
+	options := client.ActionOptions{Parameters: []client.ActionParameter{}}
+	parameterNames := []string{}
+	for name := range data.Parameters.Elements() {
+		parameterNames = append(parameterNames, name)
+	}
+	sort.Strings(parameterNames)
+	for _, name := range parameterNames {
+		value := data.Parameters.Elements()[name].(types.String)
+		options.Parameters = append(options.Parameters, client.ActionParameter{
+			Name:  name,
+			Value: value.ValueStringPointer(),
+		})
+	}
+
+	responseData, httpResp, err := invokeAction(config.AuthContext(ctx, r.providerConfig), r.apiClient, pluginType, pluginId, actionId, options)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while invoking the plugin action", err, httpResp)
+		return
+	}
+
+	data.ResultMessage = types.StringPointerValue(responseData.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pluginActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// PingFederate provides no read endpoint for invoked actions, so we'll just maintain whatever is in state
+	resp.State.Raw = req.State.Raw
+}
+
+func (r *pluginActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// This will only happen when adding or removing action trigger values.
+	// Just copy the existing result message and the planned values into state.
+	var plan, state pluginActionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ResultMessage = state.ResultMessage
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// An invoked action can't be undone, so there is nothing to do on delete.
+func (r *pluginActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,196 @@
+package pluginaction
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &pluginActionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pluginActionsDataSource{}
+
+	parametersAttrTypes = map[string]attr.Type{
+		"name":          types.StringType,
+		"description":   types.StringType,
+		"type":          types.StringType,
+		"required":      types.BoolType,
+		"default_value": types.StringType,
+	}
+	actionsAttrTypes = map[string]attr.Type{
+		"action_id":   types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"download":    types.BoolType,
+		"parameters":  types.ListType{ElemType: types.ObjectType{AttrTypes: parametersAttrTypes}},
+	}
+)
+
+func PluginActionsDataSource() datasource.DataSource {
+	return &pluginActionsDataSource{}
+}
+
+type pluginActionsDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type pluginActionsDataSourceModel struct {
+	PluginType types.String `tfsdk:"plugin_type"`
+	PluginId   types.String `tfsdk:"plugin_id"`
+	Actions    types.List   `tfsdk:"actions"`
+}
+
+func (r *pluginActionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin_actions"
+}
+
+func (r *pluginActionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *pluginActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to list the actions available on a PingFederate plugin instance.",
+		Attributes: map[string]schema.Attribute{
+			"plugin_type": schema.StringAttribute{
+				Description: pluginTypeDescription,
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(pluginTypes...),
+				},
+			},
+			"plugin_id": schema.StringAttribute{
+				Description: "The ID of the plugin instance.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The actions available on the plugin instance.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action_id": schema.StringAttribute{
+							Description: "The ID of the action.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the action.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the action.",
+							Computed:    true,
+						},
+						"download": schema.BoolAttribute{
+							Description: "Whether the action produces a file download.",
+							Computed:    true,
+						},
+						"parameters": schema.ListNestedAttribute{
+							Description: "The parameters accepted by the action.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the parameter.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "The description of the parameter.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The type of the parameter.",
+										Computed:    true,
+									},
+									"required": schema.BoolAttribute{
+										Description: "Whether a value is required for the parameter.",
+										Computed:    true,
+									},
+									"default_value": schema.StringAttribute{
+										Description: "The default value of the parameter.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (state *pluginActionsDataSourceModel) readClientResponse(response *client.Actions) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	actionValues := []attr.Value{}
+	for _, action := range response.Items {
+		parameterValues := []attr.Value{}
+		for _, parameter := range action.Parameters {
+			parameterValue, diags := types.ObjectValue(parametersAttrTypes, map[string]attr.Value{
+				"name":          types.StringPointerValue(parameter.Name),
+				"description":   types.StringPointerValue(parameter.Description),
+				"type":          types.StringPointerValue(parameter.Type),
+				"required":      types.BoolPointerValue(parameter.Required),
+				"default_value": types.StringPointerValue(parameter.DefaultValue),
+			})
+			respDiags.Append(diags...)
+			parameterValues = append(parameterValues, parameterValue)
+		}
+		parametersValue, diags := types.ListValue(types.ObjectType{AttrTypes: parametersAttrTypes}, parameterValues)
+		respDiags.Append(diags...)
+
+		actionValue, diags := types.ObjectValue(actionsAttrTypes, map[string]attr.Value{
+			"action_id":   types.StringPointerValue(action.Id),
+			"name":        types.StringPointerValue(action.Name),
+			"description": types.StringPointerValue(action.Description),
+			"download":    types.BoolPointerValue(action.Download),
+			"parameters":  parametersValue,
+		})
+		respDiags.Append(diags...)
+		actionValues = append(actionValues, actionValue)
+	}
+	state.Actions, diags = types.ListValue(types.ObjectType{AttrTypes: actionsAttrTypes}, actionValues)
+	respDiags.Append(diags...)
+	return respDiags
+}
+
+func (r *pluginActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data pluginActionsDataSourceModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	responseData, httpResp, err := getActions(config.AuthContext(ctx, r.providerConfig), r.apiClient, data.PluginType.ValueString(), data.PluginId.ValueString())
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while listing the plugin actions", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
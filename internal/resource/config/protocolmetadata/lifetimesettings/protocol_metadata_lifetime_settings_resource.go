@@ -2,11 +2,15 @@ package protocolmetadatalifetimesettings
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -31,6 +35,11 @@ type protocolMetadataLifetimeSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type protocolMetadataLifetimeSettingsResourceModel struct {
+	protocolMetadataLifetimeSettingsModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *protocolMetadataLifetimeSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -50,6 +59,7 @@ func (r *protocolMetadataLifetimeSettingsResource) Schema(ctx context.Context, r
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -87,8 +97,18 @@ func (m *protocolMetadataLifetimeSettingsModel) buildDefaultClientStruct() *clie
 	}
 }
 
+func (r *protocolMetadataLifetimeSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ProtocolMetadataAPI.GetLifetimeSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Protocol Metadata Lifetime Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *protocolMetadataLifetimeSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan protocolMetadataLifetimeSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan protocolMetadataLifetimeSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -96,9 +116,15 @@ func (r *protocolMetadataLifetimeSettingsResource) Create(ctx context.Context, r
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateProtocolMetadataLifetimeSettings := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewMetadataLifetimeSettings()
-	err := addOptionalProtocolMetadataLifetimeSettingsFields(ctx, createUpdateRequest, plan)
+	err := addOptionalProtocolMetadataLifetimeSettingsFields(ctx, createUpdateRequest, plan.protocolMetadataLifetimeSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Protocol Metadata Lifetime Settings: "+err.Error())
 		return
@@ -112,15 +138,17 @@ func (r *protocolMetadataLifetimeSettingsResource) Create(ctx context.Context, r
 	}
 
 	// Read the response into the state
-	var state protocolMetadataLifetimeSettingsModel
-	readProtocolMetadataLifetimeSettingsResponse(ctx, protocolMetadataLifetimeSettingsResponse, &state)
+	state := protocolMetadataLifetimeSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readProtocolMetadataLifetimeSettingsResponse(ctx, protocolMetadataLifetimeSettingsResponse, &state.protocolMetadataLifetimeSettingsModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *protocolMetadataLifetimeSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state protocolMetadataLifetimeSettingsModel
+	var state protocolMetadataLifetimeSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -139,7 +167,7 @@ func (r *protocolMetadataLifetimeSettingsResource) Read(ctx context.Context, req
 	}
 
 	// Read the response into the state
-	readProtocolMetadataLifetimeSettingsResponse(ctx, apiReadProtocolMetadataLifetimeSettings, &state)
+	readProtocolMetadataLifetimeSettingsResponse(ctx, apiReadProtocolMetadataLifetimeSettings, &state.protocolMetadataLifetimeSettingsModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -148,7 +176,8 @@ func (r *protocolMetadataLifetimeSettingsResource) Read(ctx context.Context, req
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *protocolMetadataLifetimeSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan protocolMetadataLifetimeSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan protocolMetadataLifetimeSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -158,7 +187,7 @@ func (r *protocolMetadataLifetimeSettingsResource) Update(ctx context.Context, r
 
 	updateProtocolMetadataLifetimeSettings := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewMetadataLifetimeSettings()
-	err := addOptionalProtocolMetadataLifetimeSettingsFields(ctx, createUpdateRequest, plan)
+	err := addOptionalProtocolMetadataLifetimeSettingsFields(ctx, createUpdateRequest, plan.protocolMetadataLifetimeSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Protocol Metadata Lifetime Settings: "+err.Error())
 		return
@@ -172,8 +201,10 @@ func (r *protocolMetadataLifetimeSettingsResource) Update(ctx context.Context, r
 	}
 
 	// Read the response into the state
-	var state protocolMetadataLifetimeSettingsModel
-	readProtocolMetadataLifetimeSettingsResponse(ctx, protocolMetadataLifetimeSettingsResponse, &state)
+	state := protocolMetadataLifetimeSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readProtocolMetadataLifetimeSettingsResponse(ctx, protocolMetadataLifetimeSettingsResponse, &state.protocolMetadataLifetimeSettingsModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -182,19 +213,27 @@ func (r *protocolMetadataLifetimeSettingsResource) Update(ctx context.Context, r
 // This config object is edit-only, so Terraform can't delete it.
 func (r *protocolMetadataLifetimeSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model protocolMetadataLifetimeSettingsModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the protocol metadata lifetime settings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_protocol_metadata_lifetime_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			var model protocolMetadataLifetimeSettingsModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.MetadataLifetimeSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateLifetimeSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *protocolMetadataLifetimeSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState protocolMetadataLifetimeSettingsModel
+	var emptyState protocolMetadataLifetimeSettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
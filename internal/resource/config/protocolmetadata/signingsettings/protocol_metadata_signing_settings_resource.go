@@ -4,19 +4,25 @@ package protocolmetadatasigningsettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 )
 
 func (r *protocolMetadataSigningSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this will reset the resource to its default state.
-	apiUpdateRequest := r.apiClient.ProtocolMetadataAPI.UpdateSigningSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(client.MetadataSigningSettings{})
-	_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateSigningSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the protocolMetadataSigningSettings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_protocol_metadata_signing_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateSigningSettings(config.AuthContext(ctx, r.providerConfig)).Body(client.MetadataSigningSettings{}).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.MetadataSigningSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ProtocolMetadataAPI.UpdateSigningSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -50,6 +51,7 @@ func (r *protocolMetadataSigningSettingsResource) Configure(_ context.Context, r
 type protocolMetadataSigningSettingsResourceModel struct {
 	SignatureAlgorithm types.String `tfsdk:"signature_algorithm"`
 	SigningKeyRef      types.Object `tfsdk:"signing_key_ref"`
+	OnDestroy          types.String `tfsdk:"on_destroy"`
 }
 
 func (r *protocolMetadataSigningSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -79,6 +81,7 @@ func (r *protocolMetadataSigningSettingsResource) Schema(ctx context.Context, re
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *protocolMetadataSigningSettingsResourceModel) buildClientStruct() (*client.MetadataSigningSettings, diag.Diagnostics) {
@@ -129,7 +132,17 @@ func (r *protocolMetadataSigningSettingsResource) emptyModel() protocolMetadataS
 	return model
 }
 
+func (r *protocolMetadataSigningSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ProtocolMetadataAPI.GetSigningSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original protocolMetadataSigningSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *protocolMetadataSigningSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data protocolMetadataSigningSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -139,6 +152,12 @@ func (r *protocolMetadataSigningSettingsResource) Create(ctx context.Context, re
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -187,6 +206,7 @@ func (r *protocolMetadataSigningSettingsResource) Read(ctx context.Context, req
 }
 
 func (r *protocolMetadataSigningSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data protocolMetadataSigningSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -217,5 +237,9 @@ func (r *protocolMetadataSigningSettingsResource) Update(ctx context.Context, re
 func (r *protocolMetadataSigningSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -0,0 +1,479 @@
+package redirectvalidationallowedurl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/readmodifywrite"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config/redirectvalidation"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/utils"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/version"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &redirectValidationAllowedUrlResource{}
+	_ resource.ResourceWithConfigure      = &redirectValidationAllowedUrlResource{}
+	_ resource.ResourceWithImportState    = &redirectValidationAllowedUrlResource{}
+	_ resource.ResourceWithModifyPlan     = &redirectValidationAllowedUrlResource{}
+	_ resource.ResourceWithValidateConfig = &redirectValidationAllowedUrlResource{}
+)
+
+const uriIdPrefix = "uri:"
+
+// RedirectValidationAllowedUrlResource is a helper function to simplify the provider implementation.
+func RedirectValidationAllowedUrlResource() resource.Resource {
+	return &redirectValidationAllowedUrlResource{}
+}
+
+// redirectValidationAllowedUrlResource is the resource implementation.
+type redirectValidationAllowedUrlResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type redirectValidationAllowedUrlModel struct {
+	Id                    types.String `tfsdk:"id"`
+	ValidDomain           types.String `tfsdk:"valid_domain"`
+	ValidPath             types.String `tfsdk:"valid_path"`
+	ValidUri              types.String `tfsdk:"valid_uri"`
+	TargetResourceSso     types.Bool   `tfsdk:"target_resource_sso"`
+	TargetResourceSlo     types.Bool   `tfsdk:"target_resource_slo"`
+	InErrorResource       types.Bool   `tfsdk:"in_error_resource"`
+	IdpDiscovery          types.Bool   `tfsdk:"idp_discovery"`
+	AllowQueryAndFragment types.Bool   `tfsdk:"allow_query_and_fragment"`
+	RequireHttps          types.Bool   `tfsdk:"require_https"`
+}
+
+// An entry in either the white list or the URI allow list of the redirect validation local settings
+type allowedUrl struct {
+	whiteListEntry    *client.RedirectValidationSettingsWhitelistEntry
+	uriAllowListEntry *client.RedirectValidationSettingsUriAllowlistEntry
+}
+
+func whiteListKey(validDomain, validPath string) string {
+	return validDomain + validPath
+}
+
+func uriAllowListKey(validUri string) string {
+	return uriIdPrefix + validUri
+}
+
+func (a allowedUrl) key() string {
+	if a.uriAllowListEntry != nil {
+		return uriAllowListKey(a.uriAllowListEntry.ValidUri)
+	}
+	return whiteListKey(a.whiteListEntry.ValidDomain, a.whiteListEntry.GetValidPath())
+}
+
+func boolFlag(value types.Bool) *bool {
+	return utils.Pointer(value.ValueBool())
+}
+
+// GetSchema defines the schema for the resource.
+func (r *redirectValidationAllowedUrlResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a single valid target resource for redirect validation. Other entries are left unchanged, so this resource can be used by multiple configurations at once. Entries with `valid_domain` are added to `redirect_validation_local_settings.white_list`, and entries with `valid_uri` are added to `redirect_validation_local_settings.uri_allow_list`. Validation itself is enabled with the `pingfederate_redirect_validation` resource, which must have `ignore_unmanaged_allowed_urls` set to `true` when used alongside this resource.",
+		Attributes: map[string]schema.Attribute{
+			"valid_domain": schema.StringAttribute{
+				Description: "Domain of a valid resource. Exactly one of `valid_domain` or `valid_uri` must be set. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("valid_uri")),
+				},
+			},
+			"valid_path": schema.StringAttribute{
+				Description: "Path of a valid resource. Only applies when `valid_domain` is set. This field is immutable and will trigger a replacement plan if changed. The default value is an empty string.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("valid_uri")),
+				},
+			},
+			"valid_uri": schema.StringAttribute{
+				Description: "URI of a valid resource. Exactly one of `valid_domain` or `valid_uri` must be set. Supported in PF version `12.1` or later. This field is immutable and will trigger a replacement plan if changed.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_resource_sso": schema.BoolAttribute{
+				Description: "Enable this target resource for SSO redirect validation. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"target_resource_slo": schema.BoolAttribute{
+				Description: "Enable this target resource for SLO redirect validation. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"in_error_resource": schema.BoolAttribute{
+				Description: "Enable this target resource for in error resource validation. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"idp_discovery": schema.BoolAttribute{
+				Description: "Enable this target resource for IdP discovery validation. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"allow_query_and_fragment": schema.BoolAttribute{
+				Description: "Allow any query parameters and fragment in the resource. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"require_https": schema.BoolAttribute{
+				Description: "Require HTTPS for accessing this resource. Only applies when `valid_domain` is set. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("valid_uri")),
+				},
+			},
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+func (r *redirectValidationAllowedUrlResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model redirectValidationAllowedUrlModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The valid_path is appended to the valid_domain to build the identifier of the entry
+	if internaltypes.IsDefined(model.ValidPath) && model.ValidPath.ValueString() != "" && !strings.HasPrefix(model.ValidPath.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("valid_path"),
+			providererror.InvalidAttributeConfiguration,
+			"valid_path must be empty or start with '/'")
+	}
+}
+
+func (r *redirectValidationAllowedUrlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *redirectValidationAllowedUrlModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if plan == nil || !internaltypes.IsDefined(plan.ValidUri) {
+		return
+	}
+
+	// The URI allow list was added in PF 12.1
+	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1210)
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to compare PingFederate versions: "+err.Error())
+		return
+	}
+	if compare < 0 {
+		version.AddUnsupportedAttributeError("valid_uri",
+			r.providerConfig.ProductVersion, version.PingFederate1210, &resp.Diagnostics)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *redirectValidationAllowedUrlResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redirect_validation_allowed_url"
+}
+
+func (r *redirectValidationAllowedUrlResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+// The white list and URI allow list are read and written together, along with the rest of the redirect validation settings
+func (r *redirectValidationAllowedUrlResource) operations() readmodifywrite.Operations[allowedUrl] {
+	var current *client.RedirectValidationSettings
+	return readmodifywrite.Operations[allowedUrl]{
+		Key: redirectvalidation.ReadModifyWriteKey,
+		Get: func(ctx context.Context) ([]allowedUrl, *http.Response, error) {
+			response, httpResp, err := r.apiClient.RedirectValidationAPI.GetRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			current = response
+			var items []allowedUrl
+			if response.RedirectValidationLocalSettings != nil {
+				for i := range response.RedirectValidationLocalSettings.WhiteList {
+					items = append(items, allowedUrl{whiteListEntry: &response.RedirectValidationLocalSettings.WhiteList[i]})
+				}
+				for i := range response.RedirectValidationLocalSettings.UriAllowList {
+					items = append(items, allowedUrl{uriAllowListEntry: &response.RedirectValidationLocalSettings.UriAllowList[i]})
+				}
+			}
+			return items, httpResp, nil
+		},
+		Put: func(ctx context.Context, items []allowedUrl) (*http.Response, error) {
+			body := *current
+			localSettings := client.RedirectValidationLocalSettings{}
+			if body.RedirectValidationLocalSettings != nil {
+				localSettings = *body.RedirectValidationLocalSettings
+			}
+			localSettings.WhiteList = []client.RedirectValidationSettingsWhitelistEntry{}
+			uriAllowList := []client.RedirectValidationSettingsUriAllowlistEntry{}
+			for _, item := range items {
+				if item.uriAllowListEntry != nil {
+					uriAllowList = append(uriAllowList, *item.uriAllowListEntry)
+				} else {
+					localSettings.WhiteList = append(localSettings.WhiteList, *item.whiteListEntry)
+				}
+			}
+			// Leave the URI allow list out of the request on versions that don't support it
+			if localSettings.UriAllowList != nil || len(uriAllowList) > 0 {
+				localSettings.UriAllowList = uriAllowList
+			}
+			body.RedirectValidationLocalSettings = &localSettings
+			_, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Body(body).Execute()
+			return httpResp, err
+		},
+	}
+}
+
+func (model *redirectValidationAllowedUrlModel) buildClientStruct() allowedUrl {
+	if internaltypes.IsDefined(model.ValidUri) {
+		return allowedUrl{
+			uriAllowListEntry: &client.RedirectValidationSettingsUriAllowlistEntry{
+				ValidUri:              model.ValidUri.ValueString(),
+				TargetResourceSSO:     boolFlag(model.TargetResourceSso),
+				TargetResourceSLO:     boolFlag(model.TargetResourceSlo),
+				InErrorResource:       boolFlag(model.InErrorResource),
+				IdpDiscovery:          boolFlag(model.IdpDiscovery),
+				AllowQueryAndFragment: boolFlag(model.AllowQueryAndFragment),
+			},
+		}
+	}
+	return allowedUrl{
+		whiteListEntry: &client.RedirectValidationSettingsWhitelistEntry{
+			ValidDomain:           model.ValidDomain.ValueString(),
+			ValidPath:             model.ValidPath.ValueStringPointer(),
+			TargetResourceSSO:     boolFlag(model.TargetResourceSso),
+			TargetResourceSLO:     boolFlag(model.TargetResourceSlo),
+			InErrorResource:       boolFlag(model.InErrorResource),
+			IdpDiscovery:          boolFlag(model.IdpDiscovery),
+			AllowQueryAndFragment: boolFlag(model.AllowQueryAndFragment),
+			RequireHttps:          boolFlag(model.RequireHttps),
+		},
+	}
+}
+
+func (state *redirectValidationAllowedUrlModel) readClientResponse(response allowedUrl) {
+	state.Id = types.StringValue(response.key())
+	if response.uriAllowListEntry != nil {
+		entry := response.uriAllowListEntry
+		state.ValidDomain = types.StringNull()
+		state.ValidPath = types.StringValue("")
+		state.ValidUri = types.StringValue(entry.ValidUri)
+		state.TargetResourceSso = types.BoolValue(entry.GetTargetResourceSSO())
+		state.TargetResourceSlo = types.BoolValue(entry.GetTargetResourceSLO())
+		state.InErrorResource = types.BoolValue(entry.GetInErrorResource())
+		state.IdpDiscovery = types.BoolValue(entry.GetIdpDiscovery())
+		state.AllowQueryAndFragment = types.BoolValue(entry.GetAllowQueryAndFragment())
+		state.RequireHttps = types.BoolValue(false)
+		return
+	}
+	entry := response.whiteListEntry
+	state.ValidDomain = types.StringValue(entry.ValidDomain)
+	state.ValidPath = types.StringValue(entry.GetValidPath())
+	state.ValidUri = types.StringNull()
+	state.TargetResourceSso = types.BoolValue(entry.GetTargetResourceSSO())
+	state.TargetResourceSlo = types.BoolValue(entry.GetTargetResourceSLO())
+	state.InErrorResource = types.BoolValue(entry.GetInErrorResource())
+	state.IdpDiscovery = types.BoolValue(entry.GetIdpDiscovery())
+	state.AllowQueryAndFragment = types.BoolValue(entry.GetAllowQueryAndFragment())
+	state.RequireHttps = types.BoolValue(entry.GetRequireHttps())
+}
+
+func indexOf(items []allowedUrl, key string) int {
+	return slices.IndexFunc(items, func(item allowedUrl) bool {
+		return item.key() == key
+	})
+}
+
+func entryMatches(item, desired allowedUrl) bool {
+	if desired.uriAllowListEntry != nil {
+		return item.uriAllowListEntry != nil &&
+			item.uriAllowListEntry.GetTargetResourceSSO() == desired.uriAllowListEntry.GetTargetResourceSSO() &&
+			item.uriAllowListEntry.GetTargetResourceSLO() == desired.uriAllowListEntry.GetTargetResourceSLO() &&
+			item.uriAllowListEntry.GetInErrorResource() == desired.uriAllowListEntry.GetInErrorResource() &&
+			item.uriAllowListEntry.GetIdpDiscovery() == desired.uriAllowListEntry.GetIdpDiscovery() &&
+			item.uriAllowListEntry.GetAllowQueryAndFragment() == desired.uriAllowListEntry.GetAllowQueryAndFragment()
+	}
+	return item.whiteListEntry != nil &&
+		item.whiteListEntry.GetTargetResourceSSO() == desired.whiteListEntry.GetTargetResourceSSO() &&
+		item.whiteListEntry.GetTargetResourceSLO() == desired.whiteListEntry.GetTargetResourceSLO() &&
+		item.whiteListEntry.GetInErrorResource() == desired.whiteListEntry.GetInErrorResource() &&
+		item.whiteListEntry.GetIdpDiscovery() == desired.whiteListEntry.GetIdpDiscovery() &&
+		item.whiteListEntry.GetAllowQueryAndFragment() == desired.whiteListEntry.GetAllowQueryAndFragment() &&
+		item.whiteListEntry.GetRequireHttps() == desired.whiteListEntry.GetRequireHttps()
+}
+
+// Add or replace this entry in the lists, and return the resulting entry from PingFederate
+func (r *redirectValidationAllowedUrlResource) upsert(ctx context.Context, plan *redirectValidationAllowedUrlModel, allowExisting bool) (*allowedUrl, *http.Response, error) {
+	desired := plan.buildClientStruct()
+	key := desired.key()
+	items, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []allowedUrl) ([]allowedUrl, error) {
+			i := indexOf(items, key)
+			if i < 0 {
+				return append(items, desired), nil
+			}
+			if !allowExisting {
+				return nil, fmt.Errorf("a redirect validation entry for %q already exists. Import it to manage it with this resource", key)
+			}
+			items[i] = desired
+			return items, nil
+		},
+		func(items []allowedUrl) bool {
+			i := indexOf(items, key)
+			return i >= 0 && entryMatches(items[i], desired)
+		})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &items[indexOf(items, key)], httpResp, nil
+}
+
+func (r *redirectValidationAllowedUrlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan redirectValidationAllowedUrlModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.upsert(ctx, &plan, false)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while adding the redirect validation allowed URL", err, httpResp)
+		return
+	}
+
+	plan.readClientResponse(*responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *redirectValidationAllowedUrlResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state redirectValidationAllowedUrlModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, httpResp, err := r.operations().Get(ctx)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the redirect validation settings", err, httpResp)
+		return
+	}
+
+	i := indexOf(items, state.buildClientStruct().key())
+	if i < 0 {
+		config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Redirect Validation Allowed URL", nil)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.readClientResponse(items[i])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *redirectValidationAllowedUrlResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan redirectValidationAllowedUrlModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	responseData, httpResp, err := r.upsert(ctx, &plan, true)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the redirect validation allowed URL", err, httpResp)
+		return
+	}
+
+	plan.readClientResponse(*responseData)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes only this entry, leaving any others in place.
+func (r *redirectValidationAllowedUrlResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state redirectValidationAllowedUrlModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := state.buildClientStruct().key()
+	_, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []allowedUrl) ([]allowedUrl, error) {
+			return slices.DeleteFunc(items, func(item allowedUrl) bool {
+				return item.key() == key
+			}), nil
+		},
+		func(items []allowedUrl) bool {
+			return indexOf(items, key) < 0
+		})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while removing the redirect validation allowed URL", err, httpResp)
+	}
+}
+
+func (r *redirectValidationAllowedUrlResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID is either "uri:<valid_uri>" for URI allow list entries,
+	// or the valid_domain followed by the valid_path for white list entries.
+	if validUri, ok := strings.CutPrefix(req.ID, uriIdPrefix); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("valid_uri"), validUri)...)
+		return
+	}
+	validDomain, validPath, found := strings.Cut(req.ID, "/")
+	if found {
+		validPath = "/" + validPath
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("valid_domain"), validDomain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("valid_path"), validPath)...)
+}
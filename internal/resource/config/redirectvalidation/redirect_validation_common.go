@@ -24,3 +24,6 @@ func readRedirectValidationResponse(ctx context.Context, r *client.RedirectValid
 	state.RedirectValidationPartnerSettings = redirectValidationPartnerSettingsObjVal
 	return diags
 }
+
+// Key used to serialize read-modify-write updates of the redirect validation settings
+const ReadModifyWriteKey = "redirect_validation"
@@ -3,6 +3,8 @@ package redirectvalidation
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/readmodifywrite"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -90,11 +94,23 @@ type redirectValidationResource struct {
 	apiClient      *client.APIClient
 }
 
+type redirectValidationResourceModel struct {
+	redirectValidationModel
+	IgnoreUnmanagedAllowedUrls types.Bool   `tfsdk:"ignore_unmanaged_allowed_urls"`
+	OnDestroy                  types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *redirectValidationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
 		Description: "Manages the settings for redirect validation.",
 		Attributes: map[string]schema.Attribute{
+			"ignore_unmanaged_allowed_urls": schema.BoolAttribute{
+				Description: "Whether entries in `redirect_validation_local_settings.white_list` and `redirect_validation_local_settings.uri_allow_list` that are not configured in this resource are left unchanged. Set to `true` when entries are also managed with the `pingfederate_redirect_validation_allowed_url` resource. When `true`, only the configured entries are tracked in this resource's state, and only those entries are removed when the resource is destroyed. The default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"redirect_validation_local_settings": schema.SingleNestedAttribute{
 				Description: "Settings for local redirect validation.",
 				Computed:    true,
@@ -248,6 +264,7 @@ func (r *redirectValidationResource) Schema(ctx context.Context, req resource.Sc
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -259,7 +276,7 @@ func (r *redirectValidationResource) ModifyPlan(ctx context.Context, req resourc
 		return
 	}
 	pfVersionAtLeast121 := compare >= 0
-	var plan *redirectValidationModel
+	var plan *redirectValidationResourceModel
 	req.Plan.Get(ctx, &plan)
 	if plan == nil {
 		return
@@ -353,8 +370,18 @@ func (m *redirectValidationModel) buildDefaultClientStruct(productVersion versio
 	return result
 }
 
+func (r *redirectValidationResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.RedirectValidationAPI.GetRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Redirect Validation", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *redirectValidationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan redirectValidationModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan redirectValidationResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -362,31 +389,50 @@ func (r *redirectValidationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createRedirectValidation := client.NewRedirectValidationSettings()
-	err := addOptionalRedirectValidationFields(ctx, createRedirectValidation, plan)
+	err := addOptionalRedirectValidationFields(ctx, createRedirectValidation, plan.redirectValidationModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Redirect Validation: "+err.Error())
 		return
 	}
 
-	apiCreateRedirectValidation := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
-	apiCreateRedirectValidation = apiCreateRedirectValidation.Body(*createRedirectValidation)
-	redirectValidationResponse, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(apiCreateRedirectValidation)
+	var redirectValidationResponse *client.RedirectValidationSettings
+	var httpResp *http.Response
+	if plan.IgnoreUnmanagedAllowedUrls.ValueBool() {
+		owned := allowedUrlKeys(createRedirectValidation)
+		redirectValidationResponse, httpResp, err = r.updatePreservingUnownedAllowedUrls(ctx, createRedirectValidation, owned)
+		if err == nil {
+			removeUnownedAllowedUrls(redirectValidationResponse, owned)
+		}
+	} else {
+		apiCreateRedirectValidation := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
+		apiCreateRedirectValidation = apiCreateRedirectValidation.Body(*createRedirectValidation)
+		redirectValidationResponse, httpResp, err = r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(apiCreateRedirectValidation)
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the Redirect Validation", err, httpResp)
 		return
 	}
 
 	// Read the response into the state
-	var state redirectValidationModel
-	diags = readRedirectValidationResponse(ctx, redirectValidationResponse, &state)
+	state := redirectValidationResourceModel{
+		IgnoreUnmanagedAllowedUrls: plan.IgnoreUnmanagedAllowedUrls,
+		OnDestroy:                  plan.OnDestroy,
+	}
+	diags = readRedirectValidationResponse(ctx, redirectValidationResponse, &state.redirectValidationModel)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *redirectValidationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state redirectValidationModel
+	var state redirectValidationResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -404,8 +450,22 @@ func (r *redirectValidationResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	if state.IgnoreUnmanagedAllowedUrls.IsNull() {
+		state.IgnoreUnmanagedAllowedUrls = types.BoolValue(false)
+	}
+	if state.IgnoreUnmanagedAllowedUrls.ValueBool() {
+		// Only track the entries that are already in state
+		stateSettings := client.NewRedirectValidationSettings()
+		err = addOptionalRedirectValidationFields(ctx, stateSettings, state.redirectValidationModel)
+		if err != nil {
+			resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to read the redirect validation allowed URLs from state: "+err.Error())
+			return
+		}
+		removeUnownedAllowedUrls(apiReadRedirectValidation, allowedUrlKeys(stateSettings))
+	}
+
 	// Read the response into the state
-	diags = readRedirectValidationResponse(ctx, apiReadRedirectValidation, &state)
+	diags = readRedirectValidationResponse(ctx, apiReadRedirectValidation, &state.redirectValidationModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
@@ -415,32 +475,58 @@ func (r *redirectValidationResource) Read(ctx context.Context, req resource.Read
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *redirectValidationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan redirectValidationModel
+	var plan, priorState redirectValidationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateRedirectValidation := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewRedirectValidationSettings()
-	err := addOptionalRedirectValidationFields(ctx, createUpdateRequest, plan)
+	err := addOptionalRedirectValidationFields(ctx, createUpdateRequest, plan.redirectValidationModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Redirect Validation: "+err.Error())
 		return
 	}
 
-	updateRedirectValidation = updateRedirectValidation.Body(*createUpdateRequest)
-	updateRedirectValidationResponse, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(updateRedirectValidation)
+	var updateRedirectValidationResponse *client.RedirectValidationSettings
+	var httpResp *http.Response
+	if plan.IgnoreUnmanagedAllowedUrls.ValueBool() {
+		// Entries that were previously in state are owned by this resource, so they are removed if no longer configured
+		priorSettings := client.NewRedirectValidationSettings()
+		err = addOptionalRedirectValidationFields(ctx, priorSettings, priorState.redirectValidationModel)
+		if err != nil {
+			resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to read the redirect validation allowed URLs from state: "+err.Error())
+			return
+		}
+		owned := allowedUrlKeys(priorSettings)
+		planned := allowedUrlKeys(createUpdateRequest)
+		for key := range planned {
+			owned[key] = true
+		}
+		updateRedirectValidationResponse, httpResp, err = r.updatePreservingUnownedAllowedUrls(ctx, createUpdateRequest, owned)
+		if err == nil {
+			removeUnownedAllowedUrls(updateRedirectValidationResponse, planned)
+		}
+	} else {
+		updateRedirectValidation := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
+		updateRedirectValidation = updateRedirectValidation.Body(*createUpdateRequest)
+		updateRedirectValidationResponse, httpResp, err = r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(updateRedirectValidation)
+	}
 	if err != nil {
 		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating Redirect Validation", err, httpResp)
 		return
 	}
 
 	// Read the response
-	var state redirectValidationModel
-	diags = readRedirectValidationResponse(ctx, updateRedirectValidationResponse, &state)
+	state := redirectValidationResourceModel{
+		IgnoreUnmanagedAllowedUrls: plan.IgnoreUnmanagedAllowedUrls,
+		OnDestroy:                  plan.OnDestroy,
+	}
+	diags = readRedirectValidationResponse(ctx, updateRedirectValidationResponse, &state.redirectValidationModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Update computed values
@@ -451,21 +537,158 @@ func (r *redirectValidationResource) Update(ctx context.Context, req resource.Up
 // This config object is edit-only, so Terraform can't delete it.
 func (r *redirectValidationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model redirectValidationModel
-	clientData := model.buildDefaultClientStruct(r.providerConfig.ProductVersion)
-	apiUpdateRequest := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the redirect validation settings", err, httpResp)
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	var model redirectValidationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	var owned map[string]bool
+	if model.IgnoreUnmanagedAllowedUrls.ValueBool() {
+		// Only replace the entries owned by this resource
+		stateSettings := client.NewRedirectValidationSettings()
+		err := addOptionalRedirectValidationFields(ctx, stateSettings, model.redirectValidationModel)
+		if err != nil {
+			resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to read the redirect validation allowed URLs from state: "+err.Error())
+			return
+		}
+		owned = allowedUrlKeys(stateSettings)
+	}
+	update := func(ctx context.Context, settings *client.RedirectValidationSettings) (*http.Response, error) {
+		if owned != nil {
+			_, httpResp, err := r.updatePreservingUnownedAllowedUrls(ctx, settings, owned)
+			return httpResp, err
+		}
+		_, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Body(*settings).Execute()
+		return httpResp, err
+	}
+
+	ondestroy.Delete(ctx, req, resp, "pingfederate_redirect_validation",
+		func(ctx context.Context) (*http.Response, error) {
+			return update(ctx, model.buildDefaultClientStruct(r.providerConfig.ProductVersion))
+		},
+		func(ctx context.Context, original client.RedirectValidationSettings) (*http.Response, error) {
+			return update(ctx, &original)
+		})
 }
 
 func (r *redirectValidationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState redirectValidationModel
+	var emptyState redirectValidationResourceModel
+	emptyState.IgnoreUnmanagedAllowedUrls = types.BoolNull()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	emptyState.RedirectValidationLocalSettings = types.ObjectNull(redirectValidationLocalSettingsAttrTypes)
 	emptyState.RedirectValidationPartnerSettings = types.ObjectNull(redirectValidationPartnerSettingsAttrTypes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+}
+
+func whiteListKey(entry client.RedirectValidationSettingsWhitelistEntry) string {
+	return entry.ValidDomain + entry.GetValidPath()
+}
+
+func uriAllowListKey(entry client.RedirectValidationSettingsUriAllowlistEntry) string {
+	return "uri:" + entry.ValidUri
+}
+
+// Keys identifying the white list and URI allow list entries in the given settings
+func allowedUrlKeys(settings *client.RedirectValidationSettings) map[string]bool {
+	keys := map[string]bool{}
+	if settings == nil || settings.RedirectValidationLocalSettings == nil {
+		return keys
+	}
+	for _, entry := range settings.RedirectValidationLocalSettings.WhiteList {
+		keys[whiteListKey(entry)] = true
+	}
+	for _, entry := range settings.RedirectValidationLocalSettings.UriAllowList {
+		keys[uriAllowListKey(entry)] = true
+	}
+	return keys
+}
+
+// Remove any white list and URI allow list entries that are not owned by this resource
+func removeUnownedAllowedUrls(settings *client.RedirectValidationSettings, owned map[string]bool) {
+	if settings == nil || settings.RedirectValidationLocalSettings == nil {
+		return
+	}
+	localSettings := settings.RedirectValidationLocalSettings
+	localSettings.WhiteList = slices.DeleteFunc(localSettings.WhiteList, func(entry client.RedirectValidationSettingsWhitelistEntry) bool {
+		return !owned[whiteListKey(entry)]
+	})
+	localSettings.UriAllowList = slices.DeleteFunc(localSettings.UriAllowList, func(entry client.RedirectValidationSettingsUriAllowlistEntry) bool {
+		return !owned[uriAllowListKey(entry)]
+	})
+}
+
+// Build a copy of the request that also includes the entries from the current settings that are not owned by this resource
+// and not already in the request
+func withUnownedAllowedUrls(request, current *client.RedirectValidationSettings, owned map[string]bool) client.RedirectValidationSettings {
+	result := *request
+	if current.RedirectValidationLocalSettings == nil {
+		return result
+	}
+	requested := allowedUrlKeys(request)
+	localSettings := client.RedirectValidationLocalSettings{}
+	if request.RedirectValidationLocalSettings != nil {
+		localSettings = *request.RedirectValidationLocalSettings
+	}
+	localSettings.WhiteList = slices.Clone(localSettings.WhiteList)
+	for _, entry := range current.RedirectValidationLocalSettings.WhiteList {
+		if key := whiteListKey(entry); !owned[key] && !requested[key] {
+			localSettings.WhiteList = append(localSettings.WhiteList, entry)
+		}
+	}
+	localSettings.UriAllowList = slices.Clone(localSettings.UriAllowList)
+	for _, entry := range current.RedirectValidationLocalSettings.UriAllowList {
+		if key := uriAllowListKey(entry); !owned[key] && !requested[key] {
+			localSettings.UriAllowList = append(localSettings.UriAllowList, entry)
+		}
+	}
+	result.RedirectValidationLocalSettings = &localSettings
+	return result
+}
+
+// Update the settings, leaving in place any white list and URI allow list entries that are not owned by this resource.
+// Returns the full updated settings from PingFederate.
+func (r *redirectValidationResource) updatePreservingUnownedAllowedUrls(ctx context.Context, request *client.RedirectValidationSettings, owned map[string]bool) (*client.RedirectValidationSettings, *http.Response, error) {
+	// The settings are a single object, so they are treated as a list with one item. Using the same key as the
+	// pingfederate_redirect_validation_allowed_url resource serializes updates with that resource.
+	ops := readmodifywrite.Operations[client.RedirectValidationSettings]{
+		Key: ReadModifyWriteKey,
+		Get: func(ctx context.Context) ([]client.RedirectValidationSettings, *http.Response, error) {
+			response, httpResp, err := r.apiClient.RedirectValidationAPI.GetRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return []client.RedirectValidationSettings{*response}, httpResp, nil
+		},
+		Put: func(ctx context.Context, items []client.RedirectValidationSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.RedirectValidationAPI.UpdateRedirectValidationSettings(config.AuthContext(ctx, r.providerConfig)).Body(items[0]).Execute()
+			return httpResp, err
+		},
+	}
+
+	var desired client.RedirectValidationSettings
+	items, httpResp, err := readmodifywrite.Update(ctx, ops,
+		func(items []client.RedirectValidationSettings) ([]client.RedirectValidationSettings, error) {
+			desired = withUnownedAllowedUrls(request, &items[0], owned)
+			return []client.RedirectValidationSettings{desired}, nil
+		},
+		func(items []client.RedirectValidationSettings) bool {
+			found := allowedUrlKeys(&items[0])
+			for key := range allowedUrlKeys(&desired) {
+				if !found[key] {
+					return false
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &items[0], httpResp, nil
 }
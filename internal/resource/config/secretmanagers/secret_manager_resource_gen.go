@@ -4,6 +4,7 @@ package secretmanagers
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -207,6 +209,7 @@ func (state *secretManagerResourceModel) readClientResponse(response *client.Sec
 }
 
 func (r *secretManagerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data secretManagerResourceModel
 
 	// Read Terraform plan data into the model
@@ -267,6 +270,7 @@ func (r *secretManagerResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *secretManagerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data secretManagerResourceModel
 
 	// Read Terraform plan data into the model
@@ -312,7 +316,21 @@ func (r *secretManagerResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *secretManagerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to manager_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("manager_id"), req, resp)
+	// Retrieve import ID, looking up the secret manager by name if requested, and save to manager_id attribute
+	managerId, ok := importid.Resolve(ctx, req.ID, "pingfederate_secret_manager", func(ctx context.Context, _, _ string) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.SecretManagersAPI.GetSecretManagers(config.AuthContext(ctx, r.providerConfig)).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, secretManager := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: secretManager.GetId(), Name: secretManager.GetName()})
+		}
+		return objects, httpResp, nil
+	}, &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("manager_id"), managerId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
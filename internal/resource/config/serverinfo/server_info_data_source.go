@@ -0,0 +1,388 @@
+package serverinfo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverInfoDataSource{}
+)
+
+var (
+	licenseAttrTypes = map[string]attr.Type{
+		"product":              types.StringType,
+		"version":              types.StringType,
+		"tier":                 types.StringType,
+		"expiration_date":      types.StringType,
+		"oauth_enabled":        types.BoolType,
+		"ws_trust_enabled":     types.BoolType,
+		"provisioning_enabled": types.BoolType,
+		"bridge_mode":          types.BoolType,
+		"features":             types.MapType{ElemType: types.StringType},
+	}
+
+	rolesAndProtocolsAttrTypes = map[string]attr.Type{
+		"oauth_enabled":                     types.BoolType,
+		"openid_connect_enabled":            types.BoolType,
+		"idp_enabled":                       types.BoolType,
+		"idp_ws_trust_enabled":              types.BoolType,
+		"idp_outbound_provisioning_enabled": types.BoolType,
+		"sp_enabled":                        types.BoolType,
+		"sp_ws_trust_enabled":               types.BoolType,
+		"sp_openid_connect_enabled":         types.BoolType,
+		"sp_inbound_provisioning_enabled":   types.BoolType,
+		"idp_discovery_enabled":             types.BoolType,
+	}
+
+	cryptoProviderAttrTypes = map[string]attr.Type{
+		"hybrid_hsm_mode": types.BoolType,
+		"hsm_key_pairs":   types.BoolType,
+	}
+)
+
+// Create a server info data source
+func ServerInfoDataSource() datasource.DataSource {
+	return &serverInfoDataSource{}
+}
+
+// serverInfoDataSource is the datasource implementation.
+type serverInfoDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type serverInfoDataSourceModel struct {
+	Version           types.String `tfsdk:"version"`
+	MajorVersion      types.Int64  `tfsdk:"major_version"`
+	MinorVersion      types.Int64  `tfsdk:"minor_version"`
+	PatchVersion      types.Int64  `tfsdk:"patch_version"`
+	License           types.Object `tfsdk:"license"`
+	RolesAndProtocols types.Object `tfsdk:"roles_and_protocols"`
+	CryptoProvider    types.Object `tfsdk:"crypto_provider"`
+}
+
+// GetSchema defines the schema for the datasource.
+func (r *serverInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Describes the version and enabled capabilities of the PingFederate server, as reported by the server itself. Unlike the `product_version` provider setting, these values are read from the server, so they can be used in `count` and conditional expressions in modules shared across environments.",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "The full server version, for example `12.2.0.4`.",
+				Computed:    true,
+			},
+			"major_version": schema.Int64Attribute{
+				Description: "The major component of the server version.",
+				Computed:    true,
+			},
+			"minor_version": schema.Int64Attribute{
+				Description: "The minor component of the server version.",
+				Computed:    true,
+			},
+			"patch_version": schema.Int64Attribute{
+				Description: "The patch component of the server version.",
+				Computed:    true,
+			},
+			"license": schema.SingleNestedAttribute{
+				Description: "A summary of the installed license.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"product": schema.StringAttribute{
+						Description: "The Ping Identity product value from the license file.",
+						Computed:    true,
+					},
+					"version": schema.StringAttribute{
+						Description: "The Ping Identity product version from the license file.",
+						Computed:    true,
+					},
+					"tier": schema.StringAttribute{
+						Description: "The tier value from the license file. The possible values are FREE, PERPETUAL or SUBSCRIPTION.",
+						Computed:    true,
+					},
+					"expiration_date": schema.StringAttribute{
+						Description: "The expiration date value from the license file (if applicable).",
+						Computed:    true,
+					},
+					"oauth_enabled": schema.BoolAttribute{
+						Description: "Indicates whether OAuth role is enabled for this license.",
+						Computed:    true,
+					},
+					"ws_trust_enabled": schema.BoolAttribute{
+						Description: "Indicates whether WS-Trust role is enabled for this license.",
+						Computed:    true,
+					},
+					"provisioning_enabled": schema.BoolAttribute{
+						Description: "Indicates whether Provisioning role is enabled for this license.",
+						Computed:    true,
+					},
+					"bridge_mode": schema.BoolAttribute{
+						Description: "Indicates whether this license is a bridge license or not.",
+						Computed:    true,
+					},
+					"features": schema.MapAttribute{
+						Description: "Other license features, keyed by feature name.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"roles_and_protocols": schema.SingleNestedAttribute{
+				Description: "The roles and protocols enabled in the server settings. On PingFederate 12.0 and later, OAuth and OpenID Connect are always enabled.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"oauth_enabled": schema.BoolAttribute{
+						Description: "Whether the OAuth 2.0 Authorization Server role is enabled.",
+						Computed:    true,
+					},
+					"openid_connect_enabled": schema.BoolAttribute{
+						Description: "Whether OpenID Connect is enabled for the OAuth role.",
+						Computed:    true,
+					},
+					"idp_enabled": schema.BoolAttribute{
+						Description: "Whether the Identity Provider role is enabled.",
+						Computed:    true,
+					},
+					"idp_ws_trust_enabled": schema.BoolAttribute{
+						Description: "Whether WS-Trust is enabled for the Identity Provider role.",
+						Computed:    true,
+					},
+					"idp_outbound_provisioning_enabled": schema.BoolAttribute{
+						Description: "Whether outbound provisioning is enabled for the Identity Provider role.",
+						Computed:    true,
+					},
+					"sp_enabled": schema.BoolAttribute{
+						Description: "Whether the Service Provider role is enabled.",
+						Computed:    true,
+					},
+					"sp_ws_trust_enabled": schema.BoolAttribute{
+						Description: "Whether WS-Trust is enabled for the Service Provider role.",
+						Computed:    true,
+					},
+					"sp_openid_connect_enabled": schema.BoolAttribute{
+						Description: "Whether OpenID Connect is enabled for the Service Provider role.",
+						Computed:    true,
+					},
+					"sp_inbound_provisioning_enabled": schema.BoolAttribute{
+						Description: "Whether inbound provisioning is enabled for the Service Provider role.",
+						Computed:    true,
+					},
+					"idp_discovery_enabled": schema.BoolAttribute{
+						Description: "Whether IdP Discovery is enabled.",
+						Computed:    true,
+					},
+				},
+			},
+			"crypto_provider": schema.SingleNestedAttribute{
+				Description: "The cryptographic provider mode, as detected from the signing, SSL server and SSL client key pairs. The administrative API does not expose the `pf.hsm.mode` setting from `run.properties`, so a server running in HSM mode without hybrid mode, or in BCFIPS mode, cannot be distinguished from one using the local provider.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"hybrid_hsm_mode": schema.BoolAttribute{
+						Description: "Whether the server is running in Hybrid HSM mode. This is detected by the presence of a cryptographic provider on any key pair, so it will be `false` on a server in Hybrid HSM mode that has no key pairs.",
+						Computed:    true,
+					},
+					"hsm_key_pairs": schema.BoolAttribute{
+						Description: "Whether any key pair is stored on the HSM.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (r *serverInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *serverInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (state *serverInfoDataSourceModel) readVersionResponse(r *client.Version) {
+	state.Version = types.StringPointerValue(r.Version)
+	state.MajorVersion = types.Int64Null()
+	state.MinorVersion = types.Int64Null()
+	state.PatchVersion = types.Int64Null()
+	if r.Version == nil {
+		return
+	}
+
+	versionParts := []*types.Int64{&state.MajorVersion, &state.MinorVersion, &state.PatchVersion}
+	for i, part := range strings.Split(*r.Version, ".") {
+		if i >= len(versionParts) {
+			break
+		}
+		// Leave any component that isn't a plain number (e.g. a pre-release suffix) as null
+		value, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			break
+		}
+		*versionParts[i] = types.Int64Value(value)
+	}
+}
+
+func (state *serverInfoDataSourceModel) readLicenseResponse(r *client.LicenseView) diag.Diagnostics {
+	var diags, respDiags diag.Diagnostics
+	expirationDate := types.StringNull()
+	if r.ExpirationDate != nil {
+		expirationDate = types.StringValue(r.ExpirationDate.Format(time.RFC3339))
+	}
+
+	features := map[string]attr.Value{}
+	for _, feature := range r.Features {
+		if feature.Name != nil {
+			features[*feature.Name] = types.StringPointerValue(feature.Value)
+		}
+	}
+	featuresValue, respDiags := types.MapValue(types.StringType, features)
+	diags.Append(respDiags...)
+
+	state.License, respDiags = types.ObjectValue(licenseAttrTypes, map[string]attr.Value{
+		"product":              types.StringPointerValue(r.Product),
+		"version":              types.StringPointerValue(r.Version),
+		"tier":                 types.StringPointerValue(r.Tier),
+		"expiration_date":      expirationDate,
+		"oauth_enabled":        types.BoolPointerValue(r.OauthEnabled),
+		"ws_trust_enabled":     types.BoolPointerValue(r.WsTrustEnabled),
+		"provisioning_enabled": types.BoolPointerValue(r.ProvisioningEnabled),
+		"bridge_mode":          types.BoolPointerValue(r.BridgeMode),
+		"features":             featuresValue,
+	})
+	diags.Append(respDiags...)
+	return diags
+}
+
+func (state *serverInfoDataSourceModel) readRolesAndProtocolsResponse(r *client.RolesAndProtocols) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r == nil {
+		state.RolesAndProtocols = types.ObjectNull(rolesAndProtocolsAttrTypes)
+		return diags
+	}
+
+	oauthRole := r.OauthRole
+	if oauthRole == nil {
+		oauthRole = &client.OAuthRole{}
+	}
+	idpRole := r.IdpRole
+	if idpRole == nil {
+		idpRole = &client.IdpRole{}
+	}
+	spRole := r.SpRole
+	if spRole == nil {
+		spRole = &client.SpRole{}
+	}
+
+	state.RolesAndProtocols, diags = types.ObjectValue(rolesAndProtocolsAttrTypes, map[string]attr.Value{
+		"oauth_enabled":                     types.BoolPointerValue(oauthRole.EnableOauth),
+		"openid_connect_enabled":            types.BoolPointerValue(oauthRole.EnableOpenIdConnect),
+		"idp_enabled":                       types.BoolPointerValue(idpRole.Enable),
+		"idp_ws_trust_enabled":              types.BoolPointerValue(idpRole.EnableWsTrust),
+		"idp_outbound_provisioning_enabled": types.BoolPointerValue(idpRole.EnableOutboundProvisioning),
+		"sp_enabled":                        types.BoolPointerValue(spRole.Enable),
+		"sp_ws_trust_enabled":               types.BoolPointerValue(spRole.EnableWsTrust),
+		"sp_openid_connect_enabled":         types.BoolPointerValue(spRole.EnableOpenIDConnect),
+		"sp_inbound_provisioning_enabled":   types.BoolPointerValue(spRole.EnableInboundProvisioning),
+		"idp_discovery_enabled":             types.BoolPointerValue(r.EnableIdpDiscovery),
+	})
+	return diags
+}
+
+func (state *serverInfoDataSourceModel) readKeyPairsResponse(keyPairs []client.KeyPairView) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// The crypto provider is only returned on key pairs when Hybrid HSM mode is enabled
+	hybridHsmMode := false
+	hsmKeyPairs := false
+	for _, keyPair := range keyPairs {
+		if keyPair.CryptoProvider == nil {
+			continue
+		}
+		hybridHsmMode = true
+		if *keyPair.CryptoProvider == "HSM" {
+			hsmKeyPairs = true
+		}
+	}
+
+	state.CryptoProvider, diags = types.ObjectValue(cryptoProviderAttrTypes, map[string]attr.Value{
+		"hybrid_hsm_mode": types.BoolValue(hybridHsmMode),
+		"hsm_key_pairs":   types.BoolValue(hsmKeyPairs),
+	})
+	return diags
+}
+
+// Read resource information
+func (r *serverInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serverInfoDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versionResponse, httpResp, err := r.apiClient.VersionAPI.GetVersion(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the server version", err, httpResp)
+		return
+	}
+	state.readVersionResponse(versionResponse)
+
+	licenseResponse, httpResp, err := r.apiClient.LicenseAPI.GetLicense(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the license summary", err, httpResp)
+		return
+	}
+	resp.Diagnostics.Append(state.readLicenseResponse(licenseResponse)...)
+
+	serverSettingsResponse, httpResp, err := r.apiClient.ServerSettingsAPI.GetServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the server settings", err, httpResp)
+		return
+	}
+	resp.Diagnostics.Append(state.readRolesAndProtocolsResponse(serverSettingsResponse.RolesAndProtocols)...)
+
+	var keyPairs []client.KeyPairView
+	signingKeyPairs, httpResp, err := r.apiClient.KeyPairsSigningAPI.GetSigningKeyPairs(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the signing key pairs", err, httpResp)
+		return
+	}
+	keyPairs = append(keyPairs, signingKeyPairs.Items...)
+
+	sslServerKeyPairs, httpResp, err := r.apiClient.KeyPairsSslServerAPI.GetSslServerKeyPairs(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the SSL server key pairs", err, httpResp)
+		return
+	}
+	keyPairs = append(keyPairs, sslServerKeyPairs.Items...)
+
+	sslClientKeyPairs, httpResp, err := r.apiClient.KeyPairsSslClientAPI.GetSslClientKeyPairs(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the SSL client key pairs", err, httpResp)
+		return
+	}
+	keyPairs = append(keyPairs, sslClientKeyPairs.Items...)
+	resp.Diagnostics.Append(state.readKeyPairsResponse(keyPairs)...)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -2,15 +2,19 @@ package serversettingsgeneralsettings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -35,6 +39,11 @@ type serverSettingsGeneralResource struct {
 	apiClient      *client.APIClient
 }
 
+type serverSettingsGeneralResourceModel struct {
+	serverSettingsGeneralModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *serverSettingsGeneralResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -78,6 +87,7 @@ func (r *serverSettingsGeneralResource) Schema(ctx context.Context, req resource
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -127,8 +137,18 @@ func (m *serverSettingsGeneralModel) buildDefaultClientStruct() *client.GeneralS
 	}
 }
 
+func (r *serverSettingsGeneralResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServerSettingsAPI.GetGeneralSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original general server settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *serverSettingsGeneralResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan serverSettingsGeneralModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan serverSettingsGeneralResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -136,8 +156,14 @@ func (r *serverSettingsGeneralResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createServerSettingsGeneral := client.NewGeneralSettings()
-	err := addOptionalServerSettingsGeneralFields(ctx, createServerSettingsGeneral, plan)
+	err := addOptionalServerSettingsGeneralFields(ctx, createServerSettingsGeneral, plan.serverSettingsGeneralModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for general server settings: "+err.Error())
 		return
@@ -152,15 +178,17 @@ func (r *serverSettingsGeneralResource) Create(ctx context.Context, req resource
 	}
 
 	// Read the response into the state
-	var state serverSettingsGeneralModel
-	readServerSettingsGeneralResponse(ctx, serverSettingsGeneralResponse, &state)
+	state := serverSettingsGeneralResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readServerSettingsGeneralResponse(ctx, serverSettingsGeneralResponse, &state.serverSettingsGeneralModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *serverSettingsGeneralResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state serverSettingsGeneralModel
+	var state serverSettingsGeneralResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -179,7 +207,7 @@ func (r *serverSettingsGeneralResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Read the response into the state
-	readServerSettingsGeneralResponse(ctx, apiReadServerSettingsGeneral, &state)
+	readServerSettingsGeneralResponse(ctx, apiReadServerSettingsGeneral, &state.serverSettingsGeneralModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -188,8 +216,9 @@ func (r *serverSettingsGeneralResource) Read(ctx context.Context, req resource.R
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverSettingsGeneralResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan serverSettingsGeneralModel
+	var plan serverSettingsGeneralResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -198,7 +227,7 @@ func (r *serverSettingsGeneralResource) Update(ctx context.Context, req resource
 
 	updateServerSettingsGeneral := r.apiClient.ServerSettingsAPI.UpdateGeneralSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewGeneralSettings()
-	err := addOptionalServerSettingsGeneralFields(ctx, createUpdateRequest, plan)
+	err := addOptionalServerSettingsGeneralFields(ctx, createUpdateRequest, plan.serverSettingsGeneralModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for general server settings: "+err.Error())
 		return
@@ -212,8 +241,10 @@ func (r *serverSettingsGeneralResource) Update(ctx context.Context, req resource
 	}
 
 	// Read the response
-	var state serverSettingsGeneralModel
-	readServerSettingsGeneralResponse(ctx, updateServerSettingsGeneralResponse, &state)
+	state := serverSettingsGeneralResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readServerSettingsGeneralResponse(ctx, updateServerSettingsGeneralResponse, &state.serverSettingsGeneralModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -223,19 +254,27 @@ func (r *serverSettingsGeneralResource) Update(ctx context.Context, req resource
 // This config object is edit-only, so Terraform can't delete it.
 func (r *serverSettingsGeneralResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model serverSettingsGeneralModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateGeneralSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateGeneralSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the general server settings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_server_settings_general",
+		func(ctx context.Context) (*http.Response, error) {
+			var model serverSettingsGeneralModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateGeneralSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.GeneralSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateGeneralSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *serverSettingsGeneralResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState serverSettingsGeneralModel
+	var emptyState serverSettingsGeneralResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
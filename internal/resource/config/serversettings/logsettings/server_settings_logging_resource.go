@@ -3,6 +3,7 @@ package serversettingslogsettings
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +18,7 @@ import (
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -68,8 +70,9 @@ type serverSettingsLoggingResource struct {
 }
 
 type serverSettingsLoggingResourceModel struct {
-	LogCategories    types.Set `tfsdk:"log_categories"`
-	LogCategoriesAll types.Set `tfsdk:"log_categories_all"`
+	LogCategories    types.Set    `tfsdk:"log_categories"`
+	LogCategoriesAll types.Set    `tfsdk:"log_categories_all"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
 }
 
 // GetSchema defines the schema for the resource.
@@ -150,6 +153,7 @@ func (r *serverSettingsLoggingResource) Schema(ctx context.Context, req resource
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -224,7 +228,17 @@ func readServerSettingsLoggingResourceResponse(ctx context.Context, r *client.Lo
 	return diags
 }
 
+func (r *serverSettingsLoggingResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServerSettingsAPI.GetLogSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Server Settings Log Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *serverSettingsLoggingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan serverSettingsLoggingResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -233,6 +247,12 @@ func (r *serverSettingsLoggingResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createServerSettingsLogging := client.NewLogSettings()
 	err := addOptionalServerSettingsLoggingFields(ctx, createServerSettingsLogging, plan)
 	if err != nil {
@@ -249,7 +269,9 @@ func (r *serverSettingsLoggingResource) Create(ctx context.Context, req resource
 	}
 
 	// Read the response into the state
-	var state serverSettingsLoggingResourceModel
+	state := serverSettingsLoggingResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readServerSettingsLoggingResourceResponse(ctx, serverSettingsLoggingResponse, &plan, &state, false)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
@@ -290,6 +312,7 @@ func (r *serverSettingsLoggingResource) Read(ctx context.Context, req resource.R
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverSettingsLoggingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
 	var plan serverSettingsLoggingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -314,7 +337,9 @@ func (r *serverSettingsLoggingResource) Update(ctx context.Context, req resource
 	}
 
 	// Read the response
-	var state serverSettingsLoggingResourceModel
+	state := serverSettingsLoggingResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
 	diags = readServerSettingsLoggingResourceResponse(ctx, updateServerSettingsLoggingResponse, &plan, &state, false)
 	resp.Diagnostics.Append(diags...)
 
@@ -325,14 +350,18 @@ func (r *serverSettingsLoggingResource) Update(ctx context.Context, req resource
 
 func (r *serverSettingsLoggingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	serverLogSettingsClientData := client.NewLogSettings()
-	serverLogSettingsApiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateLogSettings(config.AuthContext(ctx, r.providerConfig))
-	serverLogSettingsApiUpdateRequest = serverLogSettingsApiUpdateRequest.Body(*serverLogSettingsClientData)
-	_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateLogSettingsExecute(serverLogSettingsApiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the Server Log Settings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_server_settings_logging",
+		func(ctx context.Context) (*http.Response, error) {
+			serverLogSettingsClientData := client.NewLogSettings()
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateLogSettings(config.AuthContext(ctx, r.providerConfig)).Body(*serverLogSettingsClientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.LogSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateLogSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *serverSettingsLoggingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -340,6 +369,10 @@ func (r *serverSettingsLoggingResource) ImportState(ctx context.Context, req res
 	var emptyState serverSettingsLoggingResourceModel
 	emptyState.LogCategories = types.SetNull(types.ObjectType{AttrTypes: logCategoriesAttrTypes})
 	emptyState.LogCategoriesAll = types.SetNull(types.ObjectType{AttrTypes: logCategoriesAttrTypes})
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
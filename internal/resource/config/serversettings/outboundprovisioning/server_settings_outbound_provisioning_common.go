@@ -0,0 +1,25 @@
+package serversettingsoutboundprovisioning
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
+)
+
+type serverSettingsOutboundProvisioningModel struct {
+	DataStoreRef             types.Object `tfsdk:"data_store_ref"`
+	SynchronizationFrequency types.Int64  `tfsdk:"synchronization_frequency"`
+}
+
+func (state *serverSettingsOutboundProvisioningModel) readClientResponse(response *client.OutboundProvisionDatabase) diag.Diagnostics {
+	var respDiags, diags diag.Diagnostics
+	// data_store_ref
+	state.DataStoreRef, diags = resourcelink.ToState(context.Background(), &response.DataStoreRef)
+	respDiags.Append(diags...)
+	// synchronization_frequency
+	state.SynchronizationFrequency = types.Int64PointerValue(response.SynchronizationFrequency)
+	return respDiags
+}
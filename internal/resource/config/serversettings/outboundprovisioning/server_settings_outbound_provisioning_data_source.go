@@ -0,0 +1,80 @@
+package serversettingsoutboundprovisioning
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	resourcelinkdatasource "github.com/pingidentity/terraform-provider-pingfederate/internal/datasource/common/resourcelink"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ datasource.DataSource              = &serverSettingsOutboundProvisioningDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverSettingsOutboundProvisioningDataSource{}
+)
+
+func ServerSettingsOutboundProvisioningDataSource() datasource.DataSource {
+	return &serverSettingsOutboundProvisioningDataSource{}
+}
+
+type serverSettingsOutboundProvisioningDataSource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+func (r *serverSettingsOutboundProvisioningDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings_outbound_provisioning"
+}
+
+func (r *serverSettingsOutboundProvisioningDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *serverSettingsOutboundProvisioningDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Datasource to retrieve the outbound provisioning settings.",
+		Attributes: map[string]schema.Attribute{
+			"data_store_ref": schema.SingleNestedAttribute{
+				Description: "Reference to the JDBC data store used for the outbound provisioning database.",
+				Computed:    true,
+				Attributes:  resourcelinkdatasource.ToDataSourceSchema(),
+			},
+			"synchronization_frequency": schema.Int64Attribute{
+				Description: "The synchronization frequency in seconds.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *serverSettingsOutboundProvisioningDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data serverSettingsOutboundProvisioningModel
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	responseData, httpResp, err := r.apiClient.ServerSettingsAPI.GetOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while reading the outbound provisioning settings", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,217 @@
+package serversettingsoutboundprovisioning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	_ resource.Resource                = &serverSettingsOutboundProvisioningResource{}
+	_ resource.ResourceWithConfigure   = &serverSettingsOutboundProvisioningResource{}
+	_ resource.ResourceWithImportState = &serverSettingsOutboundProvisioningResource{}
+)
+
+func ServerSettingsOutboundProvisioningResource() resource.Resource {
+	return &serverSettingsOutboundProvisioningResource{}
+}
+
+type serverSettingsOutboundProvisioningResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_settings_outbound_provisioning"
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+type serverSettingsOutboundProvisioningResourceModel struct {
+	serverSettingsOutboundProvisioningModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource to manage the outbound provisioning settings, which configure the database used by PingFederate to track provisioning to SP connections with `outbound_provision` channels. Outbound provisioning must also be enabled with `roles_and_protocols.enable_outbound_provisioning` on the `pingfederate_server_settings` resource.",
+		Attributes: map[string]schema.Attribute{
+			"data_store_ref": resourcelink.CompleteSingleNestedAttribute(false, false, true, "Reference to the JDBC data store used for the outbound provisioning database, such as a `pingfederate_data_store` resource."),
+			"synchronization_frequency": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(60),
+				Description: "The synchronization frequency in seconds. The default value is `60`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+	ondestroy.ToSchema(&resp.Schema, false)
+}
+
+func (model *serverSettingsOutboundProvisioningModel) buildClientStruct() (*client.OutboundProvisionDatabase, error) {
+	dataStoreRef, err := resourcelink.ClientStruct(model.DataStoreRef)
+	if err != nil {
+		return nil, err
+	}
+	result := client.NewOutboundProvisionDatabase(*dataStoreRef)
+	result.SynchronizationFrequency = model.SynchronizationFrequency.ValueInt64Pointer()
+	return result, nil
+}
+
+func (r *serverSettingsOutboundProvisioningResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServerSettingsAPI.GetOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original outbound provisioning settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data serverSettingsOutboundProvisioningResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic, since this is a singleton resource
+	clientData, err := data.buildClientStruct()
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to build the request for the outbound provisioning settings: "+err.Error())
+		return
+	}
+	apiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
+	responseData, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateOutBoundProvisioningSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while creating the outbound provisioning settings", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverSettingsOutboundProvisioningResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	responseData, httpResp, err := r.apiClient.ServerSettingsAPI.GetOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Outbound Provisioning Settings", httpResp)
+			resp.State.RemoveResource(ctx)
+		} else {
+			config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while reading the outbound provisioning settings", err, httpResp)
+		}
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var data serverSettingsOutboundProvisioningResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	clientData, err := data.buildClientStruct()
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to build the request for the outbound provisioning settings: "+err.Error())
+		return
+	}
+	apiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig))
+	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
+	responseData, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateOutBoundProvisioningSettingsExecute(apiUpdateRequest)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the outbound provisioning settings", err, httpResp)
+		return
+	}
+
+	// Read response into the model
+	resp.Diagnostics.Append(data.readClientResponse(responseData)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverSettingsOutboundProvisioningResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_server_settings_outbound_provisioning",
+		nil,
+		func(ctx context.Context, original client.OutboundProvisionDatabase) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateOutBoundProvisioningSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
+}
+
+func (r *serverSettingsOutboundProvisioningResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
+	emptyState := serverSettingsOutboundProvisioningResourceModel{
+		serverSettingsOutboundProvisioningModel: serverSettingsOutboundProvisioningModel{
+			DataStoreRef: types.ObjectNull(resourcelink.AttrType()),
+		},
+		OnDestroy: ondestroy.Default(false),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+}
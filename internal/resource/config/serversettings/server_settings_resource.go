@@ -3,6 +3,7 @@ package serversettings
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	internaljson "github.com/pingidentity/terraform-provider-pingfederate/internal/json"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
@@ -43,6 +45,11 @@ type serverSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type serverSettingsResourceModel struct {
+	serverSettingsModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *serverSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -480,6 +487,7 @@ func (r *serverSettingsResource) Schema(ctx context.Context, req resource.Schema
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -503,7 +511,7 @@ func (r *serverSettingsResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 	pfVersionAtLeast121 := compare >= 0
-	var plan *serverSettingsModel
+	var plan *serverSettingsResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if plan == nil || !internaltypes.IsDefined(plan.Notifications) {
 		return
@@ -648,8 +656,18 @@ func (r *serverSettingsResource) Configure(_ context.Context, req resource.Confi
 
 }
 
+func (r *serverSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServerSettingsAPI.GetServerSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Server Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *serverSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan serverSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan serverSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -657,8 +675,14 @@ func (r *serverSettingsResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createServerSettings := client.NewServerSettings()
-	err := addOptionalServerSettingsFields(ctx, createServerSettings, plan)
+	err := addOptionalServerSettingsFields(ctx, createServerSettings, plan.serverSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Server Settings: "+err.Error())
 		return
@@ -673,8 +697,10 @@ func (r *serverSettingsResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Read the response into the state
-	var state serverSettingsModel
-	diags = readServerSettingsResponse(ctx, serverSettingsResponse, &state, &plan)
+	state := serverSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	diags = readServerSettingsResponse(ctx, serverSettingsResponse, &state.serverSettingsModel, &plan.serverSettingsModel)
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -682,7 +708,7 @@ func (r *serverSettingsResource) Create(ctx context.Context, req resource.Create
 
 // Read the server settings resource from the PingFederate API and update the state accordingly.
 func (r *serverSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state serverSettingsModel
+	var state serverSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -702,7 +728,7 @@ func (r *serverSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Read the response into the state
-	diags = readServerSettingsResponse(ctx, apiReadServerSettings, &state, &state)
+	diags = readServerSettingsResponse(ctx, apiReadServerSettings, &state.serverSettingsModel, &state.serverSettingsModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Set refreshed state
@@ -712,8 +738,9 @@ func (r *serverSettingsResource) Read(ctx context.Context, req resource.ReadRequ
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *serverSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan serverSettingsModel
+	var plan serverSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -722,7 +749,7 @@ func (r *serverSettingsResource) Update(ctx context.Context, req resource.Update
 
 	updateServerSettings := r.apiClient.ServerSettingsAPI.UpdateServerSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewServerSettings()
-	err := addOptionalServerSettingsFields(ctx, createUpdateRequest, plan)
+	err := addOptionalServerSettingsFields(ctx, createUpdateRequest, plan.serverSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Server Settings: "+err.Error())
 		return
@@ -736,8 +763,10 @@ func (r *serverSettingsResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Read the response
-	var state serverSettingsModel
-	diags = readServerSettingsResponse(ctx, updateServerSettingsResponse, &state, &plan)
+	state := serverSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	diags = readServerSettingsResponse(ctx, updateServerSettingsResponse, &state.serverSettingsModel, &plan.serverSettingsModel)
 	resp.Diagnostics.Append(diags...)
 
 	// Update computed values
@@ -748,39 +777,48 @@ func (r *serverSettingsResource) Update(ctx context.Context, req resource.Update
 // This config object is edit-only, so Terraform can't delete it.
 func (r *serverSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	var state serverSettingsModel
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	var state serverSettingsResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if state.FederationInfo.IsNull() || state.FederationInfo.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("federation_info"),
-			providererror.InternalProviderError,
-			"Cannot delete the server settings resource because the federation_info configuration is missing or unknown")
-	}
-	resp.Diagnostics.AddWarning(providererror.ConfigurationCannotBeResetError,
-		"The pingfederate_server_settings resource has been destroyed but cannot be completely returned to its original state. "+
-			"The resource has been removed from Terraform state but the federation_info.base_url and federation_info.saml_2_entity_id configuration remains applied to the environment")
-	resetSettings := client.NewServerSettings()
-	resetSettings.FederationInfo = client.NewFederationInfo()
-	resetSettings.FederationInfo.BaseUrl = state.FederationInfo.Attributes()["base_url"].(types.String).ValueStringPointer()
-	resetSettings.FederationInfo.Saml2EntityId = state.FederationInfo.Attributes()["saml_2_entity_id"].(types.String).ValueStringPointer()
-	apiUpdateRequest := r.apiClient.ServerSettingsAPI.UpdateServerSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*resetSettings)
-	_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateServerSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the server settings", err, httpResp)
-	}
+	ondestroy.Delete(ctx, req, resp, "pingfederate_server_settings",
+		func(ctx context.Context) (*http.Response, error) {
+			if state.FederationInfo.IsNull() || state.FederationInfo.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("federation_info"),
+					providererror.InternalProviderError,
+					"Cannot delete the server settings resource because the federation_info configuration is missing or unknown")
+			}
+			resp.Diagnostics.AddWarning(providererror.ConfigurationCannotBeResetError,
+				"The pingfederate_server_settings resource has been destroyed but cannot be completely returned to its original state. "+
+					"The resource has been removed from Terraform state but the federation_info.base_url and federation_info.saml_2_entity_id configuration remains applied to the environment")
+			resetSettings := client.NewServerSettings()
+			resetSettings.FederationInfo = client.NewFederationInfo()
+			resetSettings.FederationInfo.BaseUrl = state.FederationInfo.Attributes()["base_url"].(types.String).ValueStringPointer()
+			resetSettings.FederationInfo.Saml2EntityId = state.FederationInfo.Attributes()["saml_2_entity_id"].(types.String).ValueStringPointer()
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateServerSettings(config.AuthContext(ctx, r.providerConfig)).Body(*resetSettings).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.ServerSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServerSettingsAPI.UpdateServerSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *serverSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState serverSettingsModel
+	var emptyState serverSettingsResourceModel
 	emptyState.ContactInfo = types.ObjectNull(contactInfoAttrType)
 	emptyState.FederationInfo = types.ObjectNull(federationInfoAttrType)
 	emptyState.Notifications = types.ObjectNull(notificationsAttrType)
 	emptyState.RolesAndProtocols = types.ObjectNull(rolesAndProtocolsAttrType)
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
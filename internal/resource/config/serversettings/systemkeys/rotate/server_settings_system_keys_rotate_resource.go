@@ -230,6 +230,7 @@ func (r *serverSettingsSystemKeysRotateResource) emptyModel() serverSettingsSyst
 }
 
 func (r *serverSettingsSystemKeysRotateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var state serverSettingsSystemKeysRotateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
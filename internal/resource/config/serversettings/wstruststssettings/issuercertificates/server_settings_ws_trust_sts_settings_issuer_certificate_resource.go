@@ -228,6 +228,7 @@ func (state *serverSettingsWsTrustStsSettingsIssuerCertificateResourceModel) rea
 }
 
 func (r *serverSettingsWsTrustStsSettingsIssuerCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data serverSettingsWsTrustStsSettingsIssuerCertificateResourceModel
 
 	// Read Terraform plan data into the model
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -50,13 +51,14 @@ func (r *serverSettingsWsTrustStsSettingsResource) Configure(_ context.Context,
 }
 
 type serverSettingsWsTrustStsSettingsResourceModel struct {
-	BasicAuthnEnabled      types.Bool `tfsdk:"basic_authn_enabled"`
-	ClientCertAuthnEnabled types.Bool `tfsdk:"client_cert_authn_enabled"`
-	IssuerCerts            types.Set  `tfsdk:"issuer_certs"`
-	RestrictByIssuerCert   types.Bool `tfsdk:"restrict_by_issuer_cert"`
-	RestrictBySubjectDn    types.Bool `tfsdk:"restrict_by_subject_dn"`
-	SubjectDns             types.Set  `tfsdk:"subject_dns"`
-	Users                  types.Set  `tfsdk:"users"`
+	BasicAuthnEnabled      types.Bool   `tfsdk:"basic_authn_enabled"`
+	ClientCertAuthnEnabled types.Bool   `tfsdk:"client_cert_authn_enabled"`
+	IssuerCerts            types.Set    `tfsdk:"issuer_certs"`
+	RestrictByIssuerCert   types.Bool   `tfsdk:"restrict_by_issuer_cert"`
+	RestrictBySubjectDn    types.Bool   `tfsdk:"restrict_by_subject_dn"`
+	SubjectDns             types.Set    `tfsdk:"subject_dns"`
+	Users                  types.Set    `tfsdk:"users"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
 }
 
 func (r *serverSettingsWsTrustStsSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -137,6 +139,7 @@ func (r *serverSettingsWsTrustStsSettingsResource) Schema(ctx context.Context, r
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *serverSettingsWsTrustStsSettingsResourceModel) buildClientStruct() (*client.WsTrustStsSettings, diag.Diagnostics) {
@@ -237,7 +240,17 @@ func (r *serverSettingsWsTrustStsSettingsResource) emptyModel() serverSettingsWs
 	return model
 }
 
+func (r *serverSettingsWsTrustStsSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServerSettingsAPI.GetWsTrustStsSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original serverSettingsWsTrustStsSettings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *serverSettingsWsTrustStsSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data serverSettingsWsTrustStsSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -247,6 +260,12 @@ func (r *serverSettingsWsTrustStsSettingsResource) Create(ctx context.Context, r
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -295,6 +314,7 @@ func (r *serverSettingsWsTrustStsSettingsResource) Read(ctx context.Context, req
 }
 
 func (r *serverSettingsWsTrustStsSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data serverSettingsWsTrustStsSettingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -325,5 +345,9 @@ func (r *serverSettingsWsTrustStsSettingsResource) Update(ctx context.Context, r
 func (r *serverSettingsWsTrustStsSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -2,10 +2,12 @@ package serviceauthentication
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 )
 
@@ -41,11 +43,15 @@ func (state *serviceAuthenticationResourceModel) readClientResponseEncryptedShar
 
 func (r *serviceAuthenticationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this resource will be reset to the PingFederate default values.
-	apiUpdateRequest := r.apiClient.ServiceAuthenticationAPI.UpdateServiceAuthentication(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(client.ServiceAuthentication{})
-	_, httpResp, err := r.apiClient.ServiceAuthenticationAPI.UpdateServiceAuthenticationExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the serviceAuthentication", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_service_authentication",
+		func(ctx context.Context) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServiceAuthenticationAPI.UpdateServiceAuthentication(config.AuthContext(ctx, r.providerConfig)).Body(client.ServiceAuthentication{}).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.ServiceAuthentication) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.ServiceAuthenticationAPI.UpdateServiceAuthentication(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
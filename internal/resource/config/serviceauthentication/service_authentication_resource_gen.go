@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
 )
@@ -50,6 +51,7 @@ func (r *serviceAuthenticationResource) Configure(_ context.Context, req resourc
 type serviceAuthenticationResourceModel struct {
 	AttributeQuery types.Object `tfsdk:"attribute_query"`
 	Jmx            types.Object `tfsdk:"jmx"`
+	OnDestroy      types.String `tfsdk:"on_destroy"`
 }
 
 func (r *serviceAuthenticationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -116,6 +118,7 @@ func (r *serviceAuthenticationResource) Schema(ctx context.Context, req resource
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *serviceAuthenticationResourceModel) buildClientStruct() (*client.ServiceAuthentication, diag.Diagnostics) {
@@ -206,7 +209,17 @@ func (r *serviceAuthenticationResource) emptyModel() serviceAuthenticationResour
 	return model
 }
 
+func (r *serviceAuthenticationResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.ServiceAuthenticationAPI.GetServiceAuthentication(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original serviceAuthentication", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *serviceAuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data serviceAuthenticationResourceModel
 
 	// Read Terraform plan data into the model
@@ -216,6 +229,12 @@ func (r *serviceAuthenticationResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData, diags := data.buildClientStruct()
 	resp.Diagnostics.Append(diags...)
@@ -264,6 +283,7 @@ func (r *serviceAuthenticationResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *serviceAuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data serviceAuthenticationResourceModel
 
 	// Read Terraform plan data into the model
@@ -294,5 +314,9 @@ func (r *serviceAuthenticationResource) Update(ctx context.Context, req resource
 func (r *serviceAuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	emptyState := r.emptyModel()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
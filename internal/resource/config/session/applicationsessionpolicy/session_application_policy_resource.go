@@ -2,11 +2,15 @@ package sessionapplicationsessionpolicy
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -31,6 +35,11 @@ type sessionApplicationPolicyResource struct {
 	apiClient      *client.APIClient
 }
 
+type sessionApplicationPolicyResourceModel struct {
+	sessionApplicationPolicyModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *sessionApplicationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -51,6 +60,7 @@ func (r *sessionApplicationPolicyResource) Schema(ctx context.Context, req resou
 		},
 	}
 
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -88,8 +98,18 @@ func (r *sessionApplicationPolicyResource) Configure(_ context.Context, req reso
 
 }
 
+func (r *sessionApplicationPolicyResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.SessionAPI.GetApplicationPolicy(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Session Application Policy", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *sessionApplicationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan sessionApplicationPolicyModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan sessionApplicationPolicyResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -97,8 +117,14 @@ func (r *sessionApplicationPolicyResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createSessionApplicationPolicy := client.NewApplicationSessionPolicy()
-	err := addOptionalSessionApplicationPolicyFields(ctx, createSessionApplicationPolicy, plan)
+	err := addOptionalSessionApplicationPolicyFields(ctx, createSessionApplicationPolicy, plan.sessionApplicationPolicyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Session Application Policy: "+err.Error())
 		return
@@ -113,15 +139,17 @@ func (r *sessionApplicationPolicyResource) Create(ctx context.Context, req resou
 	}
 
 	// Read the response into the state
-	var state sessionApplicationPolicyModel
-	readSessionApplicationPolicyResponse(ctx, sessionApplicationPolicyResponse, &state)
+	state := sessionApplicationPolicyResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionApplicationPolicyResponse(ctx, sessionApplicationPolicyResponse, &state.sessionApplicationPolicyModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *sessionApplicationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state sessionApplicationPolicyModel
+	var state sessionApplicationPolicyResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -140,7 +168,7 @@ func (r *sessionApplicationPolicyResource) Read(ctx context.Context, req resourc
 	}
 
 	// Read the response into the state
-	readSessionApplicationPolicyResponse(ctx, apiReadSessionApplicationPolicy, &state)
+	readSessionApplicationPolicyResponse(ctx, apiReadSessionApplicationPolicy, &state.sessionApplicationPolicyModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -149,8 +177,9 @@ func (r *sessionApplicationPolicyResource) Read(ctx context.Context, req resourc
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *sessionApplicationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan sessionApplicationPolicyModel
+	var plan sessionApplicationPolicyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -159,7 +188,7 @@ func (r *sessionApplicationPolicyResource) Update(ctx context.Context, req resou
 
 	updateSessionApplicationPolicy := r.apiClient.SessionAPI.UpdateApplicationPolicy(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewApplicationSessionPolicy()
-	err := addOptionalSessionApplicationPolicyFields(ctx, createUpdateRequest, plan)
+	err := addOptionalSessionApplicationPolicyFields(ctx, createUpdateRequest, plan.sessionApplicationPolicyModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Session Application Policy: "+err.Error())
 		return
@@ -173,8 +202,10 @@ func (r *sessionApplicationPolicyResource) Update(ctx context.Context, req resou
 	}
 
 	// Get the current state to see how any attributes are changing
-	var state sessionApplicationPolicyModel
-	readSessionApplicationPolicyResponse(ctx, updateSessionApplicationPolicyResponse, &state)
+	state := sessionApplicationPolicyResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionApplicationPolicyResponse(ctx, updateSessionApplicationPolicyResponse, &state.sessionApplicationPolicyModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -184,19 +215,27 @@ func (r *sessionApplicationPolicyResource) Update(ctx context.Context, req resou
 // This config object is edit-only, so Terraform can't delete it.
 func (r *sessionApplicationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this method will reset the resource to the default configuration in PingFederate.
-	var data sessionApplicationPolicyModel
-	defaultClientStruct := data.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.SessionAPI.UpdateApplicationPolicy(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*defaultClientStruct)
-	_, httpResp, err := r.apiClient.SessionAPI.UpdateApplicationPolicyExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the Session Application Policy", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_session_application_policy",
+		func(ctx context.Context) (*http.Response, error) {
+			var data sessionApplicationPolicyModel
+			defaultClientStruct := data.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.SessionAPI.UpdateApplicationPolicy(config.AuthContext(ctx, r.providerConfig)).Body(*defaultClientStruct).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.ApplicationSessionPolicy) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.SessionAPI.UpdateApplicationPolicy(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *sessionApplicationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState sessionApplicationPolicyModel
+	var emptyState sessionApplicationPolicyResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -2,8 +2,10 @@ package sessionauthenticationsessionpoliciesglobal
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -36,6 +40,11 @@ type sessionAuthenticationPoliciesGlobalResource struct {
 	apiClient      *client.APIClient
 }
 
+type sessionAuthenticationPoliciesGlobalResourceModel struct {
+	sessionAuthenticationPoliciesGlobalModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *sessionAuthenticationPoliciesGlobalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -89,11 +98,12 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Schema(ctx context.Context
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
 func (r *sessionAuthenticationPoliciesGlobalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config *sessionAuthenticationPoliciesGlobalModel
+	var config *sessionAuthenticationPoliciesGlobalResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if config == nil {
@@ -159,8 +169,18 @@ func (m *sessionAuthenticationPoliciesGlobalModel) buildDefaultClientStruct() *c
 	}
 }
 
+func (r *sessionAuthenticationPoliciesGlobalResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.SessionAPI.GetGlobalPolicy(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original global authentication session policy", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *sessionAuthenticationPoliciesGlobalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan sessionAuthenticationPoliciesGlobalModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan sessionAuthenticationPoliciesGlobalResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -168,8 +188,14 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Create(ctx context.Context
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createSessionAuthenticationPoliciesGlobal := client.NewGlobalAuthenticationSessionPolicy(plan.EnableSessions.ValueBool())
-	err := addOptionalSessionAuthenticationPoliciesGlobalFields(ctx, createSessionAuthenticationPoliciesGlobal, plan)
+	err := addOptionalSessionAuthenticationPoliciesGlobalFields(ctx, createSessionAuthenticationPoliciesGlobal, plan.sessionAuthenticationPoliciesGlobalModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for the global authentication session policy: "+err.Error())
 		return
@@ -184,15 +210,17 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Create(ctx context.Context
 	}
 
 	// Read the response into the state
-	var state sessionAuthenticationPoliciesGlobalModel
-	readSessionAuthenticationPoliciesGlobalResponse(ctx, sessionAuthenticationPoliciesGlobalResponse, &state)
+	state := sessionAuthenticationPoliciesGlobalResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionAuthenticationPoliciesGlobalResponse(ctx, sessionAuthenticationPoliciesGlobalResponse, &state.sessionAuthenticationPoliciesGlobalModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *sessionAuthenticationPoliciesGlobalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state sessionAuthenticationPoliciesGlobalModel
+	var state sessionAuthenticationPoliciesGlobalResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -211,7 +239,7 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Read(ctx context.Context,
 	}
 
 	// Read the response into the state
-	readSessionAuthenticationPoliciesGlobalResponse(ctx, apiReadSessionAuthenticationPoliciesGlobal, &state)
+	readSessionAuthenticationPoliciesGlobalResponse(ctx, apiReadSessionAuthenticationPoliciesGlobal, &state.sessionAuthenticationPoliciesGlobalModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -220,8 +248,9 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Read(ctx context.Context,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *sessionAuthenticationPoliciesGlobalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan sessionAuthenticationPoliciesGlobalModel
+	var plan sessionAuthenticationPoliciesGlobalResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -230,7 +259,7 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Update(ctx context.Context
 
 	updateSessionAuthenticationPoliciesGlobal := r.apiClient.SessionAPI.UpdateGlobalPolicy(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewGlobalAuthenticationSessionPolicy(plan.EnableSessions.ValueBool())
-	err := addOptionalSessionAuthenticationPoliciesGlobalFields(ctx, createUpdateRequest, plan)
+	err := addOptionalSessionAuthenticationPoliciesGlobalFields(ctx, createUpdateRequest, plan.sessionAuthenticationPoliciesGlobalModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for the global authentication session policy: "+err.Error())
 		return
@@ -244,8 +273,10 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Update(ctx context.Context
 	}
 
 	// Read the response
-	var state sessionAuthenticationPoliciesGlobalModel
-	readSessionAuthenticationPoliciesGlobalResponse(ctx, updateSessionAuthenticationPoliciesGlobalResponse, &state)
+	state := sessionAuthenticationPoliciesGlobalResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionAuthenticationPoliciesGlobalResponse(ctx, updateSessionAuthenticationPoliciesGlobalResponse, &state.sessionAuthenticationPoliciesGlobalModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -255,19 +286,27 @@ func (r *sessionAuthenticationPoliciesGlobalResource) Update(ctx context.Context
 // This config object is edit-only, so Terraform can't delete it.
 func (r *sessionAuthenticationPoliciesGlobalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model sessionAuthenticationPoliciesGlobalModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.SessionAPI.UpdateGlobalPolicy(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.SessionAPI.UpdateGlobalPolicyExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the global authentication session policy", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_session_authentication_policies_global",
+		func(ctx context.Context) (*http.Response, error) {
+			var model sessionAuthenticationPoliciesGlobalModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.SessionAPI.UpdateGlobalPolicy(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.GlobalAuthenticationSessionPolicy) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.SessionAPI.UpdateGlobalPolicy(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *sessionAuthenticationPoliciesGlobalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState sessionAuthenticationPoliciesGlobalModel
+	var emptyState sessionAuthenticationPoliciesGlobalResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
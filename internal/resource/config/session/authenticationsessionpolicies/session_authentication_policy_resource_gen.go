@@ -272,6 +272,7 @@ func (state *sessionAuthenticationPolicyResourceModel) readClientResponse(respon
 }
 
 func (r *sessionAuthenticationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data sessionAuthenticationPolicyResourceModel
 
 	// Read Terraform plan data into the model
@@ -328,6 +329,7 @@ func (r *sessionAuthenticationPolicyResource) Read(ctx context.Context, req reso
 }
 
 func (r *sessionAuthenticationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data sessionAuthenticationPolicyResourceModel
 
 	// Read Terraform plan data into the model
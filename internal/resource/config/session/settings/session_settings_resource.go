@@ -2,12 +2,16 @@ package sessionsettings
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -31,6 +35,11 @@ type sessionSettingsResource struct {
 	apiClient      *client.APIClient
 }
 
+type sessionSettingsResourceModel struct {
+	sessionSettingsModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *sessionSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -56,6 +65,7 @@ func (r *sessionSettingsResource) Schema(ctx context.Context, req resource.Schem
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, false)
 	resp.Schema = schema
 }
 
@@ -89,8 +99,18 @@ func (r *sessionSettingsResource) Configure(_ context.Context, req resource.Conf
 
 }
 
+func (r *sessionSettingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.SessionAPI.GetSessionSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Session Settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *sessionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan sessionSettingsModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan sessionSettingsResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -98,8 +118,14 @@ func (r *sessionSettingsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createSessionSettings := client.NewSessionSettings()
-	err := addOptionalSessionSettingsFields(ctx, createSessionSettings, plan)
+	err := addOptionalSessionSettingsFields(ctx, createSessionSettings, plan.sessionSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Session Settings: "+err.Error())
 		return
@@ -114,15 +140,17 @@ func (r *sessionSettingsResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Read the response into the state
-	var state sessionSettingsModel
-	readSessionSettingsResponse(ctx, sessionSettingsResponse, &state)
+	state := sessionSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionSettingsResponse(ctx, sessionSettingsResponse, &state.sessionSettingsModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *sessionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state sessionSettingsModel
+	var state sessionSettingsResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -141,7 +169,7 @@ func (r *sessionSettingsResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Read the response into the state
-	readSessionSettingsResponse(ctx, apiReadSessionSettings, &state)
+	readSessionSettingsResponse(ctx, apiReadSessionSettings, &state.sessionSettingsModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -150,8 +178,9 @@ func (r *sessionSettingsResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *sessionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan sessionSettingsModel
+	var plan sessionSettingsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -160,7 +189,7 @@ func (r *sessionSettingsResource) Update(ctx context.Context, req resource.Updat
 
 	updateSessionSettings := r.apiClient.SessionAPI.UpdateSessionSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewSessionSettings()
-	err := addOptionalSessionSettingsFields(ctx, createUpdateRequest, plan)
+	err := addOptionalSessionSettingsFields(ctx, createUpdateRequest, plan.sessionSettingsModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Session Settings: "+err.Error())
 		return
@@ -174,8 +203,10 @@ func (r *sessionSettingsResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Get the current state to see how any attributes are changing
-	var state sessionSettingsModel
-	readSessionSettingsResponse(ctx, updateSessionSettingsResponse, &state)
+	state := sessionSettingsResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readSessionSettingsResponse(ctx, updateSessionSettingsResponse, &state.sessionSettingsModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -184,10 +215,23 @@ func (r *sessionSettingsResource) Update(ctx context.Context, req resource.Updat
 
 // This config object is edit-only, so Terraform can't delete it.
 func (r *sessionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
+	// Depending on on_destroy, this delete will leave the configuration in place, or restore the configuration
+	// saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_session_settings",
+		nil,
+		func(ctx context.Context, original client.SessionSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.SessionAPI.UpdateSessionSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *sessionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState sessionSettingsModel
+	var emptyState sessionSettingsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(false)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -4,6 +4,7 @@ package spadapters
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
@@ -337,6 +339,7 @@ func (state *spAdapterResourceModel) readClientResponse(response *client.SpAdapt
 }
 
 func (r *spAdapterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data spAdapterResourceModel
 
 	// Read Terraform plan data into the model
@@ -400,6 +403,7 @@ func (r *spAdapterResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *spAdapterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data spAdapterResourceModel
 
 	// Read Terraform plan data into the model
@@ -448,7 +452,21 @@ func (r *spAdapterResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *spAdapterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to adapter_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("adapter_id"), req, resp)
+	// Retrieve import ID, looking up the adapter by name if requested, and save to adapter_id attribute
+	adapterId, ok := importid.Resolve(ctx, req.ID, "pingfederate_sp_adapter", importid.ListPages(func(ctx context.Context, _, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		response, httpResp, err := r.apiClient.SpAdaptersAPI.GetSpAdapters(config.AuthContext(ctx, r.providerConfig)).Filter(value).Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, adapter := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: adapter.GetId(), Name: adapter.GetName()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adapter_id"), adapterId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
@@ -170,6 +170,7 @@ func readSpAuthenticationPolicyContractMappingResourceResponse(ctx context.Conte
 }
 
 func (r *spAuthenticationPolicyContractMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan spAuthenticationPolicyContractMappingResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -237,6 +238,7 @@ func (r *spAuthenticationPolicyContractMappingResource) Read(ctx context.Context
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *spAuthenticationPolicyContractMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan spAuthenticationPolicyContractMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
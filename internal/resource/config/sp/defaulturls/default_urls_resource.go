@@ -2,6 +2,7 @@ package defaulturls
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -54,6 +56,7 @@ type defaultUrlsResourceModel struct {
 	ConfirmIdpSlo    types.Bool   `tfsdk:"confirm_idp_slo"`
 	IdpErrorMsg      types.String `tfsdk:"idp_error_msg"`
 	IdpSloSuccessUrl types.String `tfsdk:"idp_slo_success_url"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
 }
 
 func (r *defaultUrlsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -107,6 +110,7 @@ func (r *defaultUrlsResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 // Validation must occur in modifyplan since it depends on attribute defaults
@@ -166,7 +170,31 @@ func (state *defaultUrlsResourceModel) readIdpClientResponse(response *client.Id
 	return nil
 }
 
+// The SP and IdP default URLs are saved together, so that both can be restored on destroy
+type defaultUrlsOriginal struct {
+	Sp  *client.SpDefaultUrls `json:"sp"`
+	Idp *client.IdpDefaultUrl `json:"idp"`
+}
+
+func (r *defaultUrlsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	var original defaultUrlsOriginal
+	var httpResp *http.Response
+	var err error
+	original.Sp, httpResp, err = r.apiClient.SpDefaultUrlsAPI.GetSpDefaultUrls(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original SP default URLs", err, httpResp)
+		return
+	}
+	original.Idp, httpResp, err = r.apiClient.IdpDefaultUrlsAPI.GetDefaultUrl(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original IdP default URLs", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *defaultUrlsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data defaultUrlsResourceModel
 
 	// Read Terraform plan data into the model
@@ -176,6 +204,12 @@ func (r *defaultUrlsResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// SP
 	// Update API call logic, since this is a singleton resource
 	spClientData := data.buildSpClientStruct()
@@ -255,6 +289,7 @@ func (r *defaultUrlsResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *defaultUrlsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data defaultUrlsResourceModel
 
 	// Read Terraform plan data into the model
@@ -320,28 +355,40 @@ func (model *defaultUrlsResource) buildDefaultIdpClientStruct() *client.IdpDefau
 
 func (r *defaultUrlsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	// SP
-	spClientData := r.buildDefaultSpClientStruct()
-	spApiUpdateRequest := r.apiClient.SpDefaultUrlsAPI.UpdateSpDefaultUrls(config.AuthContext(ctx, r.providerConfig))
-	spApiUpdateRequest = spApiUpdateRequest.Body(*spClientData)
-	_, httpResp, err := r.apiClient.SpDefaultUrlsAPI.UpdateSpDefaultUrlsExecute(spApiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the SP default URLs", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_default_urls",
+		func(ctx context.Context) (*http.Response, error) {
+			return r.updateDefaultUrls(ctx, r.buildDefaultSpClientStruct(), r.buildDefaultIdpClientStruct())
+		},
+		func(ctx context.Context, original defaultUrlsOriginal) (*http.Response, error) {
+			return r.updateDefaultUrls(ctx, original.Sp, original.Idp)
+		})
+}
 
-	// IdP
-	idpClientData := r.buildDefaultIdpClientStruct()
-	idpApiUpdateRequest := r.apiClient.IdpDefaultUrlsAPI.UpdateDefaultUrlSettings(config.AuthContext(ctx, r.providerConfig))
-	idpApiUpdateRequest = idpApiUpdateRequest.Body(*idpClientData)
-	_, httpResp, err = r.apiClient.IdpDefaultUrlsAPI.UpdateDefaultUrlSettingsExecute(idpApiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the IDP default URLs", err, httpResp)
+// Update both the SP and IdP default URLs, skipping either if nil
+func (r *defaultUrlsResource) updateDefaultUrls(ctx context.Context, sp *client.SpDefaultUrls, idp *client.IdpDefaultUrl) (*http.Response, error) {
+	if sp != nil {
+		_, httpResp, err := r.apiClient.SpDefaultUrlsAPI.UpdateSpDefaultUrls(config.AuthContext(ctx, r.providerConfig)).Body(*sp).Execute()
+		if err != nil {
+			return httpResp, err
+		}
 	}
+	if idp != nil {
+		_, httpResp, err := r.apiClient.IdpDefaultUrlsAPI.UpdateDefaultUrlSettings(config.AuthContext(ctx, r.providerConfig)).Body(*idp).Execute()
+		if err != nil {
+			return httpResp, err
+		}
+	}
+	return nil, nil
 }
 
 func (r *defaultUrlsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState defaultUrlsResourceModel
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
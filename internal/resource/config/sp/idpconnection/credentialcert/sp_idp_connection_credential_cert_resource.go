@@ -0,0 +1,30 @@
+package spidpconnectioncredentialcert
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+)
+
+func SpIdpConnectionCredentialCertResource() resource.Resource {
+	return connectioncert.NewCredentialCertResource(connectioncert.CredentialCertResourceOptions{
+		TypeName:                   "sp_idp_connection_credential_cert",
+		ConnectionType:             "IDP",
+		ConnectionName:             "SP IdP Connection",
+		ConnectionResourceTypeName: "pingfederate_sp_idp_connection",
+		ConnectionIdDescription:    "The ID of the IdP connection.",
+		EncryptionCertDescription:  "Indicates whether to use this certificate to encrypt outgoing messages.",
+		GetCerts: func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.ConnectionCerts, *http.Response, error) {
+			return apiClient.SpIdpConnectionsAPI.GetConnectionCerts(ctx, connectionId).Execute()
+		},
+		AddCert: func(ctx context.Context, apiClient *client.APIClient, connectionId string, cert client.ConnectionCert) (*client.ConnectionCert, *http.Response, error) {
+			return apiClient.SpIdpConnectionsAPI.AddConnectionCert(ctx, connectionId).Body(cert).Execute()
+		},
+		UpdateCerts: func(ctx context.Context, apiClient *client.APIClient, connectionId string, certs client.ConnectionCerts) (*client.ConnectionCerts, *http.Response, error) {
+			return apiClient.SpIdpConnectionsAPI.UpdateConnectionCerts(ctx, connectionId).Body(certs).Execute()
+		},
+	})
+}
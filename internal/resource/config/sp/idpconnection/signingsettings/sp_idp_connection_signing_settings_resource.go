@@ -0,0 +1,26 @@
+package spidpconnectionsigningsettings
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionsigningsettings"
+)
+
+func SpIdpConnectionSigningSettingsResource() resource.Resource {
+	return connectionsigningsettings.NewSigningSettingsResource(connectionsigningsettings.SigningSettingsResourceOptions{
+		TypeName:                   "sp_idp_connection_signing_settings",
+		ConnectionType:             "IDP",
+		ConnectionName:             "SP IdP Connection",
+		ConnectionResourceTypeName: "pingfederate_sp_idp_connection",
+		ConnectionIdDescription:    "The ID of the IdP connection.",
+		GetSigningSettings: func(ctx context.Context, apiClient *client.APIClient, connectionId string) (*client.SigningSettings, *http.Response, error) {
+			return apiClient.SpIdpConnectionsAPI.GetIdpConnectionSigningSettings(ctx, connectionId).Execute()
+		},
+		UpdateSigningSettings: func(ctx context.Context, apiClient *client.APIClient, connectionId string, signingSettings client.SigningSettings) (*client.SigningSettings, *http.Response, error) {
+			return apiClient.SpIdpConnectionsAPI.UpdateIdpConnectionSigningSettings(ctx, connectionId).Body(signingSettings).Execute()
+		},
+	})
+}
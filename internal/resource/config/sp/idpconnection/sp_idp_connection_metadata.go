@@ -0,0 +1,149 @@
+package spidpconnection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+var (
+	idpBrowserSsoMetadataAttrs = []string{"incoming_bindings", "slo_service_endpoints", "sso_service_endpoints"}
+)
+
+// Populate the values that can be read from the partner's SAML metadata, for any of them that are not set in the
+// configuration. When onlyUnknown is true, only values that are still unknown in the plan are populated.
+// Returns true if the plan was modified.
+func (r *spIdpConnectionResource) populateFromMetadata(ctx context.Context, plan, config, state *spIdpConnectionResourceModel, onlyUnknown bool) (bool, diag.Diagnostics) {
+	var respDiags, diags diag.Diagnostics
+
+	populate := func(configValue, planValue attr.Value) bool {
+		return configValue != nil && configValue.IsNull() && (!onlyUnknown || planValue.IsUnknown())
+	}
+
+	populateEntityId := populate(config.EntityId, plan.EntityId)
+	populateCerts := false
+	var planCredentialsAttrs map[string]attr.Value
+	if internaltypes.IsDefined(plan.Credentials) && internaltypes.IsDefined(config.Credentials) {
+		planCredentialsAttrs = plan.Credentials.Attributes()
+		populateCerts = populate(config.Credentials.Attributes()["certs"], planCredentialsAttrs["certs"])
+	}
+	populateIdpBrowserSsoAttrs := []string{}
+	var planIdpBrowserSsoAttrs map[string]attr.Value
+	if internaltypes.IsDefined(plan.IdpBrowserSso) && internaltypes.IsDefined(config.IdpBrowserSso) {
+		planIdpBrowserSsoAttrs = plan.IdpBrowserSso.Attributes()
+		for _, name := range idpBrowserSsoMetadataAttrs {
+			if populate(config.IdpBrowserSso.Attributes()[name], planIdpBrowserSsoAttrs[name]) {
+				populateIdpBrowserSsoAttrs = append(populateIdpBrowserSsoAttrs, name)
+			}
+		}
+	}
+	if !populateEntityId && !populateCerts && len(populateIdpBrowserSsoAttrs) == 0 {
+		return false, respDiags
+	}
+
+	// When metadata is not set, these values are null as usual. When the metadata can't be retrieved yet, they are
+	// unknown until it can be.
+	entityId := types.StringNull()
+	certs := types.ListNull(connectioncert.ObjType())
+	idpBrowserSsoValues := map[string]attr.Value{
+		"incoming_bindings":     types.SetNull(types.StringType),
+		"slo_service_endpoints": types.SetNull(idpBrowserSsoSloServiceEndpointsElementType),
+		"sso_service_endpoints": types.SetNull(idpBrowserSsoSsoServiceEndpointsElementType),
+	}
+	// Inline metadata is only converted when it changes. Otherwise the values read from it previously are kept.
+	// Metadata from a URL is retrieved and converted every time, so that changes at the URL are detected.
+	metadataUnchanged := state != nil && internaltypes.IsDefined(state.Metadata) && plan.Metadata.Equal(state.Metadata) &&
+		!connectionmetadata.IsFromUrl(plan.Metadata) &&
+		(!populateCerts || internaltypes.IsDefined(state.Credentials)) &&
+		(len(populateIdpBrowserSsoAttrs) == 0 || internaltypes.IsDefined(state.IdpBrowserSso))
+	metadataUnknown := plan.Metadata.IsUnknown()
+	if metadataUnchanged {
+		entityId = state.EntityId
+		if internaltypes.IsDefined(state.Credentials) {
+			certs = state.Credentials.Attributes()["certs"].(types.List)
+		}
+		if internaltypes.IsDefined(state.IdpBrowserSso) {
+			stateIdpBrowserSsoAttrs := state.IdpBrowserSso.Attributes()
+			for _, name := range idpBrowserSsoMetadataAttrs {
+				idpBrowserSsoValues[name] = stateIdpBrowserSsoAttrs[name]
+			}
+		}
+	} else if internaltypes.IsDefined(plan.Metadata) {
+		expectedProtocol := types.StringNull()
+		if internaltypes.IsDefined(plan.IdpBrowserSso) {
+			expectedProtocol = plan.IdpBrowserSso.Attributes()["protocol"].(types.String)
+		}
+		convertedJson, diags := connectionmetadata.Convert(ctx, r.apiClient, r.providerConfig, plan.Metadata, "IDP", expectedProtocol, config.EntityId)
+		respDiags.Append(diags...)
+		if respDiags.HasError() {
+			return false, respDiags
+		}
+		if convertedJson == nil {
+			metadataUnknown = true
+		} else {
+			var converted client.IdpConnection
+			err := json.Unmarshal(convertedJson, &converted)
+			if err != nil {
+				respDiags.AddError(providererror.InternalProviderError, "Failed to read the connection converted from metadata: "+err.Error())
+				return false, respDiags
+			}
+			entityId = types.StringValue(converted.EntityId)
+
+			var priorCerts attr.Value
+			if state != nil && internaltypes.IsDefined(state.Credentials) {
+				priorCerts = state.Credentials.Attributes()["certs"]
+			}
+			var convertedCerts []client.ConnectionCert
+			if converted.Credentials != nil {
+				convertedCerts = converted.Credentials.Certs
+			}
+			certs, diags = connectionmetadata.CertsPlanValue(convertedCerts, priorCerts)
+			respDiags.Append(diags...)
+
+			if converted.IdpBrowserSso != nil {
+				idpBrowserSsoValues["incoming_bindings"], diags = types.SetValueFrom(ctx, types.StringType, converted.IdpBrowserSso.IncomingBindings)
+				respDiags.Append(diags...)
+				idpBrowserSsoValues["slo_service_endpoints"], diags = types.SetValueFrom(ctx, idpBrowserSsoSloServiceEndpointsElementType, converted.IdpBrowserSso.SloServiceEndpoints)
+				respDiags.Append(diags...)
+				if len(converted.IdpBrowserSso.SsoServiceEndpoints) > 0 {
+					idpBrowserSsoValues["sso_service_endpoints"], diags = types.SetValueFrom(ctx, idpBrowserSsoSsoServiceEndpointsElementType, converted.IdpBrowserSso.SsoServiceEndpoints)
+					respDiags.Append(diags...)
+				}
+			}
+		}
+	}
+	if metadataUnknown {
+		entityId = types.StringUnknown()
+		certs = types.ListUnknown(connectioncert.ObjType())
+		idpBrowserSsoValues = map[string]attr.Value{
+			"incoming_bindings":     types.SetUnknown(types.StringType),
+			"slo_service_endpoints": types.SetUnknown(idpBrowserSsoSloServiceEndpointsElementType),
+			"sso_service_endpoints": types.SetUnknown(idpBrowserSsoSsoServiceEndpointsElementType),
+		}
+	}
+
+	if populateEntityId {
+		plan.EntityId = entityId
+	}
+	if populateCerts {
+		planCredentialsAttrs["certs"] = certs
+		plan.Credentials, diags = types.ObjectValue(credentialsAttrTypes, planCredentialsAttrs)
+		respDiags.Append(diags...)
+	}
+	if len(populateIdpBrowserSsoAttrs) > 0 {
+		for _, name := range populateIdpBrowserSsoAttrs {
+			planIdpBrowserSsoAttrs[name] = idpBrowserSsoValues[name]
+		}
+		plan.IdpBrowserSso, diags = types.ObjectValue(plan.IdpBrowserSso.AttributeTypes(ctx), planIdpBrowserSsoAttrs)
+		respDiags.Append(diags...)
+	}
+	return true, respDiags
+}
@@ -27,11 +27,14 @@ import (
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributecontractfulfillment"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/attributesources"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectioncert"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/connectionmetadata"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/datastorerepository"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importid"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/importprivatestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/inboundprovisioninguserrepository"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/issuancecriteria"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/movestate"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/pluginconfiguration"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/sourcetypeidkey"
@@ -45,9 +48,12 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &spIdpConnectionResource{}
-	_ resource.ResourceWithConfigure   = &spIdpConnectionResource{}
-	_ resource.ResourceWithImportState = &spIdpConnectionResource{}
+	_ resource.Resource                   = &spIdpConnectionResource{}
+	_ resource.ResourceWithConfigure      = &spIdpConnectionResource{}
+	_ resource.ResourceWithImportState    = &spIdpConnectionResource{}
+	_ resource.ResourceWithModifyPlan     = &spIdpConnectionResource{}
+	_ resource.ResourceWithValidateConfig = &spIdpConnectionResource{}
+	_ resource.ResourceWithMoveState      = &spIdpConnectionResource{}
 
 	metadataReloadSettingsAttrTypes = map[string]attr.Type{
 		"metadata_url_ref":            types.ObjectType{AttrTypes: resourcelink.AttrType()},
@@ -639,6 +645,7 @@ type spIdpConnectionResourceModel struct {
 	IdpOAuthGrantAttributeMapping          types.Object `tfsdk:"idp_oauth_grant_attribute_mapping"`
 	LicenseConnectionGroup                 types.String `tfsdk:"license_connection_group"`
 	LoggingMode                            types.String `tfsdk:"logging_mode"`
+	Metadata                               types.Object `tfsdk:"metadata"`
 	MetadataReloadSettings                 types.Object `tfsdk:"metadata_reload_settings"`
 	Name                                   types.String `tfsdk:"name"`
 	OidcClientCredentials                  types.Object `tfsdk:"oidc_client_credentials"`
@@ -874,7 +881,7 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							stringvalidator.LengthAtLeast(1),
 						},
 					},
-					"certs": connectioncert.ToSchema("The certificates used for signature verification and XML encryption."),
+					"certs": connectioncert.ToSchemaOptionalComputed("The certificates used for signature verification and XML encryption. Populated from `metadata` if not set. Certificates managed by `pingfederate_sp_idp_connection_credential_cert` resources are ignored."),
 					"block_encryption_algorithm": schema.StringAttribute{
 						Optional:            true,
 						Description:         "The algorithm used to encrypt assertions sent to this partner. Options are `AES_128`, `AES_256`, `AES_128_GCM`, `AES_192_GCM`, `AES_256_GCM`, `Triple_DES`.",
@@ -927,8 +934,8 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							},
 						},
 						Optional:            true,
-						Description:         "Settings related to signing messages sent to this partner.",
-						MarkdownDescription: "Settings related to signing messages sent to this partner.",
+						Description:         "Settings related to signing messages sent to this partner. If not set, signing settings managed outside of this resource, such as by the `pingfederate_sp_idp_connection_signing_settings` resource, are left in place.",
+						MarkdownDescription: "Settings related to signing messages sent to this partner. If not set, signing settings managed outside of this resource, such as by the `pingfederate_sp_idp_connection_signing_settings` resource, are left in place.",
 					},
 					"decryption_key_pair_ref": schema.SingleNestedAttribute{
 						Attributes:          resourcelink.ToSchema(),
@@ -1081,9 +1088,10 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"entity_id": schema.StringAttribute{
-				Required:            true,
-				Description:         "The partner's entity ID (connection ID) or issuer value (for OIDC Connections).",
-				MarkdownDescription: "The partner's entity ID (connection ID) or issuer value (for OIDC Connections).",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The partner's entity ID (connection ID) or issuer value (for OIDC Connections). Required unless `metadata` is set.",
+				MarkdownDescription: "The partner's entity ID (connection ID) or issuer value (for OIDC Connections). Required unless `metadata` is set.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -1527,8 +1535,9 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 					"incoming_bindings": schema.SetAttribute{
 						ElementType:         types.StringType,
 						Optional:            true,
-						Description:         "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections and it is optional for IdP Connections.",
-						MarkdownDescription: "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections and it is optional for IdP Connections.",
+						Computed:            true,
+						Description:         "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections and it is optional for IdP Connections. Populated from `metadata` if not set.",
+						MarkdownDescription: "The SAML bindings that are enabled for browser-based SSO. This is required for SAML 2.0 connections when the enabled profiles contain the SP-initiated SSO profile or either SLO profile. For SAML 1.x based connections, it is not used for SP Connections and it is optional for IdP Connections. Populated from `metadata` if not set.",
 					},
 					"jit_provisioning": schema.SingleNestedAttribute{
 						Attributes: map[string]schema.Attribute{
@@ -2233,8 +2242,9 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							},
 						},
 						Optional:            true,
-						Description:         "A list of possible endpoints to send SLO requests and responses.",
-						MarkdownDescription: "A list of possible endpoints to send SLO requests and responses.",
+						Computed:            true,
+						Description:         "A list of possible endpoints to send SLO requests and responses. Populated from `metadata` if not set.",
+						MarkdownDescription: "A list of possible endpoints to send SLO requests and responses. Populated from `metadata` if not set.",
 					},
 					"sso_application_endpoint": schema.StringAttribute{
 						Optional: false,
@@ -2354,8 +2364,9 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 							},
 						},
 						Optional:            true,
-						Description:         "The IdP SSO endpoints that define where to send your authentication requests. Only required for SP initiated SSO. This is required for SAML x.x and WS-FED Connections.",
-						MarkdownDescription: "The IdP SSO endpoints that define where to send your authentication requests. Only required for SP initiated SSO. This is required for SAML x.x and WS-FED Connections.",
+						Computed:            true,
+						Description:         "The IdP SSO endpoints that define where to send your authentication requests. Only required for SP initiated SSO. This is required for SAML x.x and WS-FED Connections. Populated from `metadata` if not set.",
+						MarkdownDescription: "The IdP SSO endpoints that define where to send your authentication requests. Only required for SP initiated SSO. This is required for SAML x.x and WS-FED Connections. Populated from `metadata` if not set.",
 						Validators: []validator.Set{
 							setvalidator.SizeAtLeast(1),
 						},
@@ -3206,6 +3217,7 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 					stringvalidator.OneOf("NONE", "STANDARD", "ENHANCED", "FULL"),
 				},
 			},
+			"metadata": connectionmetadata.ToSchema("The partner's SAML metadata. When set, `entity_id`, `credentials.certs`, and the `sso_service_endpoints`, `slo_service_endpoints` and `incoming_bindings` of `idp_browser_sso` are populated from the metadata, unless they are set in the configuration. Other settings, such as attribute contracts, must still be configured."),
 			"metadata_reload_settings": schema.SingleNestedAttribute{
 				Optional:            true,
 				Description:         "Configuration settings to enable automatic reload of partner's metadata.",
@@ -3431,6 +3443,23 @@ func (r *spIdpConnectionResource) Schema(ctx context.Context, req resource.Schem
 	resp.Schema = schema
 }
 
+func (r *spIdpConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *spIdpConnectionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if config == nil {
+		return
+	}
+
+	// The entity_id can be populated from metadata, so it is only required when metadata is not set
+	if config.Metadata.IsNull() && config.EntityId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("entity_id"),
+			providererror.InvalidAttributeConfiguration,
+			"The 'entity_id' attribute must be set when 'metadata' is not set.")
+	}
+}
+
 func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Compare to version 12.0.0 of PF
 	compare, err := version.Compare(r.providerConfig.ProductVersion, version.PingFederate1200)
@@ -3446,14 +3475,26 @@ func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 	pfVersionAtLeast1210 := compare >= 0
-	var plan *spIdpConnectionResourceModel
+	var plan, state, config *spIdpConnectionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if plan == nil {
 		return
 	}
 
+	// Populate values from the partner's metadata
+	planModifiedFromMetadata, diags := r.populateFromMetadata(ctx, plan, config, state, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if planModifiedFromMetadata {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+
 	// If any of these fields are set by the user and the PF version is not new enough, throw an error
 	if !pfVersionAtLeast1200 {
 		if internaltypes.IsDefined(plan.IdpBrowserSso) {
@@ -3492,7 +3533,6 @@ func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.M
 
 	// Set default for jwt_secured_authorization_response_mode_type if version is 12.1+
 	planModified := false
-	var diags diag.Diagnostics
 	if pfVersionAtLeast1210 && internaltypes.IsDefined(plan.IdpBrowserSso) {
 		browserSsoAttributes := plan.IdpBrowserSso.Attributes()
 		oidcProviderSettings := browserSsoAttributes["oidc_provider_settings"].(types.Object)
@@ -3534,8 +3574,6 @@ func (r *spIdpConnectionResource) ModifyPlan(ctx context.Context, req resource.M
 	}
 
 	// If the entity_id has been changed, then mark corresponding attributes as unknown
-	var state *spIdpConnectionResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if state == nil {
 		return
 	}
@@ -3833,6 +3871,7 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 				"If `idp_connection_transaction_logging_override` is configured to anything other than `DONT_OVERRIDE` in the `server_settings_general` resource,"+
 				" `logging_mode` should be configured to the same value in this resource.")
 	}
+	state.Metadata = plan.Metadata
 	state.MetadataReloadSettings, objDiags = types.ObjectValueFrom(ctx, metadataReloadSettingsAttrTypes, r.MetadataReloadSettings)
 	diags.Append(objDiags...)
 	state.Name = types.StringValue(r.Name)
@@ -3883,6 +3922,10 @@ func readSpIdpConnectionResponse(ctx context.Context, r *client.IdpConnection, p
 		}
 		credentialsCertsValue, objDiags := types.ListValue(connectioncert.ObjType(), credentialsCertsValues)
 		diags.Append(objDiags...)
+		// Leave certs unset when all of the certificates are managed outside of this resource
+		if len(credentialsCertsValues) == 0 && plan.Credentials.Attributes()["certs"] != nil && plan.Credentials.Attributes()["certs"].IsNull() {
+			credentialsCertsValue = types.ListNull(connectioncert.ObjType())
+		}
 		var credentialsDecryptionKeyPairRefValue types.Object
 		if r.Credentials.DecryptionKeyPairRef == nil {
 			credentialsDecryptionKeyPairRefValue = types.ObjectNull(resourcelink.AttrType())
@@ -4658,10 +4701,19 @@ func (r *spIdpConnectionResource) warnFor500Err(httpResp *http.Response, diags *
 }
 
 func (r *spIdpConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan, state spIdpConnectionResourceModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan, state, configModel spIdpConnectionResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate any values from metadata that could not be determined when planning
+	_, diags = r.populateFromMetadata(ctx, &plan, &configModel, nil, true)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4711,6 +4763,14 @@ func (r *spIdpConnectionResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	// Ignore any certificates and signing settings that are managed outside of this resource
+	if !isImportRead && apiReadSpIdpConnection.Credentials != nil && internaltypes.IsDefined(state.Credentials) {
+		apiReadSpIdpConnection.Credentials.Certs, _ = connectioncert.SplitCerts(apiReadSpIdpConnection.Credentials.Certs, state.Credentials.Attributes()["certs"])
+		if state.Credentials.Attributes()["signing_settings"].IsNull() {
+			apiReadSpIdpConnection.Credentials.SigningSettings = nil
+		}
+	}
+
 	// Read the response into the state
 	diags = readSpIdpConnectionResponse(ctx, apiReadSpIdpConnection, &state, &state, isImportRead)
 	resp.Diagnostics.Append(diags...)
@@ -4722,10 +4782,20 @@ func (r *spIdpConnectionResource) Read(ctx context.Context, req resource.ReadReq
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *spIdpConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
-	var plan spIdpConnectionResourceModel
+	var plan, priorState, configModel spIdpConnectionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Populate any values from metadata that could not be determined when planning
+	_, diags = r.populateFromMetadata(ctx, &plan, &configModel, &priorState, true)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4738,6 +4808,26 @@ func (r *spIdpConnectionResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Retain any certificates and signing settings that are managed outside of this resource. The credentials are
+	// locked so that they can't be changed by the credential cert and signing settings resources in the meantime.
+	defer connectioncert.LockCredentials("IDP", plan.ConnectionId.ValueString())()
+	var unmanagedCerts []client.ConnectionCert
+	retainSigningSettings := createUpdateRequest.Credentials != nil && createUpdateRequest.Credentials.SigningSettings == nil
+	if createUpdateRequest.Credentials != nil && internaltypes.IsDefined(priorState.Credentials) {
+		currentConnection, httpResp, err := r.apiClient.SpIdpConnectionsAPI.GetConnection(config.AuthContext(ctx, r.providerConfig), plan.ConnectionId.ValueString()).Execute()
+		if err != nil {
+			config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while getting the Sp Idp Connection credentials", err, httpResp, &customId)
+			return
+		}
+		if currentConnection.Credentials != nil {
+			_, unmanagedCerts = connectioncert.SplitCerts(currentConnection.Credentials.Certs, priorState.Credentials.Attributes()["certs"])
+			createUpdateRequest.Credentials.Certs = append(createUpdateRequest.Credentials.Certs, unmanagedCerts...)
+			if retainSigningSettings {
+				createUpdateRequest.Credentials.SigningSettings = currentConnection.Credentials.SigningSettings
+			}
+		}
+	}
+
 	updateSpIdpConnection = updateSpIdpConnection.Body(*createUpdateRequest)
 	updateSpIdpConnectionResponse, httpResp, err := r.apiClient.SpIdpConnectionsAPI.UpdateConnectionExecute(updateSpIdpConnection)
 	if err != nil {
@@ -4745,6 +4835,12 @@ func (r *spIdpConnectionResource) Update(ctx context.Context, req resource.Updat
 		config.ReportHttpErrorCustomId(ctx, &resp.Diagnostics, "An error occurred while updating Sp Idp Connection", err, httpResp, &customId)
 		return
 	}
+	if updateSpIdpConnectionResponse.Credentials != nil {
+		updateSpIdpConnectionResponse.Credentials.Certs = connectioncert.RemoveCerts(updateSpIdpConnectionResponse.Credentials.Certs, unmanagedCerts)
+		if retainSigningSettings {
+			updateSpIdpConnectionResponse.Credentials.SigningSettings = nil
+		}
+	}
 
 	// Read the response
 	var state spIdpConnectionResourceModel
@@ -4771,7 +4867,37 @@ func (r *spIdpConnectionResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *spIdpConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to connection_id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("connection_id"), req, resp)
+	// Retrieve import ID, looking up the connection by name or entity ID if requested, and save to connection_id attribute
+	connectionId, ok := importid.Resolve(ctx, req.ID, "pingfederate_sp_idp_connection", importid.ListPages(func(ctx context.Context, prefix, value string, page, numberPerPage int64) ([]importid.Object, *http.Response, error) {
+		listRequest := r.apiClient.SpIdpConnectionsAPI.GetConnections(config.AuthContext(ctx, r.providerConfig))
+		if prefix == importid.EntityIdPrefix {
+			listRequest = listRequest.EntityId(value)
+		} else {
+			listRequest = listRequest.Filter(value)
+		}
+		response, httpResp, err := listRequest.Page(page).NumberPerPage(numberPerPage).Execute()
+		if err != nil {
+			return nil, httpResp, err
+		}
+		var objects []importid.Object
+		for _, connection := range response.GetItems() {
+			objects = append(objects, importid.Object{Id: connection.GetId(), Name: connection.GetName(), EntityId: connection.GetEntityId()})
+		}
+		return objects, httpResp, nil
+	}), &resp.Diagnostics, importid.EntityIdPrefix, importid.NamePrefix)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("connection_id"), connectionId)...)
 	importprivatestate.MarkPrivateStateForImport(ctx, resp)
 }
+
+func (r *spIdpConnectionResource) MoveState(ctx context.Context) []resource.StateMover {
+	// Move state from the community PingFederate provider
+	return []resource.StateMover{
+		movestate.FromCommunityProvider(movestate.CommunitySource{
+			TypeNames:   []string{"pingfederate_sp_idp_connection"},
+			IdAttribute: "connection_id",
+		}),
+	}
+}
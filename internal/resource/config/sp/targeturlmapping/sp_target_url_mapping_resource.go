@@ -0,0 +1,280 @@
+package sptargeturlmapping
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/readmodifywrite"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/resourcelink"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &spTargetUrlMappingResource{}
+	_ resource.ResourceWithConfigure   = &spTargetUrlMappingResource{}
+	_ resource.ResourceWithImportState = &spTargetUrlMappingResource{}
+)
+
+// SpTargetUrlMappingResource is a helper function to simplify the provider implementation.
+func SpTargetUrlMappingResource() resource.Resource {
+	return &spTargetUrlMappingResource{}
+}
+
+// spTargetUrlMappingResource is the resource implementation.
+type spTargetUrlMappingResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type spTargetUrlMappingModel struct {
+	Id   types.String `tfsdk:"id"`
+	Url  types.String `tfsdk:"url"`
+	Type types.String `tfsdk:"type"`
+	Ref  types.Object `tfsdk:"ref"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *spTargetUrlMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a single mapping between a URL and an adapter or connection instance. Other mappings are left unchanged, so this resource can be used by multiple configurations at once. New mappings are added to the end of the list, and mappings are evaluated in order; use the `pingfederate_sp_target_url_mappings` resource when the order of the mappings must be controlled. This resource should not be used together with the `pingfederate_sp_target_url_mappings` resource.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL that will be compared against the target URL. Use a wildcard (*) to match multiple URLs to the same adapter or connection instance. This field is immutable and will trigger a replacement plan if changed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					configvalidators.ValidUrl(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The URL mapping type. Options are `SP_ADAPTER` or `SP_CONNECTION`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"SP_ADAPTER",
+						"SP_CONNECTION",
+					),
+				},
+			},
+			"ref": resourcelink.CompleteSingleNestedAttribute(false, false, true, "The adapter or connection instance mapped for this URL."),
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *spTargetUrlMappingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sp_target_url_mapping"
+}
+
+func (r *spTargetUrlMappingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *spTargetUrlMappingResource) operations() readmodifywrite.Operations[client.SpUrlMapping] {
+	return readmodifywrite.Operations[client.SpUrlMapping]{
+		Key: "sp_target_url_mappings",
+		Get: func(ctx context.Context) ([]client.SpUrlMapping, *http.Response, error) {
+			response, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.GetSpUrlMappings(config.AuthContext(ctx, r.providerConfig)).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return response.Items, httpResp, nil
+		},
+		Put: func(ctx context.Context, items []client.SpUrlMapping) (*http.Response, error) {
+			body := client.SpUrlMappings{
+				Items: items,
+			}
+			_, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(config.AuthContext(ctx, r.providerConfig)).Body(body).Execute()
+			return httpResp, err
+		},
+	}
+}
+
+func (model *spTargetUrlMappingModel) buildClientStruct() (*client.SpUrlMapping, error) {
+	ref, err := resourcelink.ClientStruct(model.Ref)
+	if err != nil {
+		return nil, err
+	}
+	return &client.SpUrlMapping{
+		Url:  model.Url.ValueStringPointer(),
+		Type: model.Type.ValueStringPointer(),
+		Ref:  ref,
+	}, nil
+}
+
+func (state *spTargetUrlMappingModel) readClientResponse(ctx context.Context, response client.SpUrlMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.Id = types.StringPointerValue(response.Url)
+	state.Url = types.StringPointerValue(response.Url)
+	state.Type = types.StringPointerValue(response.Type)
+	state.Ref, diags = resourcelink.ToState(ctx, response.Ref)
+	return diags
+}
+
+func indexOf(items []client.SpUrlMapping, url string) int {
+	return slices.IndexFunc(items, func(item client.SpUrlMapping) bool {
+		return item.GetUrl() == url
+	})
+}
+
+func mappingMatches(item, desired client.SpUrlMapping) bool {
+	return item.GetType() == desired.GetType() &&
+		item.Ref != nil && desired.Ref != nil && item.Ref.Id == desired.Ref.Id
+}
+
+// Add or replace this mapping in the list, and return the resulting mapping from PingFederate
+func (r *spTargetUrlMappingResource) upsert(ctx context.Context, desired *client.SpUrlMapping, allowExisting bool) (*client.SpUrlMapping, *http.Response, error) {
+	url := desired.GetUrl()
+	items, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []client.SpUrlMapping) ([]client.SpUrlMapping, error) {
+			i := indexOf(items, url)
+			if i < 0 {
+				return append(items, *desired), nil
+			}
+			if !allowExisting {
+				return nil, fmt.Errorf("a target URL mapping for %q already exists. Import it to manage it with this resource", url)
+			}
+			// Replace the mapping in place to preserve its position in the evaluation order
+			items[i] = *desired
+			return items, nil
+		},
+		func(items []client.SpUrlMapping) bool {
+			i := indexOf(items, url)
+			return i >= 0 && mappingMatches(items[i], *desired)
+		})
+	if err != nil {
+		return nil, httpResp, err
+	}
+	return &items[indexOf(items, url)], httpResp, nil
+}
+
+func (r *spTargetUrlMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan spTargetUrlMappingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientData, err := plan.buildClientStruct()
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to build the request for the SP target URL mapping: "+err.Error())
+		return
+	}
+	responseData, httpResp, err := r.upsert(ctx, clientData, false)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while adding the SP target URL mapping", err, httpResp)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.readClientResponse(ctx, *responseData)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *spTargetUrlMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state spTargetUrlMappingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, httpResp, err := r.operations().Get(ctx)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the SP target URL mappings", err, httpResp)
+		return
+	}
+
+	i := indexOf(items, state.Url.ValueString())
+	if i < 0 {
+		config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "SP Target URL Mapping", nil)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.readClientResponse(ctx, items[i])...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *spTargetUrlMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan spTargetUrlMappingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientData, err := plan.buildClientStruct()
+	if err != nil {
+		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to build the request for the SP target URL mapping: "+err.Error())
+		return
+	}
+	responseData, httpResp, err := r.upsert(ctx, clientData, true)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while updating the SP target URL mapping", err, httpResp)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.readClientResponse(ctx, *responseData)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes only this mapping, leaving any others in place.
+func (r *spTargetUrlMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spTargetUrlMappingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	url := state.Url.ValueString()
+	_, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []client.SpUrlMapping) ([]client.SpUrlMapping, error) {
+			return slices.DeleteFunc(items, func(item client.SpUrlMapping) bool {
+				return item.GetUrl() == url
+			}), nil
+		},
+		func(items []client.SpUrlMapping) bool {
+			return indexOf(items, url) < 0
+		})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while removing the SP target URL mapping", err, httpResp)
+	}
+}
+
+func (r *spTargetUrlMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to url attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("url"), req, resp)
+}
@@ -2,10 +2,13 @@ package sptargeturlmappings
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 )
 
@@ -24,13 +27,17 @@ func (m *spTargetUrlMappingsResourceModel) setNullObjectValues() {
 
 func (r *spTargetUrlMappingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this method will reset the resource to the default configuration in PingFederate.
-	var data spTargetUrlMappingsResourceModel
-	emptyClientStruct := data.buildClientStruct()
-	apiUpdateRequest := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*emptyClientStruct)
-	_, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the spTargetUrlMappings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_sp_target_url_mappings",
+		func(ctx context.Context) (*http.Response, error) {
+			var data spTargetUrlMappingsResourceModel
+			emptyClientStruct := data.buildClientStruct()
+			_, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(config.AuthContext(ctx, r.providerConfig)).Body(*emptyClientStruct).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.SpUrlMappings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
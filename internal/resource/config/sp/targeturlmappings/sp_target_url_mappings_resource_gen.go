@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/configvalidators"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -49,7 +50,8 @@ func (r *spTargetUrlMappingsResource) Configure(_ context.Context, req resource.
 }
 
 type spTargetUrlMappingsResourceModel struct {
-	Items types.List `tfsdk:"items"`
+	Items     types.List   `tfsdk:"items"`
+	OnDestroy types.String `tfsdk:"on_destroy"`
 }
 
 func (r *spTargetUrlMappingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -106,6 +108,7 @@ func (r *spTargetUrlMappingsResource) Schema(ctx context.Context, req resource.S
 			},
 		},
 	}
+	ondestroy.ToSchema(&resp.Schema, true)
 }
 
 func (model *spTargetUrlMappingsResourceModel) buildClientStruct() *client.SpUrlMappings {
@@ -167,7 +170,17 @@ func (state *spTargetUrlMappingsResourceModel) readClientResponse(response *clie
 	return respDiags
 }
 
+func (r *spTargetUrlMappingsResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.SpTargetUrlMappingsAPI.GetSpUrlMappings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original spTargetUrlMappings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *spTargetUrlMappingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data spTargetUrlMappingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -177,6 +190,12 @@ func (r *spTargetUrlMappingsResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update API call logic, since this is a singleton resource
 	clientData := data.buildClientStruct()
 	apiUpdateRequest := r.apiClient.SpTargetUrlMappingsAPI.UpdateSpUrlMappings(config.AuthContext(ctx, r.providerConfig))
@@ -224,6 +243,7 @@ func (r *spTargetUrlMappingsResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *spTargetUrlMappingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var data spTargetUrlMappingsResourceModel
 
 	// Read Terraform plan data into the model
@@ -254,5 +274,9 @@ func (r *spTargetUrlMappingsResource) ImportState(ctx context.Context, req resou
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
 	var emptyState spTargetUrlMappingsResourceModel
 	emptyState.setNullObjectValues()
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
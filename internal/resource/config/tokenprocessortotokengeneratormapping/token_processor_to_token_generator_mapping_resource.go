@@ -144,6 +144,7 @@ func (r *tokenProcessorToTokenGeneratorMappingResource) Configure(_ context.Cont
 }
 
 func (r *tokenProcessorToTokenGeneratorMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	var plan tokenProcessorToTokenGeneratorMappingModel
 
 	diags := req.Plan.Get(ctx, &plan)
@@ -213,6 +214,7 @@ func (r *tokenProcessorToTokenGeneratorMappingResource) Read(ctx context.Context
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *tokenProcessorToTokenGeneratorMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 
 	var plan tokenProcessorToTokenGeneratorMappingModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -8,10 +8,10 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
@@ -33,7 +33,26 @@ func BasicAuthContext(ctx context.Context, username, password string) context.Co
 
 // Get a BasicAuth context from a ProviderConfiguration
 func ProviderBasicAuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
-	return BasicAuthContext(ctx, *providerConfig.Username, *providerConfig.Password)
+	return BasicAuthContext(ctx, *providerConfig.Username, ProviderPassword(providerConfig))
+}
+
+// Guards the provider password, which the pingfederate_administrative_account_password resource can change while
+// other resources and data sources are authenticating with it
+var providerPasswordMutex sync.RWMutex
+
+// Get the current password from a ProviderConfiguration
+func ProviderPassword(providerConfig internaltypes.ProviderConfiguration) string {
+	providerPasswordMutex.RLock()
+	defer providerPasswordMutex.RUnlock()
+	return *providerConfig.Password
+}
+
+// Change the password in a ProviderConfiguration. The password is shared by all resources and data sources, so
+// subsequent requests will authenticate with the new password.
+func SetProviderPassword(providerConfig internaltypes.ProviderConfiguration, password string) {
+	providerPasswordMutex.Lock()
+	defer providerPasswordMutex.Unlock()
+	*providerConfig.Password = password
 }
 
 // Get an AccessToken context with an accessToken
@@ -59,7 +78,7 @@ func OAuthContext(ctx context.Context, transport *http.Transport, tokenUrl strin
 
 // Get an OAuth context from a ProviderConfiguration
 func ProviderOAuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
-	return OAuthContext(ctx, providerConfig.Transport, *providerConfig.TokenUrl, *providerConfig.ClientId, *providerConfig.ClientSecret, providerConfig.Scopes)
+	return OAuthContext(ctx, providerConfig.OAuthTransport, *providerConfig.TokenUrl, *providerConfig.ClientId, *providerConfig.ClientSecret, providerConfig.Scopes)
 }
 
 func AuthContext(ctx context.Context, providerConfig internaltypes.ProviderConfiguration) context.Context {
@@ -165,22 +184,9 @@ func ReportHttpErrorCustomId(ctx context.Context, diagnostics *diag.Diagnostics,
 						errorDetail.WriteString("\nDeveloper message: ")
 						errorDetail.WriteString(validationError.DeveloperMessage)
 					}
-					if validationError.FieldPath != "" {
-						tfFieldPath := validationError.FieldPath
-						if customId != nil && tfFieldPath == "id" {
-							tfFieldPath = *customId
-						}
-						tfAttrName := toTerraformIdentifier(tfFieldPath)
-						// Attempt to build the terraform field path from the attribute name
-						fieldSteps := strings.Split(tfAttrName, ".")
-						var fieldPath path.Path
-						for i, step := range fieldSteps {
-							if i == 0 {
-								fieldPath = path.Root(step)
-							} else {
-								fieldPath = fieldPath.AtMapKey(step)
-							}
-						}
+					// Attempt to attach the error to the Terraform attribute matching the PingFederate field path
+					fieldPath, fieldPathFound := terraformPathForFieldPath(ctx, validationError.FieldPath, customId)
+					if fieldPathFound {
 						diagnostics.AddAttributeError(fieldPath, providererror.PingFederateValidationError, errorDetail.String())
 					} else {
 						diagnostics.AddError(providererror.PingFederateValidationError, errorDetail.String())
@@ -0,0 +1,196 @@
+package virtualhostname
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/id"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/readmodifywrite"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
+	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &virtualHostNameResource{}
+	_ resource.ResourceWithConfigure   = &virtualHostNameResource{}
+	_ resource.ResourceWithImportState = &virtualHostNameResource{}
+)
+
+// VirtualHostNameResource is a helper function to simplify the provider implementation.
+func VirtualHostNameResource() resource.Resource {
+	return &virtualHostNameResource{}
+}
+
+// virtualHostNameResource is the resource implementation.
+type virtualHostNameResource struct {
+	providerConfig internaltypes.ProviderConfiguration
+	apiClient      *client.APIClient
+}
+
+type virtualHostNameModel struct {
+	Id              types.String `tfsdk:"id"`
+	VirtualHostName types.String `tfsdk:"virtual_host_name"`
+}
+
+// GetSchema defines the schema for the resource.
+func (r *virtualHostNameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	schema := schema.Schema{
+		Description: "Manages a single virtual host name. Other virtual host names are left unchanged, so this resource can be used by multiple configurations at once. This resource should not be used together with the `pingfederate_virtual_host_names` resource.",
+		Attributes: map[string]schema.Attribute{
+			"virtual_host_name": schema.StringAttribute{
+				Description: "The virtual host name. This field is immutable and will trigger a replacement plan if changed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+
+	id.ToSchema(&schema)
+	resp.Schema = schema
+}
+
+// Metadata returns the resource type name.
+func (r *virtualHostNameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_host_name"
+}
+
+func (r *virtualHostNameResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerCfg := req.ProviderData.(internaltypes.ResourceConfiguration)
+	r.providerConfig = providerCfg.ProviderConfig
+	r.apiClient = providerCfg.ApiClient
+}
+
+func (r *virtualHostNameResource) operations() readmodifywrite.Operations[string] {
+	return readmodifywrite.Operations[string]{
+		Key: "virtual_host_names",
+		Get: func(ctx context.Context) ([]string, *http.Response, error) {
+			response, httpResp, err := r.apiClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+			if err != nil {
+				return nil, httpResp, err
+			}
+			return response.VirtualHostNames, httpResp, nil
+		},
+		Put: func(ctx context.Context, items []string) (*http.Response, error) {
+			body := client.NewVirtualHostNameSettings()
+			body.VirtualHostNames = items
+			_, httpResp, err := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig)).Body(*body).Execute()
+			return httpResp, err
+		},
+	}
+}
+
+func (r *virtualHostNameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan virtualHostNameModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.VirtualHostName.ValueString()
+	_, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []string) ([]string, error) {
+			if slices.Contains(items, name) {
+				return nil, fmt.Errorf("virtual host name %q already exists. Import it to manage it with this resource", name)
+			}
+			return append(items, name), nil
+		},
+		func(items []string) bool {
+			return slices.Contains(items, name)
+		})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while adding the virtual host name", err, httpResp)
+		return
+	}
+
+	plan.Id = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *virtualHostNameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state virtualHostNameModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, httpResp, err := r.operations().Get(ctx)
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while getting the virtual host names", err, httpResp)
+		return
+	}
+
+	if !slices.Contains(items, state.VirtualHostName.ValueString()) {
+		config.AddResourceNotFoundWarning(ctx, &resp.Diagnostics, "Virtual Host Name", nil)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = types.StringValue(state.VirtualHostName.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// All attributes require replacement, so there is nothing to update in PingFederate.
+func (r *virtualHostNameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan virtualHostNameModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(plan.VirtualHostName.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes only this virtual host name, leaving any others in place.
+func (r *virtualHostNameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state virtualHostNameModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.VirtualHostName.ValueString()
+	_, httpResp, err := readmodifywrite.Update(ctx, r.operations(),
+		func(items []string) ([]string, error) {
+			return slices.DeleteFunc(items, func(item string) bool {
+				return item == name
+			}), nil
+		},
+		func(items []string) bool {
+			return !slices.Contains(items, name)
+		})
+	if err != nil {
+		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while removing the virtual host name", err, httpResp)
+	}
+}
+
+func (r *virtualHostNameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to virtual_host_name attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("virtual_host_name"), req, resp)
+}
@@ -2,12 +2,15 @@ package virtualhostnames
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	client "github.com/pingidentity/pingfederate-go-client/v1220/configurationapi"
+	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/common/ondestroy"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/config"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/resource/providererror"
 	internaltypes "github.com/pingidentity/terraform-provider-pingfederate/internal/types"
@@ -33,6 +36,11 @@ type virtualHostNamesResource struct {
 	apiClient      *client.APIClient
 }
 
+type virtualHostNamesResourceModel struct {
+	virtualHostNamesModel
+	OnDestroy types.String `tfsdk:"on_destroy"`
+}
+
 // GetSchema defines the schema for the resource.
 func (r *virtualHostNamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	schema := schema.Schema{
@@ -47,6 +55,7 @@ func (r *virtualHostNamesResource) Schema(ctx context.Context, req resource.Sche
 			},
 		},
 	}
+	ondestroy.ToSchema(&schema, true)
 	resp.Schema = schema
 }
 
@@ -82,8 +91,18 @@ func (m *virtualHostNamesModel) buildDefaultClientStruct() *client.VirtualHostNa
 	}
 }
 
+func (r *virtualHostNamesResource) saveOriginal(ctx context.Context, private ondestroy.PrivateState, diags *diag.Diagnostics) {
+	original, httpResp, err := r.apiClient.VirtualHostNamesAPI.GetVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig)).Execute()
+	if err != nil {
+		config.ReportHttpError(ctx, diags, "An error occurred while getting the original Virtual Host Names settings", err, httpResp)
+		return
+	}
+	diags.Append(ondestroy.SaveOriginal(ctx, private, original)...)
+}
+
 func (r *virtualHostNamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan virtualHostNamesModel
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
+	var plan virtualHostNamesResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -91,8 +110,14 @@ func (r *virtualHostNamesResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createVirtualHostNames := client.NewVirtualHostNameSettings()
-	err := addOptionalVirtualHostNamesFields(ctx, createVirtualHostNames, plan)
+	err := addOptionalVirtualHostNamesFields(ctx, createVirtualHostNames, plan.virtualHostNamesModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Virtual Host Names settings: "+err.Error())
 		return
@@ -107,15 +132,17 @@ func (r *virtualHostNamesResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Read the response into the state
-	var state virtualHostNamesModel
-	readVirtualHostNamesResponse(ctx, virtualHostNamesResponse, &state)
+	state := virtualHostNamesResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readVirtualHostNamesResponse(ctx, virtualHostNamesResponse, &state.virtualHostNamesModel)
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
 
 func (r *virtualHostNamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state virtualHostNamesModel
+	var state virtualHostNamesResourceModel
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -134,7 +161,7 @@ func (r *virtualHostNamesResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Read the response into the state
-	readVirtualHostNamesResponse(ctx, apiReadVirtualHostNames, &state)
+	readVirtualHostNamesResponse(ctx, apiReadVirtualHostNames, &state.virtualHostNamesModel)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -143,8 +170,9 @@ func (r *virtualHostNamesResource) Read(ctx context.Context, req resource.ReadRe
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *virtualHostNamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = config.WithSchema(ctx, req.Plan.Schema)
 	// Retrieve values from plan
-	var plan virtualHostNamesModel
+	var plan virtualHostNamesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -153,7 +181,7 @@ func (r *virtualHostNamesResource) Update(ctx context.Context, req resource.Upda
 
 	updateVirtualHostNames := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig))
 	createUpdateRequest := client.NewVirtualHostNameSettings()
-	err := addOptionalVirtualHostNamesFields(ctx, createUpdateRequest, plan)
+	err := addOptionalVirtualHostNamesFields(ctx, createUpdateRequest, plan.virtualHostNamesModel)
 	if err != nil {
 		resp.Diagnostics.AddError(providererror.InternalProviderError, "Failed to add optional properties to add request for Virtual Host Names settings: "+err.Error())
 		return
@@ -167,8 +195,10 @@ func (r *virtualHostNamesResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Read the response
-	var state virtualHostNamesModel
-	readVirtualHostNamesResponse(ctx, updateVirtualHostNamesResponse, &state)
+	state := virtualHostNamesResourceModel{
+		OnDestroy: plan.OnDestroy,
+	}
+	readVirtualHostNamesResponse(ctx, updateVirtualHostNamesResponse, &state.virtualHostNamesModel)
 
 	// Update computed values
 	diags = resp.State.Set(ctx, state)
@@ -178,20 +208,28 @@ func (r *virtualHostNamesResource) Update(ctx context.Context, req resource.Upda
 // This config object is edit-only, so Terraform can't delete it.
 func (r *virtualHostNamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// This resource is singleton, so it can't be deleted from the service. Deleting this resource will remove it from Terraform state.
-	// Instead this delete will reset the configuration back to the "default" value used by PingFederate.
-	var model virtualHostNamesModel
-	clientData := model.buildDefaultClientStruct()
-	apiUpdateRequest := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig))
-	apiUpdateRequest = apiUpdateRequest.Body(*clientData)
-	_, httpResp, err := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettingsExecute(apiUpdateRequest)
-	if err != nil {
-		config.ReportHttpError(ctx, &resp.Diagnostics, "An error occurred while resetting the virtual host names settings", err, httpResp)
-	}
+	// Depending on on_destroy, this delete will reset the configuration back to the "default" value used by PingFederate,
+	// leave it in place, or restore the configuration saved when the resource was created or imported.
+	ondestroy.Delete(ctx, req, resp, "pingfederate_virtual_host_names",
+		func(ctx context.Context) (*http.Response, error) {
+			var model virtualHostNamesModel
+			clientData := model.buildDefaultClientStruct()
+			_, httpResp, err := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig)).Body(*clientData).Execute()
+			return httpResp, err
+		},
+		func(ctx context.Context, original client.VirtualHostNameSettings) (*http.Response, error) {
+			_, httpResp, err := r.apiClient.VirtualHostNamesAPI.UpdateVirtualHostNamesSettings(config.AuthContext(ctx, r.providerConfig)).Body(original).Execute()
+			return httpResp, err
+		})
 }
 
 func (r *virtualHostNamesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// This resource has no identifier attributes, so the value passed in here doesn't matter. Just return an empty state struct.
-	var emptyState virtualHostNamesModel
+	var emptyState virtualHostNamesResourceModel
 	emptyState.VirtualHostNames = types.SetNull(types.StringType)
+	emptyState.OnDestroy = ondestroy.Default(true)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &emptyState)...)
+
+	// Save the existing configuration so it can be restored on destroy
+	r.saveOriginal(ctx, resp.Private, &resp.Diagnostics)
 }
@@ -10,7 +10,8 @@ import (
 // Configuration used by the provider and resources
 type ProviderConfiguration struct {
 	HttpsHost      string
-	Transport      *http.Transport
+	Transport      http.RoundTripper
+	OAuthTransport *http.Transport
 	Username       *string
 	Password       *string
 	AccessToken    *string
@@ -1,11 +1,10 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/pingidentity/terraform-provider-pingfederate/internal/provider"
 )
 
@@ -34,10 +33,12 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	err := providerserver.Serve(context.Background(), provider.NewFactory(version), providerserver.ServeOpts{
-		Address: "registry.terraform.io/pingidentity/pingfederate",
-		Debug:   debug,
-	})
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+
+	err := tf6server.Serve("registry.terraform.io/pingidentity/pingfederate", provider.NewProtocol6Server(version), serveOpts...)
 
 	if err != nil {
 		fmt.Println(err)